@@ -42,6 +42,7 @@ require (
 	github.com/kelseyhightower/envconfig v1.4.0
 	github.com/microsoft/azure-devops-go-api/azuredevops v1.0.0-b5
 	github.com/mitchellh/mapstructure v1.5.0
+	github.com/moby/patternmatcher v0.6.0
 	github.com/opencontainers/image-spec v1.1.0
 	github.com/pkg/sftp v1.13.6
 	github.com/posthog/posthog-go v0.0.0-20240327112532-87b23fe11103
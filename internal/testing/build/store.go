@@ -7,12 +7,14 @@ package build
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/build"
 )
 
 type InMemoryBuildStore struct {
 	builds map[string]*build.Build
+	mutex  sync.RWMutex
 }
 
 func NewInMemoryBuildStore() build.Store {
@@ -22,6 +24,9 @@ func NewInMemoryBuildStore() build.Store {
 }
 
 func (s *InMemoryBuildStore) Find(filter *build.Filter) (*build.Build, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	builds, err := s.processFilters(filter)
 	if err != nil {
 		return nil, err
@@ -34,6 +39,9 @@ func (s *InMemoryBuildStore) Find(filter *build.Filter) (*build.Build, error) {
 }
 
 func (s *InMemoryBuildStore) List(filter *build.Filter) ([]*build.Build, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	builds, err := s.processFilters(filter)
 	if err != nil {
 		return nil, err
@@ -43,11 +51,17 @@ func (s *InMemoryBuildStore) List(filter *build.Filter) ([]*build.Build, error)
 }
 
 func (s *InMemoryBuildStore) Save(result *build.Build) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	s.builds[result.Id] = result
 	return nil
 }
 
 func (s *InMemoryBuildStore) Delete(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	delete(s.builds, id)
 	return nil
 }
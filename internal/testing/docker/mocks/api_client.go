@@ -52,6 +52,36 @@ func (m *MockApiClient) ImagePull(ctx context.Context, ref string, options image
 	return args.Get(0).(io.ReadCloser), args.Error(1)
 }
 
+func (m *MockApiClient) ImagePush(ctx context.Context, ref string, options image.PushOptions) (io.ReadCloser, error) {
+	args := m.Called(ctx, ref, options)
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockApiClient) ImageBuild(ctx context.Context, buildContext io.Reader, options types.ImageBuildOptions) (types.ImageBuildResponse, error) {
+	args := m.Called(ctx, buildContext, options)
+	return args.Get(0).(types.ImageBuildResponse), args.Error(1)
+}
+
+func (m *MockApiClient) ImageInspectWithRaw(ctx context.Context, image string) (types.ImageInspect, []byte, error) {
+	args := m.Called(ctx, image)
+	return args.Get(0).(types.ImageInspect), nil, args.Error(1)
+}
+
+func (m *MockApiClient) ContainerCommit(ctx context.Context, container string, options container.CommitOptions) (types.IDResponse, error) {
+	args := m.Called(ctx, container, options)
+	return args.Get(0).(types.IDResponse), args.Error(1)
+}
+
+func (m *MockApiClient) CopyFromContainer(ctx context.Context, containerID, srcPath string) (io.ReadCloser, container.PathStat, error) {
+	args := m.Called(ctx, containerID, srcPath)
+	return args.Get(0).(io.ReadCloser), args.Get(1).(container.PathStat), args.Error(2)
+}
+
+func (m *MockApiClient) CopyToContainer(ctx context.Context, containerID, dstPath string, content io.Reader, options container.CopyToContainerOptions) error {
+	args := m.Called(ctx, containerID, dstPath, content, options)
+	return args.Error(0)
+}
+
 func (m *MockApiClient) ContainerLogs(ctx context.Context, container string, options container.LogsOptions) (io.ReadCloser, error) {
 	args := m.Called(ctx, container, options)
 	return args.Get(0).(io.ReadCloser), args.Error(1)
@@ -6,6 +6,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -16,8 +18,8 @@ type MockGitService struct {
 	mock.Mock
 }
 
-func (m *MockGitService) CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
-	args := m.Called(repo, auth)
+func (m *MockGitService) CloneRepository(ctx context.Context, repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	args := m.Called(ctx, repo, auth)
 	return args.Error(0)
 }
 
@@ -5,11 +5,7 @@
 
 package targets
 
-import (
-	"fmt"
-
-	"github.com/daytonaio/daytona/pkg/provider"
-)
+import "github.com/daytonaio/daytona/pkg/provider"
 
 type InMemoryTargetStore struct {
 	targets map[string]*provider.ProviderTarget
@@ -60,7 +56,7 @@ func (s *InMemoryTargetStore) processFilters(filter *provider.TargetFilter) ([]*
 			if ok {
 				return []*provider.ProviderTarget{target}, nil
 			} else {
-				return []*provider.ProviderTarget{}, fmt.Errorf("target with name %s not found", *filter.Name)
+				return []*provider.ProviderTarget{}, provider.ErrTargetNotFound
 			}
 		}
 		if filter.Default != nil {
@@ -70,6 +66,13 @@ func (s *InMemoryTargetStore) processFilters(filter *provider.TargetFilter) ([]*
 				}
 			}
 		}
+		if filter.Template != nil {
+			for _, target := range filteredTargets {
+				if target.IsTemplate != *filter.Template {
+					delete(filteredTargets, target.Name)
+				}
+			}
+		}
 	}
 
 	for _, target := range filteredTargets {
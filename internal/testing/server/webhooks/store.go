@@ -0,0 +1,148 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type InMemoryWebhookStore struct {
+	webhooks   map[string]*webhook.Webhook
+	deliveries map[string]*webhook.Delivery
+	mutex      sync.Mutex
+}
+
+func NewInMemoryWebhookStore() webhook.Store {
+	return &InMemoryWebhookStore{
+		webhooks:   make(map[string]*webhook.Webhook),
+		deliveries: make(map[string]*webhook.Delivery),
+	}
+}
+
+func (s *InMemoryWebhookStore) List() ([]*webhook.Webhook, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	webhooks := []*webhook.Webhook{}
+	for _, w := range s.webhooks {
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
+
+func (s *InMemoryWebhookStore) Find(id string) (*webhook.Webhook, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	w, ok := s.webhooks[id]
+	if !ok {
+		return nil, webhook.ErrWebhookNotFound
+	}
+
+	return w, nil
+}
+
+func (s *InMemoryWebhookStore) Save(w *webhook.Webhook) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.webhooks[w.Id] = w
+	return nil
+}
+
+func (s *InMemoryWebhookStore) Delete(w *webhook.Webhook) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.webhooks[w.Id]; !ok {
+		return webhook.ErrWebhookNotFound
+	}
+
+	delete(s.webhooks, w.Id)
+	return nil
+}
+
+func (s *InMemoryWebhookStore) ListDeliveries(webhookId string) ([]*webhook.Delivery, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deliveries := []*webhook.Delivery{}
+	for _, d := range s.deliveries {
+		if d.WebhookId == webhookId {
+			delivery := *d
+			deliveries = append(deliveries, &delivery)
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	return deliveries, nil
+}
+
+func (s *InMemoryWebhookStore) ListPendingDeliveries() ([]*webhook.Delivery, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deliveries := []*webhook.Delivery{}
+	for _, d := range s.deliveries {
+		if d.Status == webhook.DeliveryStatusPending {
+			delivery := *d
+			deliveries = append(deliveries, &delivery)
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+
+	return deliveries, nil
+}
+
+func (s *InMemoryWebhookStore) SaveDelivery(delivery *webhook.Delivery) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	d := *delivery
+	s.deliveries[delivery.Id] = &d
+	return nil
+}
+
+func (s *InMemoryWebhookStore) DeleteDeliveries(webhookId string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, d := range s.deliveries {
+		if d.WebhookId == webhookId {
+			delete(s.deliveries, id)
+		}
+	}
+
+	return nil
+}
+
+func (s *InMemoryWebhookStore) PruneDeliveries(webhookId string, keep int) error {
+	deliveries, err := s.ListDeliveries(webhookId)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i, d := range deliveries {
+		if i >= keep && d.Status != webhook.DeliveryStatusPending {
+			delete(s.deliveries, d.Id)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
@@ -50,8 +52,8 @@ type MockBuilder struct {
 	mock.Mock
 }
 
-func (b *MockBuilder) Build(build build.Build) (string, string, error) {
-	args := b.Called(build)
+func (b *MockBuilder) Build(ctx context.Context, build build.Build) (string, string, error) {
+	args := b.Called(ctx, build)
 	return args.String(0), args.String(1), args.Error(2)
 }
 
@@ -60,8 +62,8 @@ func (b *MockBuilder) CleanUp() error {
 	return args.Error(0)
 }
 
-func (b *MockBuilder) Publish(build build.Build) error {
-	args := b.Called(build)
+func (b *MockBuilder) Publish(ctx context.Context, build build.Build) error {
+	args := b.Called(ctx, build)
 	return args.Error(0)
 }
 
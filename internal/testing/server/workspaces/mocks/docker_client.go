@@ -0,0 +1,26 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/stretchr/testify/mock"
+)
+
+// Only the methods used by the workspace service are mocked
+type mockDockerClient struct {
+	docker.IDockerClient
+	mock.Mock
+}
+
+func NewMockDockerClient() *mockDockerClient {
+	return &mockDockerClient{}
+}
+
+func (c *mockDockerClient) SnapshotProject(opts docker.SnapshotProjectOptions) error {
+	args := c.Called(opts)
+	return args.Error(0)
+}
@@ -23,47 +23,52 @@ func NewMockProvisioner() *mockProvisioner {
 	return &mockProvisioner{}
 }
 
-func (p *mockProvisioner) CreateProject(params provisioner.ProjectParams) error {
-	args := p.Called(params)
+func (p *mockProvisioner) CreateProject(ctx context.Context, params provisioner.ProjectParams) error {
+	args := p.Called(ctx, params)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	args := p.Called(workspace, target)
+func (p *mockProvisioner) CreateWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	args := p.Called(ctx, workspace, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) DestroyProject(proj *project.Project, target *provider.ProviderTarget) error {
-	args := p.Called(proj, target)
+func (p *mockProvisioner) DestroyProject(ctx context.Context, proj *project.Project, target *provider.ProviderTarget) error {
+	args := p.Called(ctx, proj, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	args := p.Called(workspace, target)
+func (p *mockProvisioner) DestroyWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	args := p.Called(ctx, workspace, target)
 	return args.Error(0)
 }
 
+func (p *mockProvisioner) GetCapabilities(ctx context.Context, target *provider.ProviderTarget) (*provider.ProviderCapabilities, error) {
+	args := p.Called(ctx, target)
+	return args.Get(0).(*provider.ProviderCapabilities), args.Error(1)
+}
+
 func (p *mockProvisioner) GetWorkspaceInfo(ctx context.Context, w *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
 	args := p.Called(ctx, w, target)
 	return args.Get(0).(*workspace.WorkspaceInfo), args.Error(1)
 }
 
-func (p *mockProvisioner) StartProject(params provisioner.ProjectParams) error {
-	args := p.Called(params)
+func (p *mockProvisioner) StartProject(ctx context.Context, params provisioner.ProjectParams) error {
+	args := p.Called(ctx, params)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	args := p.Called(workspace, target)
+func (p *mockProvisioner) StartWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	args := p.Called(ctx, workspace, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) StopProject(proj *project.Project, target *provider.ProviderTarget) error {
-	args := p.Called(proj, target)
+func (p *mockProvisioner) StopProject(ctx context.Context, proj *project.Project, target *provider.ProviderTarget) error {
+	args := p.Called(ctx, proj, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	args := p.Called(workspace, target)
+func (p *mockProvisioner) StopWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	args := p.Called(ctx, workspace, target)
 	return args.Error(0)
 }
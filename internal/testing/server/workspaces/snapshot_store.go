@@ -0,0 +1,49 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+)
+
+type InMemorySnapshotStore struct {
+	snapshots map[string]*snapshot.Snapshot
+}
+
+func NewInMemorySnapshotStore() snapshot.Store {
+	return &InMemorySnapshotStore{
+		snapshots: make(map[string]*snapshot.Snapshot),
+	}
+}
+
+func (s *InMemorySnapshotStore) List(filter *snapshot.SnapshotFilter) ([]*snapshot.Snapshot, error) {
+	snapshots := []*snapshot.Snapshot{}
+	for _, snap := range s.snapshots {
+		if filter != nil && filter.WorkspaceId != nil && snap.WorkspaceId != *filter.WorkspaceId {
+			continue
+		}
+		if filter != nil && filter.ProjectName != nil && snap.ProjectName != *filter.ProjectName {
+			continue
+		}
+		snapshots = append(snapshots, snap)
+	}
+
+	return snapshots, nil
+}
+
+func (s *InMemorySnapshotStore) Find(id string) (*snapshot.Snapshot, error) {
+	snap, ok := s.snapshots[id]
+	if !ok {
+		return nil, snapshot.ErrSnapshotNotFound
+	}
+
+	return snap, nil
+}
+
+func (s *InMemorySnapshotStore) Save(snap *snapshot.Snapshot) error {
+	s.snapshots[snap.Id] = snap
+	return nil
+}
@@ -131,6 +131,38 @@ func GetWorkspace(workspaceNameOrId string, verbose bool) (*apiclient.WorkspaceD
 	return workspace, nil
 }
 
+// GetProviderCapabilities returns the capabilities declared by an installed provider
+func GetProviderCapabilities(ctx context.Context, apiClient *apiclient.APIClient, providerName string) (*apiclient.ProviderCapabilities, error) {
+	providers, res, err := apiClient.ProviderAPI.ListProviders(ctx).Execute()
+	if err != nil {
+		return nil, HandleErrorResponse(res, err)
+	}
+
+	for _, p := range providers {
+		if p.Name == providerName {
+			return &p.Capabilities, nil
+		}
+	}
+
+	return nil, fmt.Errorf("provider %s is not installed", providerName)
+}
+
+// GetTargetProviderCapabilities returns the capabilities declared by the provider of a target
+func GetTargetProviderCapabilities(ctx context.Context, apiClient *apiclient.APIClient, targetName string) (*apiclient.ProviderCapabilities, error) {
+	targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+	if err != nil {
+		return nil, HandleErrorResponse(res, err)
+	}
+
+	for _, t := range targets {
+		if t.Name == targetName {
+			return GetProviderCapabilities(ctx, apiClient, t.ProviderInfo.Name)
+		}
+	}
+
+	return nil, fmt.Errorf("target %s not found", targetName)
+}
+
 func GetFirstWorkspaceProjectName(workspaceId string, projectName string, profile *config.Profile) (string, error) {
 	ctx := context.Background()
 
@@ -19,23 +19,26 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 	}
 
 	repository := &gitprovider.GitRepository{
-		Id:     projectDTO.Repository.Id,
-		Name:   projectDTO.Repository.Name,
-		Branch: projectDTO.Repository.Branch,
-		Owner:  projectDTO.Repository.Owner,
-		Path:   projectDTO.Repository.Path,
-		Sha:    projectDTO.Repository.Sha,
-		Source: projectDTO.Repository.Source,
-		Url:    projectDTO.Repository.Url,
+		Id:         projectDTO.Repository.Id,
+		Name:       projectDTO.Repository.Name,
+		Branch:     projectDTO.Repository.Branch,
+		Owner:      projectDTO.Repository.Owner,
+		Path:       projectDTO.Repository.Path,
+		Sha:        projectDTO.Repository.Sha,
+		Source:     projectDTO.Repository.Source,
+		Url:        projectDTO.Repository.Url,
+		Target:     gitprovider.CloneTarget(projectDTO.Repository.GetCloneTarget()),
+		KeepBranch: projectDTO.Repository.GetKeepBranch(),
 	}
 
 	var projectState *project.ProjectState
 	if projectDTO.State != nil {
 		uptime := projectDTO.State.Uptime
 		projectState = &project.ProjectState{
-			UpdatedAt: projectDTO.State.UpdatedAt,
-			Uptime:    uint64(uptime),
-			GitStatus: ToGitStatus(projectDTO.State.GitStatus),
+			UpdatedAt:    projectDTO.State.UpdatedAt,
+			Uptime:       uint64(uptime),
+			GitStatus:    ToGitStatus(projectDTO.State.GitStatus),
+			LastActivity: projectDTO.State.GetLastActivity(),
 		}
 	}
 
@@ -47,6 +50,19 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 				FilePath: projectDTO.BuildConfig.Devcontainer.FilePath,
 			}
 		}
+		if projectDTO.BuildConfig.Dockerfile != nil {
+			projectBuild.Dockerfile = &buildconfig.DockerfileConfig{
+				FilePath:  projectDTO.BuildConfig.Dockerfile.FilePath,
+				Context:   projectDTO.BuildConfig.Dockerfile.GetContext(),
+				BuildArgs: projectDTO.BuildConfig.Dockerfile.GetBuildArgs(),
+				Target:    projectDTO.BuildConfig.Dockerfile.GetTarget(),
+			}
+		}
+		if projectDTO.BuildConfig.Language != nil {
+			projectBuild.Language = &buildconfig.LanguageConfig{
+				Languages: projectDTO.BuildConfig.Language.Languages,
+			}
+		}
 	}
 
 	project := &project.Project{
@@ -59,6 +75,14 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 		WorkspaceId:         projectDTO.WorkspaceId,
 		State:               projectState,
 		GitProviderConfigId: projectDTO.GitProviderConfigId,
+		Status:              project.ProjectStatus(projectDTO.Status),
+		LastError:           projectDTO.LastError,
+		SnapshotId:          projectDTO.SnapshotId,
+	}
+
+	if projectDTO.IdleTimeout != nil {
+		idleTimeout := int(*projectDTO.IdleTimeout)
+		project.IdleTimeout = &idleTimeout
 	}
 
 	if projectDTO.Repository.PrNumber != nil {
@@ -157,6 +181,7 @@ func ToProjectConfig(createProjectConfigDto pc_dto.CreateProjectConfigDTO) *conf
 		BuildConfig:         createProjectConfigDto.BuildConfig,
 		EnvVars:             createProjectConfigDto.EnvVars,
 		GitProviderConfigId: createProjectConfigDto.GitProviderConfigId,
+		IdleTimeout:         createProjectConfigDto.IdleTimeout,
 	}
 
 	result.RepositoryUrl = createProjectConfigDto.RepositoryUrl
@@ -179,6 +204,7 @@ func CreateDtoToProject(createProjectDto project_dto.CreateProjectDTO) *project.
 		Repository:          createProjectDto.Source.Repository,
 		EnvVars:             createProjectDto.EnvVars,
 		GitProviderConfigId: createProjectDto.GitProviderConfigId,
+		IdleTimeout:         createProjectDto.IdleTimeout,
 	}
 
 	if createProjectDto.Image != nil {
@@ -199,6 +225,7 @@ func CreateConfigDtoToProject(createProjectConfigDto pc_dto.CreateProjectConfigD
 		User:                *createProjectConfigDto.User,
 		BuildConfig:         createProjectConfigDto.BuildConfig,
 		GitProviderConfigId: createProjectConfigDto.GitProviderConfigId,
+		IdleTimeout:         createProjectConfigDto.IdleTimeout,
 		Repository: &gitprovider.GitRepository{
 			Url: createProjectConfigDto.RepositoryUrl,
 		},
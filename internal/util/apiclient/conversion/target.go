@@ -10,8 +10,11 @@ import (
 
 func ToProviderTarget(createProviderTargetDto dto.CreateProviderTargetDTO) *provider.ProviderTarget {
 	return &provider.ProviderTarget{
-		Name:         createProviderTargetDto.Name,
-		ProviderInfo: createProviderTargetDto.ProviderInfo,
-		Options:      createProviderTargetDto.Options,
+		Name:                    createProviderTargetDto.Name,
+		ProviderInfo:            createProviderTargetDto.ProviderInfo,
+		Options:                 createProviderTargetDto.Options,
+		IdleTimeout:             createProviderTargetDto.IdleTimeout,
+		MaxConcurrentOperations: createProviderTargetDto.MaxConcurrentOperations,
+		IsTemplate:              createProviderTargetDto.IsTemplate,
 	}
 }
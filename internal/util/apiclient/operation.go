@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+const operationPollInterval = time.Second
+
+// GetAcceptedOperation reads the operation the server started for a request made in async mode
+func GetAcceptedOperation(res *http.Response) (*apiclient.Operation, error) {
+	if res == nil || res.StatusCode != http.StatusAccepted {
+		return nil, errors.New("server did not start an operation for the request")
+	}
+
+	var operation apiclient.Operation
+	err := json.NewDecoder(res.Body).Decode(&operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operation, nil
+}
+
+// WaitForOperation polls the operation until it finishes and returns an error if it did not succeed
+func WaitForOperation(ctx context.Context, apiClient *apiclient.APIClient, operationId string) (*apiclient.Operation, error) {
+	for {
+		operation, res, err := apiClient.OperationAPI.GetOperation(ctx, operationId).Execute()
+		if err != nil {
+			return nil, HandleErrorResponse(res, err)
+		}
+
+		switch operation.State {
+		case apiclient.OperationStateSuccess:
+			return operation, nil
+		case apiclient.OperationStateError, apiclient.OperationStateCancelled:
+			if operation.Error != nil {
+				return operation, errors.New(*operation.Error)
+			}
+			return operation, fmt.Errorf("operation %s", operation.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+	}
+}
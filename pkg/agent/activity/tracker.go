@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"sync"
+	"time"
+)
+
+// Tracker records the time of the last user activity (SSH sessions, toolbox requests) in a project.
+// A nil Tracker is valid and ignores all calls.
+type Tracker struct {
+	mu             sync.Mutex
+	lastActivity   time.Time
+	activeSessions int
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		lastActivity: time.Now(),
+	}
+}
+
+// Touch records activity at the current time
+func (t *Tracker) Touch() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastActivity = time.Now()
+}
+
+// Track marks the start of a long-lived activity, e.g. an SSH session or a port forward.
+// The project is considered active until the returned function is called.
+func (t *Tracker) Track() func() {
+	if t == nil {
+		return func() {}
+	}
+
+	t.mu.Lock()
+	t.activeSessions++
+	t.lastActivity = time.Now()
+	t.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.activeSessions--
+			t.lastActivity = time.Now()
+		})
+	}
+}
+
+// LastActivity returns the time of the last activity or the current time if there are active sessions
+func (t *Tracker) LastActivity() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.activeSessions > 0 {
+		return time.Now()
+	}
+
+	return t.lastActivity
+}
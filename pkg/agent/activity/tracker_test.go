@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package activity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/activity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := activity.NewTracker()
+
+	t.Run("Touch", func(t *testing.T) {
+		before := tracker.LastActivity()
+		time.Sleep(10 * time.Millisecond)
+
+		tracker.Touch()
+
+		require.True(t, tracker.LastActivity().After(before))
+	})
+
+	t.Run("Track", func(t *testing.T) {
+		done := tracker.Track()
+		time.Sleep(10 * time.Millisecond)
+
+		require.WithinDuration(t, time.Now(), tracker.LastActivity(), 5*time.Millisecond)
+
+		done()
+		ended := tracker.LastActivity()
+		time.Sleep(10 * time.Millisecond)
+
+		require.Equal(t, ended, tracker.LastActivity())
+	})
+
+	t.Run("Nil tracker", func(t *testing.T) {
+		var nilTracker *activity.Tracker
+
+		nilTracker.Touch()
+		nilTracker.Track()()
+
+		require.True(t, nilTracker.LastActivity().IsZero())
+	})
+}
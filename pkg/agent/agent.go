@@ -106,7 +106,7 @@ func (a *Agent) startProjectMode() error {
 				}
 
 				log.Info("Cloning repository...")
-				err = a.Git.CloneRepository(project.Repository, auth)
+				err = a.Git.CloneRepository(context.Background(), project.Repository, auth)
 				if err != nil {
 					log.Error(fmt.Sprintf("failed to clone repository: %s", err))
 				} else {
@@ -264,10 +264,16 @@ func (a *Agent) updateProjectState() error {
 	}
 
 	uptime := a.uptime()
-	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(apiclient.SetProjectState{
+	state := apiclient.SetProjectState{
 		Uptime:    uptime,
 		GitStatus: conversion.ToGitStatusDTO(gitStatus),
-	}).Execute()
+	}
+
+	if lastActivity := a.Activity.LastActivity(); !lastActivity.IsZero() {
+		state.SetLastActivity(lastActivity.Format(time.RFC3339))
+	}
+
+	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(state).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
@@ -13,9 +13,11 @@ import (
 	"unsafe"
 
 	"github.com/creack/pty"
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/ssh/config"
 	"github.com/gliderlabs/ssh"
 	"github.com/pkg/sftp"
+	gossh "golang.org/x/crypto/ssh"
 	"golang.org/x/sys/unix"
 
 	log "github.com/sirupsen/logrus"
@@ -24,6 +26,7 @@ import (
 type Server struct {
 	ProjectDir        string
 	DefaultProjectDir string
+	Activity          *activity.Tracker
 }
 
 func (s *Server) Start() error {
@@ -33,6 +36,8 @@ func (s *Server) Start() error {
 	sshServer := ssh.Server{
 		Addr: fmt.Sprintf(":%d", config.SSH_PORT),
 		Handler: func(session ssh.Session) {
+			defer s.Activity.Track()()
+
 			switch ss := session.Subsystem(); ss {
 			case "":
 			case "sftp":
@@ -53,8 +58,8 @@ func (s *Server) Start() error {
 		},
 		ChannelHandlers: map[string]ssh.ChannelHandler{
 			"session":                        ssh.DefaultSessionHandler,
-			"direct-tcpip":                   ssh.DirectTCPIPHandler,
-			"direct-streamlocal@openssh.com": directStreamLocalHandler,
+			"direct-tcpip":                   s.trackActivity(ssh.DirectTCPIPHandler),
+			"direct-streamlocal@openssh.com": s.trackActivity(directStreamLocalHandler),
 		},
 		RequestHandlers: map[string]ssh.RequestHandler{
 			"tcpip-forward":                          forwardedTCPHandler.HandleSSHRequest,
@@ -80,6 +85,39 @@ func (s *Server) Start() error {
 	return sshServer.ListenAndServe()
 }
 
+// trackActivity keeps the project active while a forwarded channel is open.
+// The handlers return as soon as the channel is accepted so the activity ends when the channel is closed
+func (s *Server) trackActivity(handler ssh.ChannelHandler) ssh.ChannelHandler {
+	return func(srv *ssh.Server, conn *gossh.ServerConn, newChan gossh.NewChannel, ctx ssh.Context) {
+		s.Activity.Touch()
+		handler(srv, conn, &trackedNewChannel{NewChannel: newChan, activity: s.Activity}, ctx)
+	}
+}
+
+type trackedNewChannel struct {
+	gossh.NewChannel
+	activity *activity.Tracker
+}
+
+func (c *trackedNewChannel) Accept() (gossh.Channel, <-chan *gossh.Request, error) {
+	ch, reqs, err := c.NewChannel.Accept()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &trackedChannel{Channel: ch, done: c.activity.Track()}, reqs, nil
+}
+
+type trackedChannel struct {
+	gossh.Channel
+	done func()
+}
+
+func (c *trackedChannel) Close() error {
+	defer c.done()
+	return c.Channel.Close()
+}
+
 func (s *Server) handlePty(session ssh.Session, ptyReq ssh.Pty, winCh <-chan ssh.Window) {
 	shell := s.getShell()
 	cmd := exec.Command(shell)
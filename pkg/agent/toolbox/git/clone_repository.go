@@ -46,7 +46,7 @@ func CloneRepository(c *gin.Context) {
 		}
 	}
 
-	err := gitService.CloneRepository(&repo, auth)
+	err := gitService.CloneRepository(c.Request.Context(), &repo, auth)
 	if err != nil {
 		c.AbortWithError(400, err)
 		return
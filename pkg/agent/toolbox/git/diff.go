@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/gin-gonic/gin"
+)
+
+func GetDiff(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.AbortWithError(400, errors.New("path is required"))
+		return
+	}
+
+	gitService := git.Service{
+		ProjectDir: path,
+	}
+
+	base, patch, err := gitService.Diff(c.Query("base"))
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.JSON(200, GitDiffResponse{
+		Base:  base,
+		Patch: patch,
+	})
+}
+
+func ApplyPatch(c *gin.Context) {
+	var req GitApplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	gitService := git.Service{
+		ProjectDir: req.Path,
+	}
+
+	if err := gitService.Apply(req.Patch); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Status(200)
+}
@@ -43,3 +43,14 @@ type GitRepoRequest struct {
 	Username *string `json:"username,omitempty" validate:"optional"`
 	Password *string `json:"password,omitempty" validate:"optional"`
 } // @name GitRepoRequest
+
+type GitDiffResponse struct {
+	// Commit the patch applies to
+	Base  string `json:"base" validate:"required"`
+	Patch string `json:"patch" validate:"required"`
+} // @name GitDiffResponse
+
+type GitApplyRequest struct {
+	Path  string `json:"path" validate:"required"`
+	Patch string `json:"patch" validate:"required"`
+} // @name GitApplyRequest
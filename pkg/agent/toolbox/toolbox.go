@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/fs"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/git"
@@ -21,6 +22,7 @@ import (
 
 type Server struct {
 	ProjectDir string
+	Activity   *activity.Tracker
 }
 
 type ProjectDirResponse struct {
@@ -39,6 +41,10 @@ func (s *Server) Start() error {
 	r := gin.New()
 	r.Use(gin.Recovery())
 	r.Use(middlewares.LoggingMiddleware())
+	r.Use(func(ctx *gin.Context) {
+		s.Activity.Touch()
+		ctx.Next()
+	})
 	binding.Validator = new(api.DefaultValidator)
 
 	r.GET("/project-dir", s.GetProjectDir)
@@ -71,10 +77,12 @@ func (s *Server) Start() error {
 	gitController := r.Group("/git")
 	{
 		gitController.GET("/branches", git.ListBranches)
+		gitController.GET("/diff", git.GetDiff)
 		gitController.GET("/history", git.GetCommitHistory)
 		gitController.GET("/status", git.GetStatus)
 
 		gitController.POST("/add", git.AddFiles)
+		gitController.POST("/apply", git.ApplyPatch)
 		gitController.POST("/branches", git.CreateBranch)
 		gitController.POST("/clone", git.CloneRepository)
 		gitController.POST("/commit", git.CommitChanges)
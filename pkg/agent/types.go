@@ -7,6 +7,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/git"
 )
@@ -31,5 +32,6 @@ type Agent struct {
 	Tailscale        TailscaleServer
 	LogWriter        io.Writer
 	TelemetryEnabled bool
+	Activity         *activity.Tracker
 	startTime        time.Time
 }
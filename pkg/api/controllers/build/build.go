@@ -13,6 +13,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	builds_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/gin-gonic/gin"
@@ -68,6 +69,8 @@ func CreateBuild(ctx *gin.Context) {
 		BuildConfig: projectConfig.BuildConfig,
 		Repository:  repo,
 		EnvVars:     createBuildDto.EnvVars,
+		// Builds started by users run before webhook-triggered prebuilds
+		Priority: build.BuildPriorityHigh,
 	}
 
 	if createBuildDto.PrebuildId != nil {
@@ -136,6 +139,36 @@ func ListBuilds(ctx *gin.Context) {
 	ctx.JSON(200, builds)
 }
 
+// CancelBuild godoc
+//
+//	@Tags			build
+//	@Summary		Cancel build
+//	@Description	Cancel a build that is pending to run, running or being published
+//	@Param			buildId	path	string	true	"Build ID"
+//	@Success		204
+//	@Router			/build/{buildId}/cancel [post]
+//
+//	@id				CancelBuild
+func CancelBuild(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	err := server.BuildService.Cancel(buildId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if build.IsBuildNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if builds.IsBuildNotCancellable(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to cancel build: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
 // DeleteAllBuilds godoc
 //
 //	@Tags			build
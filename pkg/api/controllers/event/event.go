@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package event
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// StreamEvents 			godoc
+//
+//	@Tags			event
+//	@Summary		Stream events
+//	@Description	Stream server events over a WebSocket, or as server-sent events if the request is not a WebSocket upgrade
+//	@Produce		json
+//	@Produce		text/event-stream
+//	@Param			type	query		[]string	false	"Only receive events of the given types"	collectionFormat(multi)
+//	@Success		200		{object}	Event
+//	@Router			/events [get]
+//
+//	@id				StreamEvents
+func StreamEvents(ginCtx *gin.Context) {
+	types := util.ArrayMap(ginCtx.QueryArray("type"), func(t string) events.EventType {
+		return events.EventType(t)
+	})
+
+	server := server.GetInstance(nil)
+
+	eventChan, unsubscribe := server.EventBus.Subscribe(types...)
+	defer unsubscribe()
+
+	if websocket.IsWebSocketUpgrade(ginCtx.Request) {
+		streamToWs(ginCtx, eventChan)
+		return
+	}
+
+	ginCtx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ginCtx.Request.Context().Done():
+			return false
+		case event, ok := <-eventChan:
+			if !ok {
+				return false
+			}
+			ginCtx.SSEvent(string(event.Type), event)
+			return true
+		}
+	})
+}
+
+func streamToWs(ginCtx *gin.Context, eventChan <-chan events.Event) {
+	ws, err := upgrader.Upgrade(ginCtx.Writer, ginCtx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	defer func() {
+		err := ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		if err != nil {
+			log.Trace(err)
+		}
+		ws.Close()
+	}()
+
+	// Messages from the client are ignored, reading is only needed to notice when the connection is closed
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			_, _, err := ws.ReadMessage()
+			if err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ginCtx.Request.Context().Done():
+			return
+		case err := <-readErr:
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseAbnormalClosure) {
+				log.Error(err)
+			}
+			return
+		case event, ok := <-eventChan:
+			if !ok {
+				return
+			}
+			err := ws.WriteJSON(event)
+			if err != nil {
+				log.Trace(err)
+				return
+			}
+		}
+	}
+}
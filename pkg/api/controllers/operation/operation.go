@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package operation
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/operations"
+	"github.com/gin-gonic/gin"
+)
+
+// GetOperation 			godoc
+//
+//	@Tags			operation
+//	@Summary		Get operation
+//	@Description	Get the status, progress and result of an asynchronous operation
+//	@Produce		json
+//	@Param			operationId	path		string	true	"Operation ID"
+//	@Success		200			{object}	Operation
+//	@Router			/operation/{operationId} [get]
+//
+//	@id				GetOperation
+func GetOperation(ctx *gin.Context) {
+	operationId := ctx.Param("operationId")
+
+	server := server.GetInstance(nil)
+
+	op, err := server.OperationService.Find(operationId)
+	if err != nil {
+		if operations.IsOperationNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to get operation: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get operation: %w", err))
+		return
+	}
+
+	ctx.JSON(200, op)
+}
+
+// CancelOperation 			godoc
+//
+//	@Tags			operation
+//	@Summary		Cancel operation
+//	@Description	Cancel a running asynchronous operation
+//	@Param			operationId	path	string	true	"Operation ID"
+//	@Success		200
+//	@Router			/operation/{operationId}/cancel [post]
+//
+//	@id				CancelOperation
+func CancelOperation(ctx *gin.Context) {
+	operationId := ctx.Param("operationId")
+
+	server := server.GetInstance(nil)
+
+	err := server.OperationService.Cancel(operationId)
+	if err != nil {
+		if operations.IsOperationNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to cancel operation: %w", err))
+			return
+		}
+		if operations.IsOperationFinished(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to cancel operation: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to cancel operation: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
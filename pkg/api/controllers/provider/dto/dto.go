@@ -5,15 +5,28 @@ package dto
 
 import (
 	"github.com/daytonaio/daytona/pkg/os"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
 )
 
 type Provider struct {
-	Name    string  `json:"name" validate:"required"`
-	Label   *string `json:"label" validate:"optional"`
-	Version string  `json:"version" validate:"required"`
+	Name         string                        `json:"name" validate:"required"`
+	Label        *string                       `json:"label" validate:"optional"`
+	Version      string                        `json:"version" validate:"required"`
+	Capabilities provider.ProviderCapabilities `json:"capabilities" validate:"required"`
+	Health       *manager.ProviderHealth       `json:"health,omitempty" validate:"optional"`
 } //	@name	Provider
 
 type InstallProviderRequest struct {
 	Name         string                        `json:"name" validate:"required"`
+	Version      string                        `json:"version,omitempty" validate:"optional"`
 	DownloadUrls map[os.OperatingSystem]string `json:"downloadUrls" validate:"required"`
+	// Hex encoded SHA-256 checksums of the provider binaries
+	Checksums map[os.OperatingSystem]string `json:"checksums,omitempty" validate:"optional"`
+	// Base64 encoded ed25519 signatures of the provider binaries
+	Signatures map[os.OperatingSystem]string `json:"signatures,omitempty" validate:"optional"`
+	// Install the provider even if it has no checksum or signature
+	AllowUnsigned bool `json:"allowUnsigned,omitempty" validate:"optional"`
+	// Pin the version in the server config so that it is kept on restart and skipped by updates
+	Pin bool `json:"pin,omitempty" validate:"optional"`
 } //	@name	InstallProviderRequest
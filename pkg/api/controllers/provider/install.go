@@ -4,12 +4,16 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // InstallProvider godoc
@@ -31,26 +35,74 @@ func InstallProvider(ctx *gin.Context) {
 		return
 	}
 
+	if req.Pin && req.Version == "" {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("a version is required to pin the provider"))
+		return
+	}
+
 	server := server.GetInstance(nil)
+
+	// The current version is kept so that it can be restored with a rollback
+	backedUp := false
 	if _, err := server.ProviderManager.GetProvider(req.Name); err == nil {
-		err := server.ProviderManager.UninstallProvider(req.Name)
+		err := server.ProviderManager.BackupProvider(req.Name)
 		if err != nil {
-			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to uninstall current provider: %w", err))
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to back up current provider: %w", err))
 			return
 		}
+		backedUp = true
 	}
 
-	downloadPath, err := server.ProviderManager.DownloadProvider(ctx.Request.Context(), req.DownloadUrls, req.Name)
+	version := manager.Version{
+		DownloadUrls: req.DownloadUrls,
+		Checksums:    req.Checksums,
+		Signatures:   req.Signatures,
+	}
+
+	downloadPath, err := server.ProviderManager.DownloadProvider(ctx.Request.Context(), version, req.Name, req.AllowUnsigned)
 	if err != nil {
+		if backedUp {
+			restoreProvider(server.ProviderManager, req.Name)
+		}
+		if manager.IsProviderVerificationFailed(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to download provider: %w", err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to download provider: %w", err))
 		return
 	}
 
 	err = server.ProviderManager.RegisterProvider(downloadPath, true)
 	if err != nil {
+		// The downloaded binary is removed so that the install can be retried
+		discardErr := server.ProviderManager.DiscardProvider(req.Name)
+		if discardErr != nil {
+			log.Errorf("failed to remove provider %s: %s", req.Name, discardErr)
+		}
+		if backedUp {
+			restoreProvider(server.ProviderManager, req.Name)
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to register provider: %w", err))
 		return
 	}
 
+	pinnedVersion := ""
+	if req.Pin {
+		pinnedVersion = req.Version
+	}
+
+	err = server.PinProviderVersion(req.Name, pinnedVersion)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to pin provider version: %w", err))
+		return
+	}
+
 	ctx.Status(200)
 }
+
+func restoreProvider(providerManager manager.IProviderManager, name string) {
+	err := providerManager.RollbackProvider(name)
+	if err != nil {
+		log.Errorf("failed to restore provider %s: %s", name, err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -25,6 +26,7 @@ import (
 func ListProviders(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 	providers := server.ProviderManager.GetProviders()
+	providersHealth := server.ProviderManager.GetProvidersHealth()
 
 	result := []dto.Provider{}
 	for _, provider := range providers {
@@ -34,10 +36,34 @@ func ListProviders(ctx *gin.Context) {
 			return
 		}
 
+		var health *manager.ProviderHealth
+		if h, ok := providersHealth[info.Name]; ok {
+			health = &h
+		}
+
+		result = append(result, dto.Provider{
+			Name:         info.Name,
+			Label:        info.Label,
+			Version:      info.Version,
+			Capabilities: info.GetCapabilities(),
+			Health:       health,
+		})
+	}
+
+	// Providers that are down can not be dispensed so they are listed from their last known state
+	for name, h := range providersHealth {
+		if _, ok := providers[name]; ok {
+			continue
+		}
+
+		label, version := server.ProviderManager.GetProviderLastKnownInfo(name)
+		health := h
+
 		result = append(result, dto.Provider{
-			Name:    info.Name,
-			Label:   info.Label,
-			Version: info.Version,
+			Name:    name,
+			Label:   label,
+			Version: version,
+			Health:  &health,
 		})
 	}
 
@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// RollbackProvider godoc
+//
+//	@Tags			provider
+//	@Summary		Rollback a provider
+//	@Description	Restore the previously installed version of a provider and pin it
+//	@Param			provider	path	string	true	"Provider to rollback"
+//	@Success		200
+//	@Router			/provider/{provider}/rollback [post]
+//
+//	@id				RollbackProvider
+func RollbackProvider(ctx *gin.Context) {
+	providerName := ctx.Param("provider")
+
+	server := server.GetInstance(nil)
+
+	err := server.ProviderManager.RollbackProvider(providerName)
+	if err != nil {
+		if manager.IsNoPreviousProviderVersion(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to rollback provider: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to rollback provider: %w", err))
+		return
+	}
+
+	p, err := server.ProviderManager.GetProvider(providerName)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get provider: %w", err))
+		return
+	}
+
+	info, err := (*p).GetInfo()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get provider info: %w", err))
+		return
+	}
+
+	// The restored version is pinned so that it is not replaced by the next update
+	err = server.PinProviderVersion(providerName, info.Version)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to pin provider version: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
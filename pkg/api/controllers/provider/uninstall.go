@@ -33,5 +33,11 @@ func UninstallProvider(ctx *gin.Context) {
 		return
 	}
 
+	err = server.PinProviderVersion(provider, "")
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove pinned provider version: %w", err))
+		return
+	}
+
 	ctx.Status(200)
 }
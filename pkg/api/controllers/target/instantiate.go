@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/providertargets/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// InstantiateTargetTemplate godoc
+//
+//	@Tags			target
+//	@Summary		Instantiate a target template
+//	@Description	Create a target from a template by setting the variables referenced by its options
+//	@Param			target		path	string							true	"Template name"
+//	@Param			instantiate	body	InstantiateTargetTemplateDTO	true	"Instantiate template"
+//	@Produce		json
+//	@Success		200	{object}	ProviderTarget
+//	@Router			/target/{target}/instantiate [post]
+//
+//	@id				InstantiateTargetTemplate
+func InstantiateTargetTemplate(ctx *gin.Context) {
+	templateName := ctx.Param("target")
+
+	var req dto.InstantiateTargetTemplateDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	target, err := server.ProviderTargetService.Instantiate(templateName, req.Name, req.Variables)
+	if err != nil {
+		if provider.IsTargetNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to instantiate template: %w", err))
+			return
+		}
+		if provider.IsTargetAlreadyExists(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to instantiate template: %w", err))
+			return
+		}
+		if provider.IsTargetIsNotTemplate(err) || provider.IsTargetOptionsError(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to instantiate template: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to instantiate template: %w", err))
+		return
+	}
+
+	ctx.JSON(200, target)
+}
@@ -18,7 +18,7 @@ import (
 //
 //	@Tags			target
 //	@Summary		Set a target
-//	@Description	Set a target
+//	@Description	Set a target. The target options are validated against the target manifest of the provider
 //	@Param			target	body	CreateProviderTargetDTO	true	"Target to set"
 //	@Success		201
 //	@Router			/target [put]
@@ -38,10 +38,17 @@ func SetTarget(ctx *gin.Context) {
 
 	err = server.ProviderTargetService.Save(target)
 	if err != nil {
+		if provider.IsTargetOptionsError(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to set target: %w", err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set target: %w", err))
 		return
 	}
 
+	// Operations queued on the target are started right away if its limit was raised
+	server.ProvisioningQueue.SetTargetLimit(target)
+
 	ctx.Status(201)
 }
 
@@ -70,6 +77,10 @@ func SetDefaultTarget(ctx *gin.Context) {
 
 	err = server.ProviderTargetService.SetDefault(target)
 	if err != nil {
+		if provider.IsTargetIsTemplate(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to set target to default: %w", err))
+			return
+		}
 		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to set project config to default: %s", err.Error()))
 		return
 	}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// GetTargetsStatus godoc
+//
+//	@Tags			target
+//	@Summary		Get targets status
+//	@Description	Get the running and queued workspace and project operations of each target
+//	@Produce		json
+//	@Success		200	{array}	TargetQueueStatus
+//	@Router			/target/status [get]
+//
+//	@id				GetTargetsStatus
+func GetTargetsStatus(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	isTemplate := false
+	targets, err := server.ProviderTargetService.List(&provider.TargetFilter{
+		Template: &isTemplate,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list targets: %w", err))
+		return
+	}
+
+	queueStatus := server.ProvisioningQueue.GetQueueStatus()
+
+	queues := map[string]provisioner.TargetQueueStatus{}
+	for _, status := range queueStatus {
+		queues[status.Target] = status
+	}
+
+	result := []provisioner.TargetQueueStatus{}
+	for _, target := range targets {
+		status, ok := queues[target.Name]
+		if !ok {
+			status = provisioner.TargetQueueStatus{
+				Target:  target.Name,
+				Running: []provisioner.TargetOperation{},
+				Queued:  []provisioner.TargetOperation{},
+			}
+		}
+
+		// The configured limit applies to the next operation even if the queue was created with a different one
+		status.MaxConcurrentOperations = 0
+		if target.MaxConcurrentOperations != nil {
+			status.MaxConcurrentOperations = *target.MaxConcurrentOperations
+		}
+
+		result = append(result, status)
+		delete(queues, target.Name)
+	}
+
+	// Operations on targets that were removed while the operations were pending
+	for _, status := range queueStatus {
+		if _, ok := queues[status.Target]; ok {
+			result = append(result, status)
+		}
+	}
+
+	ctx.JSON(200, result)
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/operations"
+	"github.com/gin-gonic/gin"
+)
+
+// isAsyncRequest reports whether the client asked for an operation instead of waiting for the action to finish
+func isAsyncRequest(ctx *gin.Context) (bool, error) {
+	asyncQuery := ctx.Query("async")
+	if asyncQuery == "" {
+		return false, nil
+	}
+
+	async, err := strconv.ParseBool(asyncQuery)
+	if err != nil {
+		return false, errors.New("invalid value for async flag")
+	}
+
+	return async, nil
+}
+
+// runAsync starts the action as an operation and responds with the pending operation right away
+func runAsync(ctx *gin.Context, operationType operation.OperationType, resourceId string, fn operations.OperationFunc) {
+	server := server.GetInstance(nil)
+
+	op := server.OperationService.Run(ctx.Request.Context(), operationType, resourceId, fn)
+
+	ctx.JSON(http.StatusAccepted, op)
+}
@@ -4,9 +4,12 @@
 package workspace
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
@@ -19,8 +22,10 @@ import (
 //	@Summary		Create a workspace
 //	@Description	Create a workspace
 //	@Param			workspace	body	CreateWorkspaceDTO	true	"Create workspace"
+//	@Param			async		query	bool				false	"Return an operation immediately instead of waiting for the workspace to be created"
 //	@Produce		json
 //	@Success		200	{object}	Workspace
+//	@Success		202	{object}	Operation
 //	@Router			/workspace [post]
 //
 //	@id				CreateWorkspace
@@ -32,14 +37,35 @@ func CreateWorkspace(ctx *gin.Context) {
 		return
 	}
 
+	async, err := isAsyncRequest(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
-	w, err := server.WorkspaceService.CreateWorkspace(ctx.Request.Context(), createWorkspaceReq)
+	if async {
+		runAsync(ctx, operation.OperationTypeCreateWorkspace, createWorkspaceReq.Id, func(opCtx context.Context) (interface{}, error) {
+			return server.WorkspaceService.CreateWorkspace(opCtx, createWorkspaceReq)
+		})
+		return
+	}
+
+	w, err := server.WorkspaceService.CreateWorkspace(context.WithoutCancel(ctx.Request.Context()), createWorkspaceReq)
 	if err != nil {
 		if workspaces.IsWorkspaceAlreadyExists(err) {
 			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("workspace already exists: %w", err))
 			return
 		}
+		if workspaces.IsInvalidWorkspaceExpiry(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) || provider.IsTargetIsTemplate(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create workspace: %w", err))
 		return
 	}
@@ -8,6 +8,7 @@ import (
 )
 
 type SetProjectState struct {
-	Uptime    uint64             `json:"uptime" validate:"required"`
-	GitStatus *project.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	Uptime       uint64             `json:"uptime" validate:"required"`
+	GitStatus    *project.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	LastActivity *string            `json:"lastActivity,omitempty" validate:"optional"`
 } // @name SetProjectState
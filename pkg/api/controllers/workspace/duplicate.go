@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// DuplicateWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Duplicate workspace
+//	@Description	Create a new workspace with the projects, env vars and build configs of an existing workspace
+//	@Param			workspaceId	path	string					true	"Workspace ID or Name"
+//	@Param			workspace	body	DuplicateWorkspaceDTO	true	"Duplicate workspace"
+//	@Param			async		query	bool					false	"Return an operation immediately instead of waiting for the workspace to be created"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Success		202	{object}	Operation
+//	@Router			/workspace/{workspaceId}/duplicate [post]
+//
+//	@id				DuplicateWorkspace
+func DuplicateWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var duplicateWorkspaceReq dto.DuplicateWorkspaceDTO
+	err := ctx.BindJSON(&duplicateWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	async, err := isAsyncRequest(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	if async {
+		runAsync(ctx, operation.OperationTypeCreateWorkspace, duplicateWorkspaceReq.Id, func(opCtx context.Context) (interface{}, error) {
+			return server.WorkspaceService.DuplicateWorkspace(opCtx, workspaceId, duplicateWorkspaceReq)
+		})
+		return
+	}
+
+	w, err := server.WorkspaceService.DuplicateWorkspace(context.WithoutCancel(ctx.Request.Context()), workspaceId, duplicateWorkspaceReq)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to duplicate workspace: %w", err))
+			return
+		}
+		if workspaces.IsWorkspaceAlreadyExists(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("workspace already exists: %w", err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to duplicate workspace: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to duplicate workspace: %w", err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
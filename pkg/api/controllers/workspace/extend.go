@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ExtendWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Extend workspace expiry
+//	@Description	Push back the time at which the workspace is removed
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			extend		body	ExtendWorkspaceDTO	true	"Extend workspace"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/{workspaceId}/extend [post]
+//
+//	@id				ExtendWorkspace
+func ExtendWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.ExtendWorkspaceDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid duration: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.ExtendWorkspace(context.WithoutCancel(ctx.Request.Context()), workspaceId, duration)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to extend workspace %s: %w", workspaceId, err))
+			return
+		}
+		if workspaces.IsWorkspaceDoesNotExpire(err) || workspaces.IsInvalidWorkspaceExpiry(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to extend workspace %s: %w", workspaceId, err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to extend workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// MigrateWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Migrate workspace
+//	@Description	Move a workspace and its projects to another target
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			migrate		body	MigrateWorkspaceDTO	true	"Migrate workspace"
+//	@Param			async		query	bool				false	"Return an operation immediately instead of waiting for the workspace to be migrated"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Success		202	{object}	Operation
+//	@Router			/workspace/{workspaceId}/migrate [post]
+//
+//	@id				MigrateWorkspace
+func MigrateWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var migrateWorkspaceReq dto.MigrateWorkspaceDTO
+	err := ctx.BindJSON(&migrateWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	async, err := isAsyncRequest(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	if async {
+		runAsync(ctx, operation.OperationTypeMigrateWorkspace, workspaceId, func(opCtx context.Context) (interface{}, error) {
+			return server.WorkspaceService.MigrateWorkspace(opCtx, workspaceId, migrateWorkspaceReq)
+		})
+		return
+	}
+
+	w, err := server.WorkspaceService.MigrateWorkspace(context.WithoutCancel(ctx.Request.Context()), workspaceId, migrateWorkspaceReq)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) || provider.IsTargetNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to migrate workspace: %w", err))
+			return
+		}
+		if workspaces.IsWorkspaceAlreadyOnTarget(err) || workspaces.IsSnapshotsNotConfigured(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to migrate workspace: %w", err))
+			return
+		}
+		if workspaces.IsInvalidStatusTransition(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to migrate workspace: %w", err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) || provider.IsTargetIsTemplate(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to migrate workspace: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to migrate workspace: %w", err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
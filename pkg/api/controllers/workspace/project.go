@@ -4,12 +4,15 @@
 package workspace
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	workspaces_dto "github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/gin-gonic/gin"
 )
@@ -39,11 +42,17 @@ func SetProjectState(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, &project.ProjectState{
+	state := &project.ProjectState{
 		Uptime:    setProjectStateDTO.Uptime,
 		UpdatedAt: time.Now().Format(time.RFC1123),
 		GitStatus: setProjectStateDTO.GitStatus,
-	})
+	}
+
+	if setProjectStateDTO.LastActivity != nil {
+		state.LastActivity = *setProjectStateDTO.LastActivity
+	}
+
+	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, state)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
 		return
@@ -51,3 +60,86 @@ func SetProjectState(ctx *gin.Context) {
 
 	ctx.Status(200)
 }
+
+// AddProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Add project to workspace
+//	@Description	Create and start a new project in an existing workspace
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			project		body	CreateProjectDTO	true	"Create project"
+//	@Produce		json
+//	@Success		200	{object}	Project
+//	@Router			/workspace/{workspaceId}/project [post]
+//
+//	@id				AddProject
+func AddProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var createProjectReq workspaces_dto.CreateProjectDTO
+	err := ctx.BindJSON(&createProjectReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	p, err := server.WorkspaceService.AddProject(context.WithoutCancel(ctx.Request.Context()), workspaceId, createProjectReq)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to add project: %w", err))
+			return
+		}
+		if workspaces.IsProjectAlreadyExists(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to add project: %w", err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to add project: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to add project: %w", err))
+		return
+	}
+
+	ctx.JSON(200, p)
+}
+
+// RemoveProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Remove project from workspace
+//	@Description	Destroy a project and remove it from the workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/project/{projectId} [delete]
+//
+//	@id				RemoveProject
+func RemoveProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.RemoveProject(context.WithoutCancel(ctx.Request.Context()), workspaceId, projectId)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to remove project %s: %w", projectId, err))
+			return
+		}
+		if workspaces.IsLastProject(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to remove project %s: %w", projectId, err))
+			return
+		}
+		if workspaces.IsInvalidStatusTransition(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to remove project %s: %w", projectId, err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,137 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	workspaces_dto "github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+	"github.com/gin-gonic/gin"
+)
+
+// SnapshotProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Snapshot project
+//	@Description	Commit the project container to an image and push it to the snapshot container registry
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			projectId	path	string				true	"Project ID"
+//	@Param			snapshot	body	CreateSnapshotDTO	true	"Create snapshot"
+//	@Produce		json
+//	@Success		200	{object}	ProjectSnapshot
+//	@Router			/workspace/{workspaceId}/{projectId}/snapshot [post]
+//
+//	@id				SnapshotProject
+func SnapshotProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var createSnapshotReq workspaces_dto.CreateSnapshotDTO
+	err := ctx.BindJSON(&createSnapshotReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	snap, err := server.WorkspaceService.SnapshotProject(context.WithoutCancel(ctx.Request.Context()), workspaceId, projectId, createSnapshotReq)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to snapshot project %s: %w", projectId, err))
+			return
+		}
+		if workspaces.IsSnapshotsNotConfigured(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to snapshot project %s: %w", projectId, err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to snapshot project %s: %w", projectId, err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to snapshot project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, snap)
+}
+
+// ListSnapshots 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List snapshots
+//	@Description	List project snapshots of a workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Produce		json
+//	@Success		200	{array}	ProjectSnapshot
+//	@Router			/workspace/{workspaceId}/snapshot [get]
+//
+//	@id				ListSnapshots
+func ListSnapshots(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	snapshots, err := server.WorkspaceService.ListSnapshots(workspaceId)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to list snapshots: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list snapshots: %w", err))
+		return
+	}
+
+	ctx.JSON(200, snapshots)
+}
+
+// RestoreSnapshot 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Restore snapshot
+//	@Description	Create and start a new project in the workspace from a project snapshot
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			restore		body	RestoreSnapshotDTO	true	"Restore snapshot"
+//	@Produce		json
+//	@Success		200	{object}	Project
+//	@Router			/workspace/{workspaceId}/restore [post]
+//
+//	@id				RestoreSnapshot
+func RestoreSnapshot(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var restoreSnapshotReq workspaces_dto.RestoreSnapshotDTO
+	err := ctx.BindJSON(&restoreSnapshotReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	p, err := server.WorkspaceService.RestoreSnapshot(context.WithoutCancel(ctx.Request.Context()), workspaceId, restoreSnapshotReq)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || snapshot.IsSnapshotNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to restore snapshot: %w", err))
+			return
+		}
+		if workspaces.IsProjectAlreadyExists(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to restore snapshot: %w", err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to restore snapshot: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to restore snapshot: %w", err))
+		return
+	}
+
+	ctx.JSON(200, p)
+}
@@ -4,10 +4,13 @@
 package workspace
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/operation"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,18 +19,38 @@ import (
 //	@Tags			workspace
 //	@Summary		Start workspace
 //	@Description	Start workspace
-//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			async		query		bool	false	"Return an operation immediately instead of waiting for the workspace to start"
+//	@Produce		json
 //	@Success		200
+//	@Success		202			{object}	Operation
 //	@Router			/workspace/{workspaceId}/start [post]
 //
 //	@id				StartWorkspace
 func StartWorkspace(ctx *gin.Context) {
 	workspaceId := ctx.Param("workspaceId")
 
+	async, err := isAsyncRequest(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
-	err := server.WorkspaceService.StartWorkspace(ctx.Request.Context(), workspaceId)
+	if async {
+		runAsync(ctx, operation.OperationTypeStartWorkspace, workspaceId, func(opCtx context.Context) (interface{}, error) {
+			return nil, server.WorkspaceService.StartWorkspace(opCtx, workspaceId)
+		})
+		return
+	}
+
+	err = server.WorkspaceService.StartWorkspace(context.WithoutCancel(ctx.Request.Context()), workspaceId)
 	if err != nil {
+		if workspaces.IsInvalidStatusTransition(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to start workspace %s: %w", workspaceId, err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to start workspace %s: %w", workspaceId, err))
 		return
 	}
@@ -52,8 +75,12 @@ func StartProject(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	err := server.WorkspaceService.StartProject(ctx.Request.Context(), workspaceId, projectId)
+	err := server.WorkspaceService.StartProject(context.WithoutCancel(ctx.Request.Context()), workspaceId, projectId)
 	if err != nil {
+		if workspaces.IsInvalidStatusTransition(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to start project %s: %w", projectId, err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to start project %s: %w", projectId, err))
 		return
 	}
@@ -4,10 +4,13 @@
 package workspace
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/operation"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,18 +19,42 @@ import (
 //	@Tags			workspace
 //	@Summary		Stop workspace
 //	@Description	Stop workspace
-//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			async		query		bool	false	"Return an operation immediately instead of waiting for the workspace to stop"
+//	@Produce		json
 //	@Success		200
+//	@Success		202			{object}	Operation
 //	@Router			/workspace/{workspaceId}/stop [post]
 //
 //	@id				StopWorkspace
 func StopWorkspace(ctx *gin.Context) {
 	workspaceId := ctx.Param("workspaceId")
 
+	async, err := isAsyncRequest(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
-	err := server.WorkspaceService.StopWorkspace(ctx.Request.Context(), workspaceId)
+	if async {
+		runAsync(ctx, operation.OperationTypeStopWorkspace, workspaceId, func(opCtx context.Context) (interface{}, error) {
+			return nil, server.WorkspaceService.StopWorkspace(opCtx, workspaceId)
+		})
+		return
+	}
+
+	err = server.WorkspaceService.StopWorkspace(context.WithoutCancel(ctx.Request.Context()), workspaceId)
 	if err != nil {
+		if workspaces.IsInvalidStatusTransition(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
 		return
 	}
@@ -52,8 +79,16 @@ func StopProject(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	err := server.WorkspaceService.StopProject(ctx.Request.Context(), workspaceId, projectId)
+	err := server.WorkspaceService.StopProject(context.WithoutCancel(ctx.Request.Context()), workspaceId, projectId)
 	if err != nil {
+		if workspaces.IsInvalidStatusTransition(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to stop project %s: %w", projectId, err))
+			return
+		}
+		if workspaces.IsCapabilityNotSupported(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to stop project %s: %w", projectId, err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop project %s: %w", projectId, err))
 		return
 	}
@@ -22,6 +22,23 @@ func GitAddFiles(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
 
+// GitApplyPatch			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Apply patch
+//	@Description	Apply a patch to the working tree of git repository inside workspace project
+//	@Produce		json
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			projectId	path	string			true	"Project ID"
+//	@Param			params		body	GitApplyRequest	true	"GitApplyRequest"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/git/apply [post]
+//
+//	@id				GitApplyPatch
+func GitApplyPatch(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
 // GitCloneRepository			godoc
 //
 //	@Tags			workspace toolbox
@@ -73,6 +90,24 @@ func GitCreateBranch(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
 
+// GitDiff			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Get git diff
+//	@Description	Get a patch of uncommitted and unpushed changes from git repository inside workspace project
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			path		query		string	true	"Path to git repository"
+//	@Param			base		query		string	false	"Ref to compute the merge base with, defaults to HEAD"
+//	@Success		200			{object}	GitDiffResponse
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/git/diff [get]
+//
+//	@id				GitDiff
+func GitDiff(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
 // GitCommitHistory			godoc
 //
 //	@Tags			workspace toolbox
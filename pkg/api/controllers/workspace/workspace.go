@@ -4,12 +4,14 @@
 package workspace
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/gin-gonic/gin"
 )
 
@@ -113,12 +115,16 @@ func RemoveWorkspace(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	if force {
-		err = server.WorkspaceService.ForceRemoveWorkspace(ctx.Request.Context(), workspaceId)
+		err = server.WorkspaceService.ForceRemoveWorkspace(context.WithoutCancel(ctx.Request.Context()), workspaceId)
 	} else {
-		err = server.WorkspaceService.RemoveWorkspace(ctx.Request.Context(), workspaceId)
+		err = server.WorkspaceService.RemoveWorkspace(context.WithoutCancel(ctx.Request.Context()), workspaceId)
 	}
 
 	if err != nil {
+		if workspaces.IsInvalidStatusTransition(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to remove workspace: %w", err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove workspace: %w", err))
 		return
 	}
@@ -254,6 +254,30 @@ const docTemplate = `{
                 }
             }
         },
+        "/build/{buildId}/cancel": {
+            "post": {
+                "description": "Cancel a build that is pending to run, running or being published",
+                "tags": [
+                    "build"
+                ],
+                "summary": "Cancel build",
+                "operationId": "CancelBuild",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
         "/container-registry": {
             "get": {
                 "description": "List container registries",
@@ -361,6 +385,40 @@ const docTemplate = `{
                 }
             }
         },
+        "/events": {
+            "get": {
+                "description": "Stream server events over a WebSocket, or as server-sent events if the request is not a WebSocket upgrade",
+                "produces": [
+                    "application/json",
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Stream events",
+                "operationId": "StreamEvents",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only receive events of the given types",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Event"
+                        }
+                    }
+                }
+            }
+        },
         "/gitprovider": {
             "get": {
                 "description": "List Git providers",
@@ -860,6 +918,60 @@ const docTemplate = `{
                 }
             }
         },
+        "/operation/{operationId}": {
+            "get": {
+                "description": "Get the status, progress and result of an asynchronous operation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "operation"
+                ],
+                "summary": "Get operation",
+                "operationId": "GetOperation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Operation ID",
+                        "name": "operationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Operation"
+                        }
+                    }
+                }
+            }
+        },
+        "/operation/{operationId}/cancel": {
+            "post": {
+                "description": "Cancel a running asynchronous operation",
+                "tags": [
+                    "operation"
+                ],
+                "summary": "Cancel operation",
+                "operationId": "CancelOperation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Operation ID",
+                        "name": "operationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/profile": {
             "get": {
                 "description": "Get profile data",
@@ -1332,6 +1444,30 @@ const docTemplate = `{
                 }
             }
         },
+        "/provider/{provider}/rollback": {
+            "post": {
+                "description": "Restore the previously installed version of a provider and pin it",
+                "tags": [
+                    "provider"
+                ],
+                "summary": "Rollback a provider",
+                "operationId": "RollbackProvider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider to rollback",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/provider/{provider}/target-manifest": {
             "get": {
                 "description": "Get provider target manifest",
@@ -1533,7 +1669,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Set a target",
+                "description": "Set a target. The target options are validated against the target manifest of the provider",
                 "tags": [
                     "target"
                 ],
@@ -1557,6 +1693,30 @@ const docTemplate = `{
                 }
             }
         },
+        "/target/status": {
+            "get": {
+                "description": "Get the running and queued workspace and project operations of each target",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "target"
+                ],
+                "summary": "Get targets status",
+                "operationId": "GetTargetsStatus",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/TargetQueueStatus"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/target/{target}": {
             "delete": {
                 "description": "Remove a target",
@@ -1581,6 +1741,45 @@ const docTemplate = `{
                 }
             }
         },
+        "/target/{target}/instantiate": {
+            "post": {
+                "description": "Create a target from a template by setting the variables referenced by its options",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "target"
+                ],
+                "summary": "Instantiate a target template",
+                "operationId": "InstantiateTargetTemplate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template name",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Instantiate template",
+                        "name": "instantiate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/InstantiateTargetTemplateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProviderTarget"
+                        }
+                    }
+                }
+            }
+        },
         "/target/{target}/set-default": {
             "patch": {
                 "description": "Set target to default",
@@ -1605,6 +1804,120 @@ const docTemplate = `{
                 }
             }
         },
+        "/webhook": {
+            "get": {
+                "description": "List webhooks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "List webhooks",
+                "operationId": "ListWebhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Webhook"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a webhook",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Create a webhook",
+                "operationId": "CreateWebhook",
+                "parameters": [
+                    {
+                        "description": "Create webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWebhookDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{webhookId}": {
+            "delete": {
+                "description": "Delete a webhook and its delivery history",
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Delete a webhook",
+                "operationId": "DeleteWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/webhook/{webhookId}/deliveries": {
+            "get": {
+                "description": "List the delivery history of a webhook, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "List webhook deliveries",
+                "operationId": "ListWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookDelivery"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/workspace": {
             "get": {
                 "description": "List workspaces",
@@ -1655,6 +1968,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/CreateWorkspaceDTO"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return an operation immediately instead of waiting for the workspace to be created",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1663,6 +1982,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/Workspace"
                         }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/Operation"
+                        }
                     }
                 }
             }
@@ -1731,14 +2056,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspaceId}/start": {
+        "/workspace/{workspaceId}/duplicate": {
             "post": {
-                "description": "Start workspace",
+                "description": "Create a new workspace with the projects, env vars and build configs of an existing workspace",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Start workspace",
-                "operationId": "StartWorkspace",
+                "summary": "Duplicate workspace",
+                "operationId": "DuplicateWorkspace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1746,23 +2074,360 @@ const docTemplate = `{
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/workspace/{workspaceId}/stop": {
+                    },
+                    {
+                        "description": "Duplicate workspace",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/DuplicateWorkspaceDTO"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return an operation immediately instead of waiting for the workspace to be created",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/Operation"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/extend": {
+            "post": {
+                "description": "Push back the time at which the workspace is removed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Extend workspace expiry",
+                "operationId": "ExtendWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Extend workspace",
+                        "name": "extend",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExtendWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/migrate": {
+            "post": {
+                "description": "Move a workspace and its projects to another target",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Migrate workspace",
+                "operationId": "MigrateWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Migrate workspace",
+                        "name": "migrate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MigrateWorkspaceDTO"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return an operation immediately instead of waiting for the workspace to be migrated",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/Operation"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/project": {
+            "post": {
+                "description": "Create and start a new project in an existing workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Add project to workspace",
+                "operationId": "AddProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create project",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProjectDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Project"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/project/{projectId}": {
+            "delete": {
+                "description": "Destroy a project and remove it from the workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Remove project from workspace",
+                "operationId": "RemoveProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/restore": {
+            "post": {
+                "description": "Create and start a new project in the workspace from a project snapshot",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Restore snapshot",
+                "operationId": "RestoreSnapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Restore snapshot",
+                        "name": "restore",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RestoreSnapshotDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Project"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/snapshot": {
+            "get": {
+                "description": "List project snapshots of a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List snapshots",
+                "operationId": "ListSnapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ProjectSnapshot"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/start": {
+            "post": {
+                "description": "Start workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Start workspace",
+                "operationId": "StartWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return an operation immediately instead of waiting for the workspace to start",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/Operation"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/stop": {
+            "post": {
+                "description": "Stop workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Stop workspace",
+                "operationId": "StopWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return an operation immediately instead of waiting for the workspace to stop",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/Operation"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/snapshot": {
             "post": {
-                "description": "Stop workspace",
+                "description": "Commit the project container to an image and push it to the snapshot container registry",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Stop workspace",
-                "operationId": "StopWorkspace",
+                "summary": "Snapshot project",
+                "operationId": "SnapshotProject",
                 "parameters": [
                     {
                         "type": "string",
@@ -1770,11 +2435,30 @@ const docTemplate = `{
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create snapshot",
+                        "name": "snapshot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateSnapshotDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectSnapshot"
+                        }
                     }
                 }
             }
@@ -2454,6 +3138,49 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/{projectId}/toolbox/git/apply": {
+            "post": {
+                "description": "Apply a patch to the working tree of git repository inside workspace project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Apply patch",
+                "operationId": "GitApplyPatch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitApplyRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitApplyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/{projectId}/toolbox/git/branches": {
             "get": {
                 "description": "Get branch list from git repository inside workspace project",
@@ -2628,6 +3355,56 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/{projectId}/toolbox/git/diff": {
+            "get": {
+                "description": "Get a patch of uncommitted and unpushed changes from git repository inside workspace project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Get git diff",
+                "operationId": "GitDiff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path to git repository",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ref to compute the merge base with, defaults to HEAD",
+                        "name": "base",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GitDiffResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/{projectId}/toolbox/git/history": {
             "get": {
                 "description": "Get commit history from git repository inside workspace project",
@@ -3258,11 +4035,18 @@ const docTemplate = `{
                 "envVars",
                 "id",
                 "prebuildId",
+                "priority",
                 "repository",
                 "state",
                 "updatedAt"
             ],
             "properties": {
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BuildAttempt"
+                    }
+                },
                 "buildConfig": {
                     "$ref": "#/definitions/BuildConfig"
                 },
@@ -3284,12 +4068,24 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "lastErrorCategory": {
+                    "$ref": "#/definitions/build.BuildErrorCategory"
+                },
                 "prebuildId": {
                     "type": "string"
                 },
+                "priority": {
+                    "$ref": "#/definitions/build.BuildPriority"
+                },
+                "queued": {
+                    "type": "integer"
+                },
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
+                "retryAt": {
+                    "type": "string"
+                },
                 "state": {
                     "$ref": "#/definitions/build.BuildState"
                 },
@@ -3301,6 +4097,27 @@ const docTemplate = `{
                 }
             }
         },
+        "BuildAttempt": {
+            "type": "object",
+            "required": [
+                "number",
+                "startedAt"
+            ],
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "errorCategory": {
+                    "$ref": "#/definitions/build.BuildErrorCategory"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "type": "string"
+                }
+            }
+        },
         "BuildConfig": {
             "type": "object",
             "properties": {
@@ -3309,6 +4126,12 @@ const docTemplate = `{
                 },
                 "devcontainer": {
                     "$ref": "#/definitions/DevcontainerConfig"
+                },
+                "dockerfile": {
+                    "$ref": "#/definitions/DockerfileConfig"
+                },
+                "language": {
+                    "$ref": "#/definitions/LanguageConfig"
                 }
             }
         },
@@ -3502,6 +4325,9 @@ const docTemplate = `{
                 "gitProviderConfigId": {
                     "type": "string"
                 },
+                "idleTimeout": {
+                    "type": "integer"
+                },
                 "image": {
                     "type": "string"
                 },
@@ -3536,6 +4362,9 @@ const docTemplate = `{
                 "gitProviderConfigId": {
                     "type": "string"
                 },
+                "idleTimeout": {
+                    "type": "integer"
+                },
                 "image": {
                     "type": "string"
                 },
@@ -3569,6 +4398,15 @@ const docTemplate = `{
                 "providerInfo"
             ],
             "properties": {
+                "idleTimeout": {
+                    "type": "integer"
+                },
+                "isTemplate": {
+                    "type": "boolean"
+                },
+                "maxConcurrentOperations": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -3580,6 +4418,37 @@ const docTemplate = `{
                 }
             }
         },
+        "CreateSnapshotDTO": {
+            "type": "object",
+            "properties": {
+                "includeVolume": {
+                    "description": "Copy the project directory into the snapshot in addition to the container filesystem",
+                    "type": "boolean"
+                }
+            }
+        },
+        "CreateWebhookDTO": {
+            "type": "object",
+            "required": [
+                "secret",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "description": "Event types delivered to the webhook, all events are delivered if empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/EventType"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "CreateWorkspaceDTO": {
             "type": "object",
             "required": [
@@ -3589,33 +4458,158 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CreateProjectDTO"
+                    }
+                },
+                "target": {
+                    "type": "string"
+                },
+                "ttl": {
+                    "description": "Duration (e.g. \"24h\") after which the workspace is removed. Mutually exclusive with ExpiresAt",
+                    "type": "string"
+                }
+            }
+        },
+        "DevcontainerConfig": {
+            "type": "object",
+            "required": [
+                "filePath"
+            ],
+            "properties": {
+                "filePath": {
+                    "type": "string"
+                }
+            }
+        },
+        "DockerfileConfig": {
+            "type": "object",
+            "required": [
+                "filePath"
+            ],
+            "properties": {
+                "buildArgs": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "context": {
+                    "type": "string",
+                    "description": "Build context relative to the project directory. Defaults to the project directory"
+                },
+                "filePath": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string",
+                    "description": "Stage of a multi-stage Dockerfile to build"
+                }
+            }
+        },
+        "DuplicateWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "commits": {
+                    "description": "Commits to check out in the duplicated projects, keyed by project name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "Event": {
+            "type": "object",
+            "required": [
+                "id",
+                "timestamp",
+                "type"
+            ],
+            "properties": {
+                "buildId": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "prebuildId": {
                     "type": "string"
                 },
-                "projects": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/CreateProjectDTO"
-                    }
+                "projectName": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "state": {
+                    "description": "New status of the project or state of the build",
+                    "type": "string"
                 },
                 "target": {
                     "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/EventType"
+                },
+                "workspaceId": {
+                    "type": "string"
                 }
             }
         },
-        "DevcontainerConfig": {
-            "type": "object",
-            "required": [
-                "filePath"
+        "EventType": {
+            "type": "string",
+            "enum": [
+                "workspace.created",
+                "workspace.started",
+                "workspace.stopped",
+                "workspace.error",
+                "project.status-changed",
+                "build.state-changed",
+                "build.failed",
+                "prebuild.triggered",
+                "provider.installed",
+                "target.changed"
             ],
-            "properties": {
-                "filePath": {
-                    "type": "string"
-                }
-            }
+            "x-enum-varnames": [
+                "EventTypeWorkspaceCreated",
+                "EventTypeWorkspaceStarted",
+                "EventTypeWorkspaceStopped",
+                "EventTypeWorkspaceError",
+                "EventTypeProjectStatusChanged",
+                "EventTypeBuildStateChanged",
+                "EventTypeBuildFailed",
+                "EventTypePrebuildTriggered",
+                "EventTypeProviderInstalled",
+                "EventTypeTargetChanged"
+            ]
         },
         "ExecuteRequest": {
             "type": "object",
@@ -3647,6 +4641,18 @@ const docTemplate = `{
                 }
             }
         },
+        "ExtendWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "duration"
+            ],
+            "properties": {
+                "duration": {
+                    "description": "Duration (e.g. \"2h\") by which the workspace expiry is pushed back",
+                    "type": "string"
+                }
+            }
+        },
         "FRPSConfig": {
             "type": "object",
             "required": [
@@ -3782,6 +4788,21 @@ const docTemplate = `{
                 }
             }
         },
+        "GitApplyRequest": {
+            "type": "object",
+            "required": [
+                "patch",
+                "path"
+            ],
+            "properties": {
+                "patch": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
         "GitBranch": {
             "type": "object",
             "required": [
@@ -3900,6 +4921,22 @@ const docTemplate = `{
                 }
             }
         },
+        "GitDiffResponse": {
+            "type": "object",
+            "required": [
+                "base",
+                "patch"
+            ],
+            "properties": {
+                "base": {
+                    "description": "Commit the patch applies to",
+                    "type": "string"
+                },
+                "patch": {
+                    "type": "string"
+                }
+            }
+        },
         "GitNamespace": {
             "type": "object",
             "required": [
@@ -4024,6 +5061,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "keepBranch": {
+                    "description": "Moves the cloned branch to the commit of a commit clone instead of checking the commit out with a detached HEAD",
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -4104,6 +5145,17 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "allowUnsigned": {
+                    "description": "Install the provider even if it has no checksum or signature",
+                    "type": "boolean"
+                },
+                "checksums": {
+                    "description": "Hex encoded SHA-256 checksums of the provider binaries",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "downloadUrls": {
                     "type": "object",
                     "additionalProperties": {
@@ -4112,6 +5164,54 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "pin": {
+                    "description": "Pin the version in the server config so that it is kept on restart and skipped by updates",
+                    "type": "boolean"
+                },
+                "signatures": {
+                    "description": "Base64 encoded ed25519 signatures of the provider binaries",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "InstantiateTargetTemplateDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name of the new target",
+                    "type": "string"
+                },
+                "variables": {
+                    "description": "Variable name -> value",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "LanguageConfig": {
+            "type": "object",
+            "required": [
+                "languages"
+            ],
+            "properties": {
+                "languages": {
+                    "description": "Languages of the project, e.g. go, node, python, rust or java",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -4301,6 +5401,28 @@ const docTemplate = `{
                 }
             }
         },
+        "MigrateWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "target"
+            ],
+            "properties": {
+                "commits": {
+                    "description": "Commits to check out in the migrated projects, keyed by project name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "target": {
+                    "type": "string"
+                },
+                "useSnapshots": {
+                    "description": "Carry the project containers over to the new target as snapshot images",
+                    "type": "boolean"
+                }
+            }
+        },
         "NetworkKey": {
             "type": "object",
             "required": [
@@ -4312,6 +5434,78 @@ const docTemplate = `{
                 }
             }
         },
+        "Operation": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "resourceId",
+                "state",
+                "type",
+                "updatedAt"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "progress": {
+                    "description": "Describes the step the operation is currently executing",
+                    "type": "string"
+                },
+                "resourceId": {
+                    "description": "ID or name of the workspace the operation acts on",
+                    "type": "string"
+                },
+                "result": {},
+                "state": {
+                    "$ref": "#/definitions/OperationState"
+                },
+                "type": {
+                    "$ref": "#/definitions/OperationType"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "OperationState": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "success",
+                "error",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "OperationStatePending",
+                "OperationStateRunning",
+                "OperationStateSuccess",
+                "OperationStateError",
+                "OperationStateCancelled"
+            ]
+        },
+        "OperationType": {
+            "type": "string",
+            "enum": [
+                "create-workspace",
+                "start-workspace",
+                "stop-workspace",
+                "migrate-workspace"
+            ],
+            "x-enum-varnames": [
+                "OperationTypeCreateWorkspace",
+                "OperationTypeStartWorkspace",
+                "OperationTypeStopWorkspace",
+                "OperationTypeMigrateWorkspace"
+            ]
+        },
         "Position": {
             "type": "object",
             "required": [
@@ -4410,6 +5604,7 @@ const docTemplate = `{
                 "image",
                 "name",
                 "repository",
+                "status",
                 "target",
                 "user",
                 "workspaceId"
@@ -4427,18 +5622,31 @@ const docTemplate = `{
                 "gitProviderConfigId": {
                     "type": "string"
                 },
+                "idleTimeout": {
+                    "type": "integer"
+                },
                 "image": {
                     "type": "string"
                 },
+                "lastError": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
+                "snapshotId": {
+                    "description": "Id of the snapshot the project was restored from. The project runs the snapshot image instead of being built",
+                    "type": "string"
+                },
                 "state": {
                     "$ref": "#/definitions/ProjectState"
                 },
+                "status": {
+                    "$ref": "#/definitions/ProjectStatus"
+                },
                 "target": {
                     "type": "string"
                 },
@@ -4476,93 +5684,238 @@ const docTemplate = `{
                 "gitProviderConfigId": {
                     "type": "string"
                 },
-                "image": {
+                "idleTimeout": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prebuilds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PrebuildConfig"
+                    }
+                },
+                "repositoryUrl": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectDirResponse": {
+            "type": "object",
+            "properties": {
+                "dir": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectInfo": {
+            "type": "object",
+            "required": [
+                "created",
+                "isRunning",
+                "name",
+                "workspaceId"
+            ],
+            "properties": {
+                "created": {
+                    "type": "string"
+                },
+                "isRunning": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "providerMetadata": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectSnapshot": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "envVars",
+                "id",
+                "image",
+                "includesVolume",
+                "projectName",
+                "repository",
+                "user",
+                "workspaceId"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "gitProviderConfigId": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 },
-                "name": {
+                "image": {
+                    "description": "Image in the container registry holding the project container filesystem",
                     "type": "string"
                 },
-                "prebuilds": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/PrebuildConfig"
-                    }
+                "includesVolume": {
+                    "description": "Whether the project directory is part of the image",
+                    "type": "boolean"
                 },
-                "repositoryUrl": {
+                "projectName": {
                     "type": "string"
                 },
+                "repository": {
+                    "$ref": "#/definitions/GitRepository"
+                },
                 "user": {
                     "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
                 }
             }
         },
-        "ProjectDirResponse": {
+        "ProjectState": {
             "type": "object",
+            "required": [
+                "updatedAt",
+                "uptime"
+            ],
             "properties": {
-                "dir": {
+                "gitStatus": {
+                    "$ref": "#/definitions/GitStatus"
+                },
+                "lastActivity": {
+                    "type": "string"
+                },
+                "updatedAt": {
                     "type": "string"
+                },
+                "uptime": {
+                    "type": "integer"
                 }
             }
         },
-        "ProjectInfo": {
+        "ProjectStatus": {
+            "type": "string",
+            "enum": [
+                "creating",
+                "starting",
+                "running",
+                "stopping",
+                "stopped",
+                "deleting",
+                "error",
+                "unknown"
+            ],
+            "x-enum-comments": {
+                "ProjectStatusUnknown": "Status of projects persisted before statuses were introduced"
+            },
+            "x-enum-varnames": [
+                "ProjectStatusCreating",
+                "ProjectStatusStarting",
+                "ProjectStatusRunning",
+                "ProjectStatusStopping",
+                "ProjectStatusStopped",
+                "ProjectStatusDeleting",
+                "ProjectStatusError",
+                "ProjectStatusUnknown"
+            ]
+        },
+        "Provider": {
             "type": "object",
             "required": [
-                "created",
-                "isRunning",
+                "capabilities",
                 "name",
-                "workspaceId"
+                "version"
             ],
             "properties": {
-                "created": {
-                    "type": "string"
+                "capabilities": {
+                    "$ref": "#/definitions/ProviderCapabilities"
                 },
-                "isRunning": {
-                    "type": "boolean"
+                "health": {
+                    "$ref": "#/definitions/ProviderHealth"
                 },
-                "name": {
+                "label": {
                     "type": "string"
                 },
-                "providerMetadata": {
+                "name": {
                     "type": "string"
                 },
-                "workspaceId": {
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "ProjectState": {
+        "ProviderCapabilities": {
             "type": "object",
             "required": [
-                "updatedAt",
-                "uptime"
+                "multiProject",
+                "portExposure",
+                "snapshots",
+                "stopStart"
             ],
             "properties": {
-                "gitStatus": {
-                    "$ref": "#/definitions/GitStatus"
+                "multiProject": {
+                    "description": "Workspaces can have more than one project",
+                    "type": "boolean"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "portExposure": {
+                    "description": "Project ports can be exposed outside of the Daytona network",
+                    "type": "boolean"
                 },
-                "uptime": {
-                    "type": "integer"
+                "snapshots": {
+                    "description": "The project container can be committed to a snapshot image",
+                    "type": "boolean"
+                },
+                "stopStart": {
+                    "description": "Workspaces and projects can be stopped and started again",
+                    "type": "boolean"
                 }
             }
         },
-        "Provider": {
+        "ProviderHealth": {
             "type": "object",
             "required": [
-                "name",
-                "version"
+                "crashCount",
+                "healthy",
+                "lastCheckedAt"
             ],
             "properties": {
-                "label": {
-                    "type": "string"
+                "crashCount": {
+                    "type": "integer"
                 },
-                "name": {
+                "healthy": {
+                    "type": "boolean"
+                },
+                "lastCheckedAt": {
                     "type": "string"
                 },
-                "version": {
+                "lastError": {
                     "type": "string"
+                },
+                "unmetRequirements": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -4575,9 +5928,21 @@ const docTemplate = `{
                 "providerInfo"
             ],
             "properties": {
+                "idleTimeout": {
+                    "description": "Minutes without activity after which projects on the target are stopped",
+                    "type": "integer"
+                },
                 "isDefault": {
                     "type": "boolean"
                 },
+                "isTemplate": {
+                    "description": "Option values of a template can reference variables, e.g. ${region}, that are set when the template is instantiated.\nTemplates can not be used to create workspaces",
+                    "type": "boolean"
+                },
+                "maxConcurrentOperations": {
+                    "description": "Maximum number of workspace and project operations that run on the target at the same time.\nOperations over the limit are queued. Not limited if not set",
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -4643,6 +6008,22 @@ const docTemplate = `{
                 }
             }
         },
+        "RestoreSnapshotDTO": {
+            "type": "object",
+            "required": [
+                "projectName",
+                "snapshotId"
+            ],
+            "properties": {
+                "projectName": {
+                    "description": "Name of the project created from the snapshot",
+                    "type": "string"
+                },
+                "snapshotId": {
+                    "type": "string"
+                }
+            }
+        },
         "Sample": {
             "type": "object",
             "required": [
@@ -4734,6 +6115,24 @@ const docTemplate = `{
                 "logFile": {
                     "$ref": "#/definitions/LogFileConfig"
                 },
+                "masterKeyFile": {
+                    "description": "Path of the file holding the master key used to encrypt secrets at rest. Defaults to master.key in the server config dir",
+                    "type": "string"
+                },
+                "pinnedProviderVersions": {
+                    "description": "Provider versions that are kept on restart and skipped by provider updates",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "projectIdleTimeout": {
+                    "type": "integer"
+                },
+                "providerPublicKey": {
+                    "description": "Base64 encoded ed25519 public key used to verify provider signatures",
+                    "type": "string"
+                },
                 "providersDir": {
                     "type": "string"
                 },
@@ -4790,6 +6189,9 @@ const docTemplate = `{
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "lastActivity": {
+                    "type": "string"
+                },
                 "uptime": {
                     "type": "integer"
                 }
@@ -4829,18 +6231,173 @@ const docTemplate = `{
                 "UpdatedButUnmerged"
             ]
         },
+        "TargetOperation": {
+            "type": "object",
+            "required": [
+                "name",
+                "queuedAt",
+                "workspaceId"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Provisioner method, e.g. CreateProject",
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "queuedAt": {
+                    "type": "string"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "TargetQueueStatus": {
+            "type": "object",
+            "required": [
+                "maxConcurrentOperations",
+                "queued",
+                "running",
+                "target"
+            ],
+            "properties": {
+                "maxConcurrentOperations": {
+                    "description": "Zero if the number of concurrent operations is not limited",
+                    "type": "integer"
+                },
+                "queued": {
+                    "description": "Operations waiting for a free slot, in the order they will be started",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TargetOperation"
+                    }
+                },
+                "running": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TargetOperation"
+                    }
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "Webhook": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "events",
+                "id",
+                "url"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "events": {
+                    "description": "Event types delivered to the webhook, all events are delivered if empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/EventType"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "WebhookDelivery": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "createdAt",
+                "eventId",
+                "eventType",
+                "id",
+                "status",
+                "updatedAt",
+                "webhookId"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Error of the last attempt",
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "$ref": "#/definitions/EventType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "nextAttemptAt": {
+                    "description": "Time of the next attempt of a pending delivery",
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/WebhookDeliveryStatus"
+                },
+                "statusCode": {
+                    "description": "HTTP status code of the last attempt",
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "webhookId": {
+                    "type": "string"
+                }
+            }
+        },
+        "WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "success",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "DeliveryStatusPending",
+                "DeliveryStatusSuccess",
+                "DeliveryStatusFailed"
+            ]
+        },
         "Workspace": {
             "type": "object",
             "required": [
                 "id",
                 "name",
                 "projects",
+                "status",
                 "target"
             ],
             "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "lastError": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -4850,6 +6407,9 @@ const docTemplate = `{
                         "$ref": "#/definitions/Project"
                     }
                 },
+                "status": {
+                    "$ref": "#/definitions/WorkspaceStatus"
+                },
                 "target": {
                     "type": "string"
                 }
@@ -4861,15 +6421,22 @@ const docTemplate = `{
                 "id",
                 "name",
                 "projects",
+                "status",
                 "target"
             ],
             "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "info": {
                     "$ref": "#/definitions/WorkspaceInfo"
                 },
+                "lastError": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -4879,6 +6446,9 @@ const docTemplate = `{
                         "$ref": "#/definitions/Project"
                     }
                 },
+                "status": {
+                    "$ref": "#/definitions/WorkspaceStatus"
+                },
                 "target": {
                     "type": "string"
                 }
@@ -4905,6 +6475,32 @@ const docTemplate = `{
                 }
             }
         },
+        "WorkspaceStatus": {
+            "type": "string",
+            "enum": [
+                "creating",
+                "starting",
+                "running",
+                "stopping",
+                "stopped",
+                "deleting",
+                "error",
+                "unknown"
+            ],
+            "x-enum-comments": {
+                "WorkspaceStatusUnknown": "Status of workspaces persisted before statuses were introduced"
+            },
+            "x-enum-varnames": [
+                "WorkspaceStatusCreating",
+                "WorkspaceStatusStarting",
+                "WorkspaceStatusRunning",
+                "WorkspaceStatusStopping",
+                "WorkspaceStatusStopped",
+                "WorkspaceStatusDeleting",
+                "WorkspaceStatusError",
+                "WorkspaceStatusUnknown"
+            ]
+        },
         "apikey.ApiKeyType": {
             "type": "string",
             "enum": [
@@ -4918,6 +6514,36 @@ const docTemplate = `{
                 "ApiKeyTypeWorkspace"
             ]
         },
+        "build.BuildErrorCategory": {
+            "type": "string",
+            "enum": [
+                "clone",
+                "devcontainer",
+                "commit",
+                "publish"
+            ],
+            "x-enum-varnames": [
+                "BuildErrorCategoryClone",
+                "BuildErrorCategoryDevcontainer",
+                "BuildErrorCategoryCommit",
+                "BuildErrorCategoryPublish"
+            ]
+        },
+        "build.BuildPriority": {
+            "type": "string",
+            "enum": [
+                "normal",
+                "high"
+            ],
+            "x-enum-comments": {
+                "BuildPriorityHigh": "Builds started by users",
+                "BuildPriorityNormal": "Builds triggered by git provider webhooks"
+            },
+            "x-enum-varnames": [
+                "BuildPriorityNormal",
+                "BuildPriorityHigh"
+            ]
+        },
         "build.BuildState": {
             "type": "string",
             "enum": [
@@ -4928,7 +6554,8 @@ const docTemplate = `{
                 "published",
                 "pending-delete",
                 "pending-forced-delete",
-                "deleting"
+                "deleting",
+                "cancelled"
             ],
             "x-enum-varnames": [
                 "BuildStatePendingRun",
@@ -4938,7 +6565,8 @@ const docTemplate = `{
                 "BuildStatePublished",
                 "BuildStatePendingDelete",
                 "BuildStatePendingForcedDelete",
-                "BuildStateDeleting"
+                "BuildStateDeleting",
+                "BuildStateCancelled"
             ]
         },
         "provider.ProviderInfo": {
@@ -4948,6 +6576,9 @@ const docTemplate = `{
                 "version"
             ],
             "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/ProviderCapabilities"
+                },
                 "label": {
                     "type": "string"
                 },
@@ -4984,6 +6615,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "required": {
+                    "description": "Required properties must be set unless they are disabled for the target",
+                    "type": "boolean"
+                },
                 "suggestions": {
                     "description": "Suggestions is an optional list of auto-complete values to assist the user while filling the field",
                     "type": "array",
@@ -4,6 +4,7 @@
 package middlewares
 
 import (
+	"errors"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -29,7 +30,15 @@ func LoggingMiddleware() gin.HandlerFunc {
 				"latency": latencyTime,
 				"error":   ctx.Errors.String(),
 			}).Error("API ERROR")
-			ctx.JSON(statusCode, gin.H{"error": ctx.Errors[0].Err.Error()})
+			response := gin.H{"error": ctx.Errors[0].Err.Error()}
+
+			// Validation errors also report the error of each invalid field
+			var fieldErr interface{ FieldErrors() map[string]string }
+			if errors.As(ctx.Errors[0].Err, &fieldErr) {
+				response["fields"] = fieldErr.FieldErrors()
+			}
+
+			ctx.JSON(statusCode, response)
 		} else {
 			log.WithFields(log.Fields{
 				"method":  reqMethod,
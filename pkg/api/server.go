@@ -36,9 +36,11 @@ import (
 	"github.com/daytonaio/daytona/pkg/api/controllers/binary"
 	"github.com/daytonaio/daytona/pkg/api/controllers/build"
 	"github.com/daytonaio/daytona/pkg/api/controllers/containerregistry"
+	"github.com/daytonaio/daytona/pkg/api/controllers/event"
 	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/health"
 	log_controller "github.com/daytonaio/daytona/pkg/api/controllers/log"
+	"github.com/daytonaio/daytona/pkg/api/controllers/operation"
 	"github.com/daytonaio/daytona/pkg/api/controllers/profiledata"
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig"
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig/prebuild"
@@ -46,6 +48,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/api/controllers/sample"
 	"github.com/daytonaio/daytona/pkg/api/controllers/server"
 	"github.com/daytonaio/daytona/pkg/api/controllers/target"
+	"github.com/daytonaio/daytona/pkg/api/controllers/webhook"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/toolbox"
 
@@ -147,9 +150,17 @@ func (a *ApiServer) Start() error {
 		workspaceController.POST("/", workspace.CreateWorkspace)
 		workspaceController.POST("/:workspaceId/start", workspace.StartWorkspace)
 		workspaceController.POST("/:workspaceId/stop", workspace.StopWorkspace)
+		workspaceController.POST("/:workspaceId/extend", workspace.ExtendWorkspace)
+		workspaceController.POST("/:workspaceId/duplicate", workspace.DuplicateWorkspace)
+		workspaceController.POST("/:workspaceId/migrate", workspace.MigrateWorkspace)
 		workspaceController.DELETE("/:workspaceId", workspace.RemoveWorkspace)
 		workspaceController.POST("/:workspaceId/:projectId/start", workspace.StartProject)
 		workspaceController.POST("/:workspaceId/:projectId/stop", workspace.StopProject)
+		workspaceController.POST("/:workspaceId/project", workspace.AddProject)
+		workspaceController.DELETE("/:workspaceId/project/:projectId", workspace.RemoveProject)
+		workspaceController.POST("/:workspaceId/:projectId/snapshot", workspace.SnapshotProject)
+		workspaceController.GET("/:workspaceId/snapshot", workspace.ListSnapshots)
+		workspaceController.POST("/:workspaceId/restore", workspace.RestoreSnapshot)
 
 		toolboxController := workspaceController.Group("/:workspaceId/:projectId/toolbox")
 		{
@@ -177,10 +188,12 @@ func (a *ApiServer) Start() error {
 			gitController := toolboxController.Group("/git")
 			{
 				gitController.GET("/branches", toolbox.GitBranchList)
+				gitController.GET("/diff", toolbox.GitDiff)
 				gitController.GET("/history", toolbox.GitCommitHistory)
 				gitController.GET("/status", toolbox.GitStatus)
 
 				gitController.POST("/add", toolbox.GitAddFiles)
+				gitController.POST("/apply", toolbox.GitApplyPatch)
 				gitController.POST("/branches", toolbox.GitCreateBranch)
 				gitController.POST("/clone", toolbox.GitCloneRepository)
 				gitController.POST("/commit", toolbox.GitCommitChanges)
@@ -202,6 +215,14 @@ func (a *ApiServer) Start() error {
 		}
 	}
 
+	protected.GET("/events", event.StreamEvents)
+
+	operationController := protected.Group("/operation")
+	{
+		operationController.GET("/:operationId", operation.GetOperation)
+		operationController.POST("/:operationId/cancel", operation.CancelOperation)
+	}
+
 	projectConfigController := protected.Group("/project-config")
 	{
 		// Defining the prebuild routes first to avoid conflicts with the project config routes
@@ -235,6 +256,7 @@ func (a *ApiServer) Start() error {
 		providerController.POST("/install", provider.InstallProvider)
 		providerController.GET("/", provider.ListProviders)
 		providerController.POST("/:provider/uninstall", provider.UninstallProvider)
+		providerController.POST("/:provider/rollback", provider.RollbackProvider)
 		providerController.GET("/:provider/target-manifest", provider.GetTargetManifest)
 	}
 
@@ -251,6 +273,7 @@ func (a *ApiServer) Start() error {
 		buildController.POST("/", build.CreateBuild)
 		buildController.GET("/:buildId", build.GetBuild)
 		buildController.GET("/", build.ListBuilds)
+		buildController.POST("/:buildId/cancel", build.CancelBuild)
 		buildController.DELETE("/", build.DeleteAllBuilds)
 		buildController.DELETE("/:buildId", build.DeleteBuild)
 		buildController.DELETE("/prebuild/:prebuildId", build.DeleteBuildsFromPrebuild)
@@ -259,8 +282,10 @@ func (a *ApiServer) Start() error {
 	targetController := protected.Group("/target")
 	{
 		targetController.GET("/", target.ListTargets)
+		targetController.GET("/status", target.GetTargetsStatus)
 		targetController.PUT("/", target.SetTarget)
 		targetController.PATCH("/:target/set-default", target.SetDefaultTarget)
+		targetController.POST("/:target/instantiate", target.InstantiateTargetTemplate)
 		targetController.DELETE("/:target", target.RemoveTarget)
 	}
 
@@ -296,6 +321,14 @@ func (a *ApiServer) Start() error {
 		apiKeyController.DELETE("/:apiKeyName", apikey.RevokeApiKey)
 	}
 
+	webhookController := protected.Group("/webhook")
+	{
+		webhookController.GET("/", webhook.ListWebhooks)
+		webhookController.POST("/", webhook.CreateWebhook)
+		webhookController.DELETE("/:webhookId", webhook.DeleteWebhook)
+		webhookController.GET("/:webhookId/deliveries", webhook.ListWebhookDeliveries)
+	}
+
 	profileDataController := protected.Group("/profile")
 	{
 		profileDataController.GET("/", profiledata.GetProfileData)
@@ -56,8 +56,12 @@ type APIClient struct {
 
 	DefaultAPI *DefaultAPIService
 
+	EventAPI *EventAPIService
+
 	GitProviderAPI *GitProviderAPIService
 
+	OperationAPI *OperationAPIService
+
 	PrebuildAPI *PrebuildAPIService
 
 	ProfileAPI *ProfileAPIService
@@ -72,6 +76,8 @@ type APIClient struct {
 
 	TargetAPI *TargetAPIService
 
+	WebhookAPI *WebhookAPIService
+
 	WorkspaceAPI *WorkspaceAPIService
 
 	WorkspaceToolboxAPI *WorkspaceToolboxAPIService
@@ -97,7 +103,9 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.BuildAPI = (*BuildAPIService)(&c.common)
 	c.ContainerRegistryAPI = (*ContainerRegistryAPIService)(&c.common)
 	c.DefaultAPI = (*DefaultAPIService)(&c.common)
+	c.EventAPI = (*EventAPIService)(&c.common)
 	c.GitProviderAPI = (*GitProviderAPIService)(&c.common)
+	c.OperationAPI = (*OperationAPIService)(&c.common)
 	c.PrebuildAPI = (*PrebuildAPIService)(&c.common)
 	c.ProfileAPI = (*ProfileAPIService)(&c.common)
 	c.ProjectConfigAPI = (*ProjectConfigAPIService)(&c.common)
@@ -105,6 +113,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.SampleAPI = (*SampleAPIService)(&c.common)
 	c.ServerAPI = (*ServerAPIService)(&c.common)
 	c.TargetAPI = (*TargetAPIService)(&c.common)
+	c.WebhookAPI = (*WebhookAPIService)(&c.common)
 	c.WorkspaceAPI = (*WorkspaceAPIService)(&c.common)
 	c.WorkspaceToolboxAPI = (*WorkspaceToolboxAPIService)(&c.common)
 
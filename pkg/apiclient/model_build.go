@@ -21,17 +21,22 @@ var _ MappedNullable = &Build{}
 
 // Build struct for Build
 type Build struct {
-	BuildConfig     *BuildConfig      `json:"buildConfig,omitempty"`
-	ContainerConfig ContainerConfig   `json:"containerConfig"`
-	CreatedAt       string            `json:"createdAt"`
-	EnvVars         map[string]string `json:"envVars"`
-	Id              string            `json:"id"`
-	Image           *string           `json:"image,omitempty"`
-	PrebuildId      string            `json:"prebuildId"`
-	Repository      GitRepository     `json:"repository"`
-	State           BuildBuildState   `json:"state"`
-	UpdatedAt       string            `json:"updatedAt"`
-	User            *string           `json:"user,omitempty"`
+	Attempts          []BuildAttempt           `json:"attempts,omitempty"`
+	BuildConfig       *BuildConfig             `json:"buildConfig,omitempty"`
+	ContainerConfig   ContainerConfig          `json:"containerConfig"`
+	CreatedAt         string                   `json:"createdAt"`
+	EnvVars           map[string]string        `json:"envVars"`
+	Id                string                   `json:"id"`
+	Image             *string                  `json:"image,omitempty"`
+	LastErrorCategory *BuildBuildErrorCategory `json:"lastErrorCategory,omitempty"`
+	PrebuildId        string                   `json:"prebuildId"`
+	Priority          BuildBuildPriority       `json:"priority"`
+	Queued            *int32                   `json:"queued,omitempty"`
+	Repository        GitRepository            `json:"repository"`
+	RetryAt           *string                  `json:"retryAt,omitempty"`
+	State             BuildBuildState          `json:"state"`
+	UpdatedAt         string                   `json:"updatedAt"`
+	User              *string                  `json:"user,omitempty"`
 }
 
 type _Build Build
@@ -40,13 +45,14 @@ type _Build Build
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewBuild(containerConfig ContainerConfig, createdAt string, envVars map[string]string, id string, prebuildId string, repository GitRepository, state BuildBuildState, updatedAt string) *Build {
+func NewBuild(containerConfig ContainerConfig, createdAt string, envVars map[string]string, id string, prebuildId string, priority BuildBuildPriority, repository GitRepository, state BuildBuildState, updatedAt string) *Build {
 	this := Build{}
 	this.ContainerConfig = containerConfig
 	this.CreatedAt = createdAt
 	this.EnvVars = envVars
 	this.Id = id
 	this.PrebuildId = prebuildId
+	this.Priority = priority
 	this.Repository = repository
 	this.State = state
 	this.UpdatedAt = updatedAt
@@ -61,6 +67,38 @@ func NewBuildWithDefaults() *Build {
 	return &this
 }
 
+// GetAttempts returns the Attempts field value if set, zero value otherwise.
+func (o *Build) GetAttempts() []BuildAttempt {
+	if o == nil || IsNil(o.Attempts) {
+		var ret []BuildAttempt
+		return ret
+	}
+	return o.Attempts
+}
+
+// GetAttemptsOk returns a tuple with the Attempts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetAttemptsOk() ([]BuildAttempt, bool) {
+	if o == nil || IsNil(o.Attempts) {
+		return nil, false
+	}
+	return o.Attempts, true
+}
+
+// HasAttempts returns a boolean if a field has been set.
+func (o *Build) HasAttempts() bool {
+	if o != nil && !IsNil(o.Attempts) {
+		return true
+	}
+
+	return false
+}
+
+// SetAttempts gets a reference to the given []BuildAttempt and assigns it to the Attempts field.
+func (o *Build) SetAttempts(v []BuildAttempt) {
+	o.Attempts = v
+}
+
 // GetBuildConfig returns the BuildConfig field value if set, zero value otherwise.
 func (o *Build) GetBuildConfig() BuildConfig {
 	if o == nil || IsNil(o.BuildConfig) {
@@ -221,6 +259,38 @@ func (o *Build) SetImage(v string) {
 	o.Image = &v
 }
 
+// GetLastErrorCategory returns the LastErrorCategory field value if set, zero value otherwise.
+func (o *Build) GetLastErrorCategory() BuildBuildErrorCategory {
+	if o == nil || IsNil(o.LastErrorCategory) {
+		var ret BuildBuildErrorCategory
+		return ret
+	}
+	return *o.LastErrorCategory
+}
+
+// GetLastErrorCategoryOk returns a tuple with the LastErrorCategory field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetLastErrorCategoryOk() (*BuildBuildErrorCategory, bool) {
+	if o == nil || IsNil(o.LastErrorCategory) {
+		return nil, false
+	}
+	return o.LastErrorCategory, true
+}
+
+// HasLastErrorCategory returns a boolean if a field has been set.
+func (o *Build) HasLastErrorCategory() bool {
+	if o != nil && !IsNil(o.LastErrorCategory) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastErrorCategory gets a reference to the given BuildBuildErrorCategory and assigns it to the LastErrorCategory field.
+func (o *Build) SetLastErrorCategory(v BuildBuildErrorCategory) {
+	o.LastErrorCategory = &v
+}
+
 // GetPrebuildId returns the PrebuildId field value
 func (o *Build) GetPrebuildId() string {
 	if o == nil {
@@ -245,6 +315,62 @@ func (o *Build) SetPrebuildId(v string) {
 	o.PrebuildId = v
 }
 
+// GetPriority returns the Priority field value
+func (o *Build) GetPriority() BuildBuildPriority {
+	if o == nil {
+		var ret BuildBuildPriority
+		return ret
+	}
+
+	return o.Priority
+}
+
+// GetPriorityOk returns a tuple with the Priority field value
+// and a boolean to check if the value has been set.
+func (o *Build) GetPriorityOk() (*BuildBuildPriority, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Priority, true
+}
+
+// SetPriority sets field value
+func (o *Build) SetPriority(v BuildBuildPriority) {
+	o.Priority = v
+}
+
+// GetQueued returns the Queued field value if set, zero value otherwise.
+func (o *Build) GetQueued() int32 {
+	if o == nil || IsNil(o.Queued) {
+		var ret int32
+		return ret
+	}
+	return *o.Queued
+}
+
+// GetQueuedOk returns a tuple with the Queued field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetQueuedOk() (*int32, bool) {
+	if o == nil || IsNil(o.Queued) {
+		return nil, false
+	}
+	return o.Queued, true
+}
+
+// HasQueued returns a boolean if a field has been set.
+func (o *Build) HasQueued() bool {
+	if o != nil && !IsNil(o.Queued) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueued gets a reference to the given int32 and assigns it to the Queued field.
+func (o *Build) SetQueued(v int32) {
+	o.Queued = &v
+}
+
 // GetRepository returns the Repository field value
 func (o *Build) GetRepository() GitRepository {
 	if o == nil {
@@ -269,6 +395,38 @@ func (o *Build) SetRepository(v GitRepository) {
 	o.Repository = v
 }
 
+// GetRetryAt returns the RetryAt field value if set, zero value otherwise.
+func (o *Build) GetRetryAt() string {
+	if o == nil || IsNil(o.RetryAt) {
+		var ret string
+		return ret
+	}
+	return *o.RetryAt
+}
+
+// GetRetryAtOk returns a tuple with the RetryAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetRetryAtOk() (*string, bool) {
+	if o == nil || IsNil(o.RetryAt) {
+		return nil, false
+	}
+	return o.RetryAt, true
+}
+
+// HasRetryAt returns a boolean if a field has been set.
+func (o *Build) HasRetryAt() bool {
+	if o != nil && !IsNil(o.RetryAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetRetryAt gets a reference to the given string and assigns it to the RetryAt field.
+func (o *Build) SetRetryAt(v string) {
+	o.RetryAt = &v
+}
+
 // GetState returns the State field value
 func (o *Build) GetState() BuildBuildState {
 	if o == nil {
@@ -359,6 +517,9 @@ func (o Build) MarshalJSON() ([]byte, error) {
 
 func (o Build) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Attempts) {
+		toSerialize["attempts"] = o.Attempts
+	}
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
@@ -369,8 +530,18 @@ func (o Build) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
+	if !IsNil(o.LastErrorCategory) {
+		toSerialize["lastErrorCategory"] = o.LastErrorCategory
+	}
 	toSerialize["prebuildId"] = o.PrebuildId
+	toSerialize["priority"] = o.Priority
+	if !IsNil(o.Queued) {
+		toSerialize["queued"] = o.Queued
+	}
 	toSerialize["repository"] = o.Repository
+	if !IsNil(o.RetryAt) {
+		toSerialize["retryAt"] = o.RetryAt
+	}
 	toSerialize["state"] = o.State
 	toSerialize["updatedAt"] = o.UpdatedAt
 	if !IsNil(o.User) {
@@ -389,6 +560,7 @@ func (o *Build) UnmarshalJSON(data []byte) (err error) {
 		"envVars",
 		"id",
 		"prebuildId",
+		"priority",
 		"repository",
 		"state",
 		"updatedAt",
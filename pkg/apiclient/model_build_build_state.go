@@ -28,6 +28,7 @@ const (
 	BuildStatePendingDelete       BuildBuildState = "pending-delete"
 	BuildStatePendingForcedDelete BuildBuildState = "pending-forced-delete"
 	BuildStateDeleting            BuildBuildState = "deleting"
+	BuildStateCancelled           BuildBuildState = "cancelled"
 )
 
 // All allowed values of BuildBuildState enum
@@ -40,6 +41,7 @@ var AllowedBuildBuildStateEnumValues = []BuildBuildState{
 	"pending-delete",
 	"pending-forced-delete",
 	"deleting",
+	"cancelled",
 }
 
 func (v *BuildBuildState) UnmarshalJSON(src []byte) error {
@@ -24,6 +24,7 @@ type CreateProjectDTO struct {
 	BuildConfig         *BuildConfig           `json:"buildConfig,omitempty"`
 	EnvVars             map[string]string      `json:"envVars"`
 	GitProviderConfigId *string                `json:"gitProviderConfigId,omitempty"`
+	IdleTimeout         *int32                 `json:"idleTimeout,omitempty"`
 	Image               *string                `json:"image,omitempty"`
 	Name                string                 `json:"name"`
 	Source              CreateProjectSourceDTO `json:"source"`
@@ -140,6 +141,38 @@ func (o *CreateProjectDTO) SetGitProviderConfigId(v string) {
 	o.GitProviderConfigId = &v
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *CreateProjectDTO) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetImage returns the Image field value if set, zero value otherwise.
 func (o *CreateProjectDTO) GetImage() string {
 	if o == nil || IsNil(o.Image) {
@@ -269,6 +302,9 @@ func (o CreateProjectDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
@@ -21,9 +21,12 @@ var _ MappedNullable = &CreateProviderTargetDTO{}
 
 // CreateProviderTargetDTO struct for CreateProviderTargetDTO
 type CreateProviderTargetDTO struct {
-	Name         string               `json:"name"`
-	Options      string               `json:"options"`
-	ProviderInfo ProviderProviderInfo `json:"providerInfo"`
+	IdleTimeout             *int32               `json:"idleTimeout,omitempty"`
+	IsTemplate              *bool                `json:"isTemplate,omitempty"`
+	MaxConcurrentOperations *int32               `json:"maxConcurrentOperations,omitempty"`
+	Name                    string               `json:"name"`
+	Options                 string               `json:"options"`
+	ProviderInfo            ProviderProviderInfo `json:"providerInfo"`
 }
 
 type _CreateProviderTargetDTO CreateProviderTargetDTO
@@ -48,6 +51,102 @@ func NewCreateProviderTargetDTOWithDefaults() *CreateProviderTargetDTO {
 	return &this
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *CreateProviderTargetDTO) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProviderTargetDTO) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *CreateProviderTargetDTO) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *CreateProviderTargetDTO) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
+// GetIsTemplate returns the IsTemplate field value if set, zero value otherwise.
+func (o *CreateProviderTargetDTO) GetIsTemplate() bool {
+	if o == nil || IsNil(o.IsTemplate) {
+		var ret bool
+		return ret
+	}
+	return *o.IsTemplate
+}
+
+// GetIsTemplateOk returns a tuple with the IsTemplate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProviderTargetDTO) GetIsTemplateOk() (*bool, bool) {
+	if o == nil || IsNil(o.IsTemplate) {
+		return nil, false
+	}
+	return o.IsTemplate, true
+}
+
+// HasIsTemplate returns a boolean if a field has been set.
+func (o *CreateProviderTargetDTO) HasIsTemplate() bool {
+	if o != nil && !IsNil(o.IsTemplate) {
+		return true
+	}
+
+	return false
+}
+
+// SetIsTemplate gets a reference to the given bool and assigns it to the IsTemplate field.
+func (o *CreateProviderTargetDTO) SetIsTemplate(v bool) {
+	o.IsTemplate = &v
+}
+
+// GetMaxConcurrentOperations returns the MaxConcurrentOperations field value if set, zero value otherwise.
+func (o *CreateProviderTargetDTO) GetMaxConcurrentOperations() int32 {
+	if o == nil || IsNil(o.MaxConcurrentOperations) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxConcurrentOperations
+}
+
+// GetMaxConcurrentOperationsOk returns a tuple with the MaxConcurrentOperations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProviderTargetDTO) GetMaxConcurrentOperationsOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxConcurrentOperations) {
+		return nil, false
+	}
+	return o.MaxConcurrentOperations, true
+}
+
+// HasMaxConcurrentOperations returns a boolean if a field has been set.
+func (o *CreateProviderTargetDTO) HasMaxConcurrentOperations() bool {
+	if o != nil && !IsNil(o.MaxConcurrentOperations) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxConcurrentOperations gets a reference to the given int32 and assigns it to the MaxConcurrentOperations field.
+func (o *CreateProviderTargetDTO) SetMaxConcurrentOperations(v int32) {
+	o.MaxConcurrentOperations = &v
+}
+
 // GetName returns the Name field value
 func (o *CreateProviderTargetDTO) GetName() string {
 	if o == nil {
@@ -130,6 +229,15 @@ func (o CreateProviderTargetDTO) MarshalJSON() ([]byte, error) {
 
 func (o CreateProviderTargetDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
+	if !IsNil(o.IsTemplate) {
+		toSerialize["isTemplate"] = o.IsTemplate
+	}
+	if !IsNil(o.MaxConcurrentOperations) {
+		toSerialize["maxConcurrentOperations"] = o.MaxConcurrentOperations
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["options"] = o.Options
 	toSerialize["providerInfo"] = o.ProviderInfo
@@ -21,10 +21,13 @@ var _ MappedNullable = &CreateWorkspaceDTO{}
 
 // CreateWorkspaceDTO struct for CreateWorkspaceDTO
 type CreateWorkspaceDTO struct {
-	Id       string             `json:"id"`
-	Name     string             `json:"name"`
-	Projects []CreateProjectDTO `json:"projects"`
-	Target   string             `json:"target"`
+	ExpiresAt *string            `json:"expiresAt,omitempty"`
+	Id        string             `json:"id"`
+	Name      string             `json:"name"`
+	Projects  []CreateProjectDTO `json:"projects"`
+	Target    string             `json:"target"`
+	// Duration (e.g. \"24h\") after which the workspace is removed. Mutually exclusive with ExpiresAt
+	Ttl *string `json:"ttl,omitempty"`
 }
 
 type _CreateWorkspaceDTO CreateWorkspaceDTO
@@ -50,6 +53,38 @@ func NewCreateWorkspaceDTOWithDefaults() *CreateWorkspaceDTO {
 	return &this
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *CreateWorkspaceDTO) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetId returns the Id field value
 func (o *CreateWorkspaceDTO) GetId() string {
 	if o == nil {
@@ -146,6 +181,38 @@ func (o *CreateWorkspaceDTO) SetTarget(v string) {
 	o.Target = v
 }
 
+// GetTtl returns the Ttl field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetTtl() string {
+	if o == nil || IsNil(o.Ttl) {
+		var ret string
+		return ret
+	}
+	return *o.Ttl
+}
+
+// GetTtlOk returns a tuple with the Ttl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetTtlOk() (*string, bool) {
+	if o == nil || IsNil(o.Ttl) {
+		return nil, false
+	}
+	return o.Ttl, true
+}
+
+// HasTtl returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasTtl() bool {
+	if o != nil && !IsNil(o.Ttl) {
+		return true
+	}
+
+	return false
+}
+
+// SetTtl gets a reference to the given string and assigns it to the Ttl field.
+func (o *CreateWorkspaceDTO) SetTtl(v string) {
+	o.Ttl = &v
+}
+
 func (o CreateWorkspaceDTO) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -156,10 +223,16 @@ func (o CreateWorkspaceDTO) MarshalJSON() ([]byte, error) {
 
 func (o CreateWorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["id"] = o.Id
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
 	toSerialize["target"] = o.Target
+	if !IsNil(o.Ttl) {
+		toSerialize["ttl"] = o.Ttl
+	}
 	return toSerialize, nil
 }
 
@@ -24,13 +24,15 @@ type GitRepository struct {
 	Branch      string       `json:"branch"`
 	CloneTarget *CloneTarget `json:"cloneTarget,omitempty"`
 	Id          string       `json:"id"`
-	Name        string       `json:"name"`
-	Owner       string       `json:"owner"`
-	Path        *string      `json:"path,omitempty"`
-	PrNumber    *int32       `json:"prNumber,omitempty"`
-	Sha         string       `json:"sha"`
-	Source      string       `json:"source"`
-	Url         string       `json:"url"`
+	// Moves the cloned branch to the commit of a commit clone instead of checking the commit out with a detached HEAD
+	KeepBranch *bool   `json:"keepBranch,omitempty"`
+	Name       string  `json:"name"`
+	Owner      string  `json:"owner"`
+	Path       *string `json:"path,omitempty"`
+	PrNumber   *int32  `json:"prNumber,omitempty"`
+	Sha        string  `json:"sha"`
+	Source     string  `json:"source"`
+	Url        string  `json:"url"`
 }
 
 type _GitRepository GitRepository
@@ -139,6 +141,38 @@ func (o *GitRepository) SetId(v string) {
 	o.Id = v
 }
 
+// GetKeepBranch returns the KeepBranch field value if set, zero value otherwise.
+func (o *GitRepository) GetKeepBranch() bool {
+	if o == nil || IsNil(o.KeepBranch) {
+		var ret bool
+		return ret
+	}
+	return *o.KeepBranch
+}
+
+// GetKeepBranchOk returns a tuple with the KeepBranch field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitRepository) GetKeepBranchOk() (*bool, bool) {
+	if o == nil || IsNil(o.KeepBranch) {
+		return nil, false
+	}
+	return o.KeepBranch, true
+}
+
+// HasKeepBranch returns a boolean if a field has been set.
+func (o *GitRepository) HasKeepBranch() bool {
+	if o != nil && !IsNil(o.KeepBranch) {
+		return true
+	}
+
+	return false
+}
+
+// SetKeepBranch gets a reference to the given bool and assigns it to the KeepBranch field.
+func (o *GitRepository) SetKeepBranch(v bool) {
+	o.KeepBranch = &v
+}
+
 // GetName returns the Name field value
 func (o *GitRepository) GetName() string {
 	if o == nil {
@@ -338,6 +372,9 @@ func (o GitRepository) ToMap() (map[string]interface{}, error) {
 		toSerialize["cloneTarget"] = o.CloneTarget
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.KeepBranch) {
+		toSerialize["keepBranch"] = o.KeepBranch
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["owner"] = o.Owner
 	if !IsNil(o.Path) {
@@ -24,13 +24,18 @@ type Project struct {
 	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
 	EnvVars             map[string]string `json:"envVars"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
+	IdleTimeout         *int32            `json:"idleTimeout,omitempty"`
 	Image               string            `json:"image"`
+	LastError           *string           `json:"lastError,omitempty"`
 	Name                string            `json:"name"`
 	Repository          GitRepository     `json:"repository"`
-	State               *ProjectState     `json:"state,omitempty"`
-	Target              string            `json:"target"`
-	User                string            `json:"user"`
-	WorkspaceId         string            `json:"workspaceId"`
+	// Id of the snapshot the project was restored from. The project runs the snapshot image instead of being built
+	SnapshotId  *string       `json:"snapshotId,omitempty"`
+	State       *ProjectState `json:"state,omitempty"`
+	Status      ProjectStatus `json:"status"`
+	Target      string        `json:"target"`
+	User        string        `json:"user"`
+	WorkspaceId string        `json:"workspaceId"`
 }
 
 type _Project Project
@@ -39,12 +44,13 @@ type _Project Project
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewProject(envVars map[string]string, image string, name string, repository GitRepository, target string, user string, workspaceId string) *Project {
+func NewProject(envVars map[string]string, image string, name string, repository GitRepository, status ProjectStatus, target string, user string, workspaceId string) *Project {
 	this := Project{}
 	this.EnvVars = envVars
 	this.Image = image
 	this.Name = name
 	this.Repository = repository
+	this.Status = status
 	this.Target = target
 	this.User = user
 	this.WorkspaceId = workspaceId
@@ -147,6 +153,38 @@ func (o *Project) SetGitProviderConfigId(v string) {
 	o.GitProviderConfigId = &v
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *Project) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *Project) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *Project) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetImage returns the Image field value
 func (o *Project) GetImage() string {
 	if o == nil {
@@ -171,6 +209,38 @@ func (o *Project) SetImage(v string) {
 	o.Image = v
 }
 
+// GetLastError returns the LastError field value if set, zero value otherwise.
+func (o *Project) GetLastError() string {
+	if o == nil || IsNil(o.LastError) {
+		var ret string
+		return ret
+	}
+	return *o.LastError
+}
+
+// GetLastErrorOk returns a tuple with the LastError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetLastErrorOk() (*string, bool) {
+	if o == nil || IsNil(o.LastError) {
+		return nil, false
+	}
+	return o.LastError, true
+}
+
+// HasLastError returns a boolean if a field has been set.
+func (o *Project) HasLastError() bool {
+	if o != nil && !IsNil(o.LastError) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastError gets a reference to the given string and assigns it to the LastError field.
+func (o *Project) SetLastError(v string) {
+	o.LastError = &v
+}
+
 // GetName returns the Name field value
 func (o *Project) GetName() string {
 	if o == nil {
@@ -219,6 +289,38 @@ func (o *Project) SetRepository(v GitRepository) {
 	o.Repository = v
 }
 
+// GetSnapshotId returns the SnapshotId field value if set, zero value otherwise.
+func (o *Project) GetSnapshotId() string {
+	if o == nil || IsNil(o.SnapshotId) {
+		var ret string
+		return ret
+	}
+	return *o.SnapshotId
+}
+
+// GetSnapshotIdOk returns a tuple with the SnapshotId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetSnapshotIdOk() (*string, bool) {
+	if o == nil || IsNil(o.SnapshotId) {
+		return nil, false
+	}
+	return o.SnapshotId, true
+}
+
+// HasSnapshotId returns a boolean if a field has been set.
+func (o *Project) HasSnapshotId() bool {
+	if o != nil && !IsNil(o.SnapshotId) {
+		return true
+	}
+
+	return false
+}
+
+// SetSnapshotId gets a reference to the given string and assigns it to the SnapshotId field.
+func (o *Project) SetSnapshotId(v string) {
+	o.SnapshotId = &v
+}
+
 // GetState returns the State field value if set, zero value otherwise.
 func (o *Project) GetState() ProjectState {
 	if o == nil || IsNil(o.State) {
@@ -251,6 +353,30 @@ func (o *Project) SetState(v ProjectState) {
 	o.State = &v
 }
 
+// GetStatus returns the Status field value
+func (o *Project) GetStatus() ProjectStatus {
+	if o == nil {
+		var ret ProjectStatus
+		return ret
+	}
+
+	return o.Status
+}
+
+// GetStatusOk returns a tuple with the Status field value
+// and a boolean to check if the value has been set.
+func (o *Project) GetStatusOk() (*ProjectStatus, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Status, true
+}
+
+// SetStatus sets field value
+func (o *Project) SetStatus(v ProjectStatus) {
+	o.Status = v
+}
+
 // GetTarget returns the Target field value
 func (o *Project) GetTarget() string {
 	if o == nil {
@@ -340,12 +466,22 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	toSerialize["image"] = o.Image
+	if !IsNil(o.LastError) {
+		toSerialize["lastError"] = o.LastError
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["repository"] = o.Repository
+	if !IsNil(o.SnapshotId) {
+		toSerialize["snapshotId"] = o.SnapshotId
+	}
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
+	toSerialize["status"] = o.Status
 	toSerialize["target"] = o.Target
 	toSerialize["user"] = o.User
 	toSerialize["workspaceId"] = o.WorkspaceId
@@ -361,6 +497,7 @@ func (o *Project) UnmarshalJSON(data []byte) (err error) {
 		"image",
 		"name",
 		"repository",
+		"status",
 		"target",
 		"user",
 		"workspaceId",
@@ -25,6 +25,7 @@ type ProjectConfig struct {
 	Default             bool              `json:"default"`
 	EnvVars             map[string]string `json:"envVars"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
+	IdleTimeout         *int32            `json:"idleTimeout,omitempty"`
 	Image               string            `json:"image"`
 	Name                string            `json:"name"`
 	Prebuilds           []PrebuildConfig  `json:"prebuilds,omitempty"`
@@ -169,6 +170,38 @@ func (o *ProjectConfig) SetGitProviderConfigId(v string) {
 	o.GitProviderConfigId = &v
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *ProjectConfig) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *ProjectConfig) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *ProjectConfig) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetImage returns the Image field value
 func (o *ProjectConfig) GetImage() string {
 	if o == nil {
@@ -315,6 +348,9 @@ func (o ProjectConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	toSerialize["image"] = o.Image
 	toSerialize["name"] = o.Name
 	if !IsNil(o.Prebuilds) {
@@ -21,9 +21,10 @@ var _ MappedNullable = &ProjectState{}
 
 // ProjectState struct for ProjectState
 type ProjectState struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	UpdatedAt string     `json:"updatedAt"`
-	Uptime    int32      `json:"uptime"`
+	GitStatus    *GitStatus `json:"gitStatus,omitempty"`
+	LastActivity *string    `json:"lastActivity,omitempty"`
+	UpdatedAt    string     `json:"updatedAt"`
+	Uptime       int32      `json:"uptime"`
 }
 
 type _ProjectState ProjectState
@@ -79,6 +80,38 @@ func (o *ProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLastActivity returns the LastActivity field value if set, zero value otherwise.
+func (o *ProjectState) GetLastActivity() string {
+	if o == nil || IsNil(o.LastActivity) {
+		var ret string
+		return ret
+	}
+	return *o.LastActivity
+}
+
+// GetLastActivityOk returns a tuple with the LastActivity field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetLastActivityOk() (*string, bool) {
+	if o == nil || IsNil(o.LastActivity) {
+		return nil, false
+	}
+	return o.LastActivity, true
+}
+
+// HasLastActivity returns a boolean if a field has been set.
+func (o *ProjectState) HasLastActivity() bool {
+	if o != nil && !IsNil(o.LastActivity) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivity gets a reference to the given string and assigns it to the LastActivity field.
+func (o *ProjectState) SetLastActivity(v string) {
+	o.LastActivity = &v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value
 func (o *ProjectState) GetUpdatedAt() string {
 	if o == nil {
@@ -140,6 +173,9 @@ func (o ProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.LastActivity) {
+		toSerialize["lastActivity"] = o.LastActivity
+	}
 	toSerialize["updatedAt"] = o.UpdatedAt
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
@@ -21,9 +21,11 @@ var _ MappedNullable = &Provider{}
 
 // Provider struct for Provider
 type Provider struct {
-	Label   *string `json:"label,omitempty"`
-	Name    string  `json:"name"`
-	Version string  `json:"version"`
+	Capabilities ProviderCapabilities `json:"capabilities"`
+	Health       *ProviderHealth      `json:"health,omitempty"`
+	Label        *string              `json:"label,omitempty"`
+	Name         string               `json:"name"`
+	Version      string               `json:"version"`
 }
 
 type _Provider Provider
@@ -32,8 +34,9 @@ type _Provider Provider
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewProvider(name string, version string) *Provider {
+func NewProvider(capabilities ProviderCapabilities, name string, version string) *Provider {
 	this := Provider{}
+	this.Capabilities = capabilities
 	this.Name = name
 	this.Version = version
 	return &this
@@ -47,6 +50,62 @@ func NewProviderWithDefaults() *Provider {
 	return &this
 }
 
+// GetCapabilities returns the Capabilities field value
+func (o *Provider) GetCapabilities() ProviderCapabilities {
+	if o == nil {
+		var ret ProviderCapabilities
+		return ret
+	}
+
+	return o.Capabilities
+}
+
+// GetCapabilitiesOk returns a tuple with the Capabilities field value
+// and a boolean to check if the value has been set.
+func (o *Provider) GetCapabilitiesOk() (*ProviderCapabilities, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Capabilities, true
+}
+
+// SetCapabilities sets field value
+func (o *Provider) SetCapabilities(v ProviderCapabilities) {
+	o.Capabilities = v
+}
+
+// GetHealth returns the Health field value if set, zero value otherwise.
+func (o *Provider) GetHealth() ProviderHealth {
+	if o == nil || IsNil(o.Health) {
+		var ret ProviderHealth
+		return ret
+	}
+	return *o.Health
+}
+
+// GetHealthOk returns a tuple with the Health field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Provider) GetHealthOk() (*ProviderHealth, bool) {
+	if o == nil || IsNil(o.Health) {
+		return nil, false
+	}
+	return o.Health, true
+}
+
+// HasHealth returns a boolean if a field has been set.
+func (o *Provider) HasHealth() bool {
+	if o != nil && !IsNil(o.Health) {
+		return true
+	}
+
+	return false
+}
+
+// SetHealth gets a reference to the given ProviderHealth and assigns it to the Health field.
+func (o *Provider) SetHealth(v ProviderHealth) {
+	o.Health = &v
+}
+
 // GetLabel returns the Label field value if set, zero value otherwise.
 func (o *Provider) GetLabel() string {
 	if o == nil || IsNil(o.Label) {
@@ -137,6 +196,10 @@ func (o Provider) MarshalJSON() ([]byte, error) {
 
 func (o Provider) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	toSerialize["capabilities"] = o.Capabilities
+	if !IsNil(o.Health) {
+		toSerialize["health"] = o.Health
+	}
 	if !IsNil(o.Label) {
 		toSerialize["label"] = o.Label
 	}
@@ -150,6 +213,7 @@ func (o *Provider) UnmarshalJSON(data []byte) (err error) {
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
+		"capabilities",
 		"name",
 		"version",
 	}
@@ -21,9 +21,10 @@ var _ MappedNullable = &ProviderProviderInfo{}
 
 // ProviderProviderInfo struct for ProviderProviderInfo
 type ProviderProviderInfo struct {
-	Label   *string `json:"label,omitempty"`
-	Name    string  `json:"name"`
-	Version string  `json:"version"`
+	Capabilities *ProviderCapabilities `json:"capabilities,omitempty"`
+	Label        *string               `json:"label,omitempty"`
+	Name         string                `json:"name"`
+	Version      string                `json:"version"`
 }
 
 type _ProviderProviderInfo ProviderProviderInfo
@@ -47,6 +48,38 @@ func NewProviderProviderInfoWithDefaults() *ProviderProviderInfo {
 	return &this
 }
 
+// GetCapabilities returns the Capabilities field value if set, zero value otherwise.
+func (o *ProviderProviderInfo) GetCapabilities() ProviderCapabilities {
+	if o == nil || IsNil(o.Capabilities) {
+		var ret ProviderCapabilities
+		return ret
+	}
+	return *o.Capabilities
+}
+
+// GetCapabilitiesOk returns a tuple with the Capabilities field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderProviderInfo) GetCapabilitiesOk() (*ProviderCapabilities, bool) {
+	if o == nil || IsNil(o.Capabilities) {
+		return nil, false
+	}
+	return o.Capabilities, true
+}
+
+// HasCapabilities returns a boolean if a field has been set.
+func (o *ProviderProviderInfo) HasCapabilities() bool {
+	if o != nil && !IsNil(o.Capabilities) {
+		return true
+	}
+
+	return false
+}
+
+// SetCapabilities gets a reference to the given ProviderCapabilities and assigns it to the Capabilities field.
+func (o *ProviderProviderInfo) SetCapabilities(v ProviderCapabilities) {
+	o.Capabilities = &v
+}
+
 // GetLabel returns the Label field value if set, zero value otherwise.
 func (o *ProviderProviderInfo) GetLabel() string {
 	if o == nil || IsNil(o.Label) {
@@ -137,6 +170,9 @@ func (o ProviderProviderInfo) MarshalJSON() ([]byte, error) {
 
 func (o ProviderProviderInfo) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Capabilities) {
+		toSerialize["capabilities"] = o.Capabilities
+	}
 	if !IsNil(o.Label) {
 		toSerialize["label"] = o.Label
 	}
@@ -28,6 +28,8 @@ type ProviderProviderTargetProperty struct {
 	InputMasked       *bool   `json:"inputMasked,omitempty"`
 	// Options is only used if the Type is ProviderTargetPropertyTypeOption
 	Options []string `json:"options,omitempty"`
+	// Required properties must be set unless they are disabled for the target
+	Required *bool `json:"required,omitempty"`
 	// Suggestions is an optional list of auto-complete values to assist the user while filling the field
 	Suggestions []string                            `json:"suggestions,omitempty"`
 	Type        *ProviderProviderTargetPropertyType `json:"type,omitempty"`
@@ -210,6 +212,38 @@ func (o *ProviderProviderTargetProperty) SetOptions(v []string) {
 	o.Options = v
 }
 
+// GetRequired returns the Required field value if set, zero value otherwise.
+func (o *ProviderProviderTargetProperty) GetRequired() bool {
+	if o == nil || IsNil(o.Required) {
+		var ret bool
+		return ret
+	}
+	return *o.Required
+}
+
+// GetRequiredOk returns a tuple with the Required field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderProviderTargetProperty) GetRequiredOk() (*bool, bool) {
+	if o == nil || IsNil(o.Required) {
+		return nil, false
+	}
+	return o.Required, true
+}
+
+// HasRequired returns a boolean if a field has been set.
+func (o *ProviderProviderTargetProperty) HasRequired() bool {
+	if o != nil && !IsNil(o.Required) {
+		return true
+	}
+
+	return false
+}
+
+// SetRequired gets a reference to the given bool and assigns it to the Required field.
+func (o *ProviderProviderTargetProperty) SetRequired(v bool) {
+	o.Required = &v
+}
+
 // GetSuggestions returns the Suggestions field value if set, zero value otherwise.
 func (o *ProviderProviderTargetProperty) GetSuggestions() []string {
 	if o == nil || IsNil(o.Suggestions) {
@@ -299,6 +333,9 @@ func (o ProviderProviderTargetProperty) ToMap() (map[string]interface{}, error)
 	if !IsNil(o.Options) {
 		toSerialize["options"] = o.Options
 	}
+	if !IsNil(o.Required) {
+		toSerialize["required"] = o.Required
+	}
 	if !IsNil(o.Suggestions) {
 		toSerialize["suggestions"] = o.Suggestions
 	}
@@ -21,8 +21,14 @@ var _ MappedNullable = &ProviderTarget{}
 
 // ProviderTarget struct for ProviderTarget
 type ProviderTarget struct {
-	IsDefault bool   `json:"isDefault"`
-	Name      string `json:"name"`
+	// Minutes without activity after which projects on the target are stopped
+	IdleTimeout *int32 `json:"idleTimeout,omitempty"`
+	IsDefault   bool   `json:"isDefault"`
+	// Option values of a template can reference variables, e.g. ${region}, that are set when the template is instantiated. Templates can not be used to create workspaces
+	IsTemplate *bool `json:"isTemplate,omitempty"`
+	// Maximum number of workspace and project operations that run on the target at the same time. Operations over the limit are queued. Not limited if not set
+	MaxConcurrentOperations *int32 `json:"maxConcurrentOperations,omitempty"`
+	Name                    string `json:"name"`
 	// JSON encoded map of options
 	Options      string               `json:"options"`
 	ProviderInfo ProviderProviderInfo `json:"providerInfo"`
@@ -51,6 +57,38 @@ func NewProviderTargetWithDefaults() *ProviderTarget {
 	return &this
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *ProviderTarget) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *ProviderTarget) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *ProviderTarget) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetIsDefault returns the IsDefault field value
 func (o *ProviderTarget) GetIsDefault() bool {
 	if o == nil {
@@ -75,6 +113,70 @@ func (o *ProviderTarget) SetIsDefault(v bool) {
 	o.IsDefault = v
 }
 
+// GetIsTemplate returns the IsTemplate field value if set, zero value otherwise.
+func (o *ProviderTarget) GetIsTemplate() bool {
+	if o == nil || IsNil(o.IsTemplate) {
+		var ret bool
+		return ret
+	}
+	return *o.IsTemplate
+}
+
+// GetIsTemplateOk returns a tuple with the IsTemplate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetIsTemplateOk() (*bool, bool) {
+	if o == nil || IsNil(o.IsTemplate) {
+		return nil, false
+	}
+	return o.IsTemplate, true
+}
+
+// HasIsTemplate returns a boolean if a field has been set.
+func (o *ProviderTarget) HasIsTemplate() bool {
+	if o != nil && !IsNil(o.IsTemplate) {
+		return true
+	}
+
+	return false
+}
+
+// SetIsTemplate gets a reference to the given bool and assigns it to the IsTemplate field.
+func (o *ProviderTarget) SetIsTemplate(v bool) {
+	o.IsTemplate = &v
+}
+
+// GetMaxConcurrentOperations returns the MaxConcurrentOperations field value if set, zero value otherwise.
+func (o *ProviderTarget) GetMaxConcurrentOperations() int32 {
+	if o == nil || IsNil(o.MaxConcurrentOperations) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxConcurrentOperations
+}
+
+// GetMaxConcurrentOperationsOk returns a tuple with the MaxConcurrentOperations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetMaxConcurrentOperationsOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxConcurrentOperations) {
+		return nil, false
+	}
+	return o.MaxConcurrentOperations, true
+}
+
+// HasMaxConcurrentOperations returns a boolean if a field has been set.
+func (o *ProviderTarget) HasMaxConcurrentOperations() bool {
+	if o != nil && !IsNil(o.MaxConcurrentOperations) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxConcurrentOperations gets a reference to the given int32 and assigns it to the MaxConcurrentOperations field.
+func (o *ProviderTarget) SetMaxConcurrentOperations(v int32) {
+	o.MaxConcurrentOperations = &v
+}
+
 // GetName returns the Name field value
 func (o *ProviderTarget) GetName() string {
 	if o == nil {
@@ -157,7 +259,16 @@ func (o ProviderTarget) MarshalJSON() ([]byte, error) {
 
 func (o ProviderTarget) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	toSerialize["isDefault"] = o.IsDefault
+	if !IsNil(o.IsTemplate) {
+		toSerialize["isTemplate"] = o.IsTemplate
+	}
+	if !IsNil(o.MaxConcurrentOperations) {
+		toSerialize["maxConcurrentOperations"] = o.MaxConcurrentOperations
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["options"] = o.Options
 	toSerialize["providerInfo"] = o.ProviderInfo
@@ -34,10 +34,17 @@ type ServerConfig struct {
 	LocalBuilderRegistryImage string        `json:"localBuilderRegistryImage"`
 	LocalBuilderRegistryPort  int32         `json:"localBuilderRegistryPort"`
 	LogFile                   LogFileConfig `json:"logFile"`
-	ProvidersDir              string        `json:"providersDir"`
-	RegistryUrl               string        `json:"registryUrl"`
-	SamplesIndexUrl           *string       `json:"samplesIndexUrl,omitempty"`
-	ServerDownloadUrl         string        `json:"serverDownloadUrl"`
+	// Path of the file holding the master key used to encrypt secrets at rest. Defaults to master.key in the server config dir
+	MasterKeyFile *string `json:"masterKeyFile,omitempty"`
+	// Provider versions that are kept on restart and skipped by provider updates
+	PinnedProviderVersions map[string]string `json:"pinnedProviderVersions,omitempty"`
+	ProjectIdleTimeout     *int32            `json:"projectIdleTimeout,omitempty"`
+	// Base64 encoded ed25519 public key used to verify provider signatures
+	ProviderPublicKey *string `json:"providerPublicKey,omitempty"`
+	ProvidersDir      string  `json:"providersDir"`
+	RegistryUrl       string  `json:"registryUrl"`
+	SamplesIndexUrl   *string `json:"samplesIndexUrl,omitempty"`
+	ServerDownloadUrl string  `json:"serverDownloadUrl"`
 }
 
 type _ServerConfig ServerConfig
@@ -401,6 +408,134 @@ func (o *ServerConfig) SetLogFile(v LogFileConfig) {
 	o.LogFile = v
 }
 
+// GetMasterKeyFile returns the MasterKeyFile field value if set, zero value otherwise.
+func (o *ServerConfig) GetMasterKeyFile() string {
+	if o == nil || IsNil(o.MasterKeyFile) {
+		var ret string
+		return ret
+	}
+	return *o.MasterKeyFile
+}
+
+// GetMasterKeyFileOk returns a tuple with the MasterKeyFile field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetMasterKeyFileOk() (*string, bool) {
+	if o == nil || IsNil(o.MasterKeyFile) {
+		return nil, false
+	}
+	return o.MasterKeyFile, true
+}
+
+// HasMasterKeyFile returns a boolean if a field has been set.
+func (o *ServerConfig) HasMasterKeyFile() bool {
+	if o != nil && !IsNil(o.MasterKeyFile) {
+		return true
+	}
+
+	return false
+}
+
+// SetMasterKeyFile gets a reference to the given string and assigns it to the MasterKeyFile field.
+func (o *ServerConfig) SetMasterKeyFile(v string) {
+	o.MasterKeyFile = &v
+}
+
+// GetPinnedProviderVersions returns the PinnedProviderVersions field value if set, zero value otherwise.
+func (o *ServerConfig) GetPinnedProviderVersions() map[string]string {
+	if o == nil || IsNil(o.PinnedProviderVersions) {
+		var ret map[string]string
+		return ret
+	}
+	return o.PinnedProviderVersions
+}
+
+// GetPinnedProviderVersionsOk returns a tuple with the PinnedProviderVersions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetPinnedProviderVersionsOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.PinnedProviderVersions) {
+		return map[string]string{}, false
+	}
+	return o.PinnedProviderVersions, true
+}
+
+// HasPinnedProviderVersions returns a boolean if a field has been set.
+func (o *ServerConfig) HasPinnedProviderVersions() bool {
+	if o != nil && !IsNil(o.PinnedProviderVersions) {
+		return true
+	}
+
+	return false
+}
+
+// SetPinnedProviderVersions gets a reference to the given map[string]string and assigns it to the PinnedProviderVersions field.
+func (o *ServerConfig) SetPinnedProviderVersions(v map[string]string) {
+	o.PinnedProviderVersions = v
+}
+
+// GetProjectIdleTimeout returns the ProjectIdleTimeout field value if set, zero value otherwise.
+func (o *ServerConfig) GetProjectIdleTimeout() int32 {
+	if o == nil || IsNil(o.ProjectIdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.ProjectIdleTimeout
+}
+
+// GetProjectIdleTimeoutOk returns a tuple with the ProjectIdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetProjectIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.ProjectIdleTimeout) {
+		return nil, false
+	}
+	return o.ProjectIdleTimeout, true
+}
+
+// HasProjectIdleTimeout returns a boolean if a field has been set.
+func (o *ServerConfig) HasProjectIdleTimeout() bool {
+	if o != nil && !IsNil(o.ProjectIdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectIdleTimeout gets a reference to the given int32 and assigns it to the ProjectIdleTimeout field.
+func (o *ServerConfig) SetProjectIdleTimeout(v int32) {
+	o.ProjectIdleTimeout = &v
+}
+
+// GetProviderPublicKey returns the ProviderPublicKey field value if set, zero value otherwise.
+func (o *ServerConfig) GetProviderPublicKey() string {
+	if o == nil || IsNil(o.ProviderPublicKey) {
+		var ret string
+		return ret
+	}
+	return *o.ProviderPublicKey
+}
+
+// GetProviderPublicKeyOk returns a tuple with the ProviderPublicKey field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetProviderPublicKeyOk() (*string, bool) {
+	if o == nil || IsNil(o.ProviderPublicKey) {
+		return nil, false
+	}
+	return o.ProviderPublicKey, true
+}
+
+// HasProviderPublicKey returns a boolean if a field has been set.
+func (o *ServerConfig) HasProviderPublicKey() bool {
+	if o != nil && !IsNil(o.ProviderPublicKey) {
+		return true
+	}
+
+	return false
+}
+
+// SetProviderPublicKey gets a reference to the given string and assigns it to the ProviderPublicKey field.
+func (o *ServerConfig) SetProviderPublicKey(v string) {
+	o.ProviderPublicKey = &v
+}
+
 // GetProvidersDir returns the ProvidersDir field value
 func (o *ServerConfig) GetProvidersDir() string {
 	if o == nil {
@@ -532,6 +667,18 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize["localBuilderRegistryImage"] = o.LocalBuilderRegistryImage
 	toSerialize["localBuilderRegistryPort"] = o.LocalBuilderRegistryPort
 	toSerialize["logFile"] = o.LogFile
+	if !IsNil(o.MasterKeyFile) {
+		toSerialize["masterKeyFile"] = o.MasterKeyFile
+	}
+	if !IsNil(o.PinnedProviderVersions) {
+		toSerialize["pinnedProviderVersions"] = o.PinnedProviderVersions
+	}
+	if !IsNil(o.ProjectIdleTimeout) {
+		toSerialize["projectIdleTimeout"] = o.ProjectIdleTimeout
+	}
+	if !IsNil(o.ProviderPublicKey) {
+		toSerialize["providerPublicKey"] = o.ProviderPublicKey
+	}
 	toSerialize["providersDir"] = o.ProvidersDir
 	toSerialize["registryUrl"] = o.RegistryUrl
 	if !IsNil(o.SamplesIndexUrl) {
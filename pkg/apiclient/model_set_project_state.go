@@ -21,8 +21,9 @@ var _ MappedNullable = &SetProjectState{}
 
 // SetProjectState struct for SetProjectState
 type SetProjectState struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	Uptime    int32      `json:"uptime"`
+	GitStatus    *GitStatus `json:"gitStatus,omitempty"`
+	LastActivity *string    `json:"lastActivity,omitempty"`
+	Uptime       int32      `json:"uptime"`
 }
 
 type _SetProjectState SetProjectState
@@ -77,6 +78,38 @@ func (o *SetProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLastActivity returns the LastActivity field value if set, zero value otherwise.
+func (o *SetProjectState) GetLastActivity() string {
+	if o == nil || IsNil(o.LastActivity) {
+		var ret string
+		return ret
+	}
+	return *o.LastActivity
+}
+
+// GetLastActivityOk returns a tuple with the LastActivity field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetLastActivityOk() (*string, bool) {
+	if o == nil || IsNil(o.LastActivity) {
+		return nil, false
+	}
+	return o.LastActivity, true
+}
+
+// HasLastActivity returns a boolean if a field has been set.
+func (o *SetProjectState) HasLastActivity() bool {
+	if o != nil && !IsNil(o.LastActivity) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivity gets a reference to the given string and assigns it to the LastActivity field.
+func (o *SetProjectState) SetLastActivity(v string) {
+	o.LastActivity = &v
+}
+
 // GetUptime returns the Uptime field value
 func (o *SetProjectState) GetUptime() int32 {
 	if o == nil {
@@ -114,6 +147,9 @@ func (o SetProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.LastActivity) {
+		toSerialize["lastActivity"] = o.LastActivity
+	}
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
 }
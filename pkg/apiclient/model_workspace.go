@@ -21,10 +21,13 @@ var _ MappedNullable = &Workspace{}
 
 // Workspace struct for Workspace
 type Workspace struct {
-	Id       string    `json:"id"`
-	Name     string    `json:"name"`
-	Projects []Project `json:"projects"`
-	Target   string    `json:"target"`
+	ExpiresAt *string         `json:"expiresAt,omitempty"`
+	Id        string          `json:"id"`
+	LastError *string         `json:"lastError,omitempty"`
+	Name      string          `json:"name"`
+	Projects  []Project       `json:"projects"`
+	Status    WorkspaceStatus `json:"status"`
+	Target    string          `json:"target"`
 }
 
 type _Workspace Workspace
@@ -33,11 +36,12 @@ type _Workspace Workspace
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewWorkspace(id string, name string, projects []Project, target string) *Workspace {
+func NewWorkspace(id string, name string, projects []Project, status WorkspaceStatus, target string) *Workspace {
 	this := Workspace{}
 	this.Id = id
 	this.Name = name
 	this.Projects = projects
+	this.Status = status
 	this.Target = target
 	return &this
 }
@@ -50,6 +54,38 @@ func NewWorkspaceWithDefaults() *Workspace {
 	return &this
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *Workspace) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *Workspace) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *Workspace) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetId returns the Id field value
 func (o *Workspace) GetId() string {
 	if o == nil {
@@ -74,6 +110,38 @@ func (o *Workspace) SetId(v string) {
 	o.Id = v
 }
 
+// GetLastError returns the LastError field value if set, zero value otherwise.
+func (o *Workspace) GetLastError() string {
+	if o == nil || IsNil(o.LastError) {
+		var ret string
+		return ret
+	}
+	return *o.LastError
+}
+
+// GetLastErrorOk returns a tuple with the LastError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetLastErrorOk() (*string, bool) {
+	if o == nil || IsNil(o.LastError) {
+		return nil, false
+	}
+	return o.LastError, true
+}
+
+// HasLastError returns a boolean if a field has been set.
+func (o *Workspace) HasLastError() bool {
+	if o != nil && !IsNil(o.LastError) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastError gets a reference to the given string and assigns it to the LastError field.
+func (o *Workspace) SetLastError(v string) {
+	o.LastError = &v
+}
+
 // GetName returns the Name field value
 func (o *Workspace) GetName() string {
 	if o == nil {
@@ -122,6 +190,30 @@ func (o *Workspace) SetProjects(v []Project) {
 	o.Projects = v
 }
 
+// GetStatus returns the Status field value
+func (o *Workspace) GetStatus() WorkspaceStatus {
+	if o == nil {
+		var ret WorkspaceStatus
+		return ret
+	}
+
+	return o.Status
+}
+
+// GetStatusOk returns a tuple with the Status field value
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetStatusOk() (*WorkspaceStatus, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Status, true
+}
+
+// SetStatus sets field value
+func (o *Workspace) SetStatus(v WorkspaceStatus) {
+	o.Status = v
+}
+
 // GetTarget returns the Target field value
 func (o *Workspace) GetTarget() string {
 	if o == nil {
@@ -156,9 +248,16 @@ func (o Workspace) MarshalJSON() ([]byte, error) {
 
 func (o Workspace) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.LastError) {
+		toSerialize["lastError"] = o.LastError
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
+	toSerialize["status"] = o.Status
 	toSerialize["target"] = o.Target
 	return toSerialize, nil
 }
@@ -171,6 +270,7 @@ func (o *Workspace) UnmarshalJSON(data []byte) (err error) {
 		"id",
 		"name",
 		"projects",
+		"status",
 		"target",
 	}
 
@@ -21,11 +21,14 @@ var _ MappedNullable = &WorkspaceDTO{}
 
 // WorkspaceDTO struct for WorkspaceDTO
 type WorkspaceDTO struct {
-	Id       string         `json:"id"`
-	Info     *WorkspaceInfo `json:"info,omitempty"`
-	Name     string         `json:"name"`
-	Projects []Project      `json:"projects"`
-	Target   string         `json:"target"`
+	ExpiresAt *string         `json:"expiresAt,omitempty"`
+	Id        string          `json:"id"`
+	Info      *WorkspaceInfo  `json:"info,omitempty"`
+	LastError *string         `json:"lastError,omitempty"`
+	Name      string          `json:"name"`
+	Projects  []Project       `json:"projects"`
+	Status    WorkspaceStatus `json:"status"`
+	Target    string          `json:"target"`
 }
 
 type _WorkspaceDTO WorkspaceDTO
@@ -34,11 +37,12 @@ type _WorkspaceDTO WorkspaceDTO
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewWorkspaceDTO(id string, name string, projects []Project, target string) *WorkspaceDTO {
+func NewWorkspaceDTO(id string, name string, projects []Project, status WorkspaceStatus, target string) *WorkspaceDTO {
 	this := WorkspaceDTO{}
 	this.Id = id
 	this.Name = name
 	this.Projects = projects
+	this.Status = status
 	this.Target = target
 	return &this
 }
@@ -51,6 +55,38 @@ func NewWorkspaceDTOWithDefaults() *WorkspaceDTO {
 	return &this
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *WorkspaceDTO) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetId returns the Id field value
 func (o *WorkspaceDTO) GetId() string {
 	if o == nil {
@@ -107,6 +143,38 @@ func (o *WorkspaceDTO) SetInfo(v WorkspaceInfo) {
 	o.Info = &v
 }
 
+// GetLastError returns the LastError field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetLastError() string {
+	if o == nil || IsNil(o.LastError) {
+		var ret string
+		return ret
+	}
+	return *o.LastError
+}
+
+// GetLastErrorOk returns a tuple with the LastError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetLastErrorOk() (*string, bool) {
+	if o == nil || IsNil(o.LastError) {
+		return nil, false
+	}
+	return o.LastError, true
+}
+
+// HasLastError returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasLastError() bool {
+	if o != nil && !IsNil(o.LastError) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastError gets a reference to the given string and assigns it to the LastError field.
+func (o *WorkspaceDTO) SetLastError(v string) {
+	o.LastError = &v
+}
+
 // GetName returns the Name field value
 func (o *WorkspaceDTO) GetName() string {
 	if o == nil {
@@ -155,6 +223,30 @@ func (o *WorkspaceDTO) SetProjects(v []Project) {
 	o.Projects = v
 }
 
+// GetStatus returns the Status field value
+func (o *WorkspaceDTO) GetStatus() WorkspaceStatus {
+	if o == nil {
+		var ret WorkspaceStatus
+		return ret
+	}
+
+	return o.Status
+}
+
+// GetStatusOk returns a tuple with the Status field value
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetStatusOk() (*WorkspaceStatus, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Status, true
+}
+
+// SetStatus sets field value
+func (o *WorkspaceDTO) SetStatus(v WorkspaceStatus) {
+	o.Status = v
+}
+
 // GetTarget returns the Target field value
 func (o *WorkspaceDTO) GetTarget() string {
 	if o == nil {
@@ -189,12 +281,19 @@ func (o WorkspaceDTO) MarshalJSON() ([]byte, error) {
 
 func (o WorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["id"] = o.Id
 	if !IsNil(o.Info) {
 		toSerialize["info"] = o.Info
 	}
+	if !IsNil(o.LastError) {
+		toSerialize["lastError"] = o.LastError
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
+	toSerialize["status"] = o.Status
 	toSerialize["target"] = o.Target
 	return toSerialize, nil
 }
@@ -207,6 +306,7 @@ func (o *WorkspaceDTO) UnmarshalJSON(data []byte) (err error) {
 		"id",
 		"name",
 		"projects",
+		"status",
 		"target",
 	}
 
@@ -24,20 +24,26 @@ const (
 	BuildStatePendingDelete       BuildState = "pending-delete"
 	BuildStatePendingForcedDelete BuildState = "pending-forced-delete"
 	BuildStateDeleting            BuildState = "deleting"
+	BuildStateCancelled           BuildState = "cancelled"
 )
 
 type Build struct {
-	Id              string                          `json:"id" validate:"required"`
-	State           BuildState                      `json:"state" validate:"required"`
-	Image           *string                         `json:"image" validate:"optional"`
-	User            *string                         `json:"user" validate:"optional"`
-	ContainerConfig containerconfig.ContainerConfig `json:"containerConfig" validate:"required"`
-	BuildConfig     *buildconfig.BuildConfig        `json:"buildConfig" validate:"optional"`
-	Repository      *gitprovider.GitRepository      `json:"repository" validate:"required"`
-	EnvVars         map[string]string               `json:"envVars" validate:"required"`
-	PrebuildId      string                          `json:"prebuildId" validate:"required"`
-	CreatedAt       time.Time                       `json:"createdAt" validate:"required"`
-	UpdatedAt       time.Time                       `json:"updatedAt" validate:"required"`
+	Id                string                          `json:"id" validate:"required"`
+	State             BuildState                      `json:"state" validate:"required"`
+	Image             *string                         `json:"image" validate:"optional"`
+	User              *string                         `json:"user" validate:"optional"`
+	ContainerConfig   containerconfig.ContainerConfig `json:"containerConfig" validate:"required"`
+	BuildConfig       *buildconfig.BuildConfig        `json:"buildConfig" validate:"optional"`
+	Repository        *gitprovider.GitRepository      `json:"repository" validate:"required"`
+	EnvVars           map[string]string               `json:"envVars" validate:"required"`
+	PrebuildId        string                          `json:"prebuildId" validate:"required"`
+	Priority          BuildPriority                   `json:"priority" validate:"required"`
+	Queued            *int                            `json:"queued,omitempty" validate:"optional"`
+	Attempts          []BuildAttempt                  `json:"attempts,omitempty" validate:"optional"`
+	LastErrorCategory *BuildErrorCategory             `json:"lastErrorCategory,omitempty" validate:"optional"`
+	RetryAt           *time.Time                      `json:"retryAt,omitempty" validate:"optional"`
+	CreatedAt         time.Time                       `json:"createdAt" validate:"required"`
+	UpdatedAt         time.Time                       `json:"updatedAt" validate:"required"`
 } // @name Build
 
 func (b *Build) Compare(other *Build) (bool, error) {
@@ -75,6 +81,16 @@ func (b *Build) GetBuildHash() (string, error) {
 		if err != nil {
 			return "", err
 		}
+	} else if b.BuildConfig != nil && b.BuildConfig.Dockerfile != nil {
+		buildJson, err = json.Marshal(b.BuildConfig.Dockerfile)
+		if err != nil {
+			return "", err
+		}
+	} else if b.BuildConfig != nil && b.BuildConfig.Language != nil {
+		buildJson, err = json.Marshal(b.BuildConfig.Language)
+		if err != nil {
+			return "", err
+		}
 	}
 	envVarsJson, err := json.Marshal(b.EnvVars)
 	if err != nil {
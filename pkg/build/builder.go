@@ -4,18 +4,24 @@
 package build
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
 
+	"github.com/daytonaio/daytona/pkg/build/detect"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/docker/docker/client"
 )
 
 type IBuilder interface {
-	Build(build Build) (string, string, error)
+	Build(ctx context.Context, build Build) (string, string, error)
 	CleanUp() error
-	Publish(build Build) error
+	Publish(ctx context.Context, build Build) error
 	GetImageName(build Build) (string, error)
 }
 
@@ -32,6 +38,52 @@ type Builder struct {
 	defaultProjectUser          string
 }
 
+func (b *Builder) CleanUp() error {
+	return os.RemoveAll(b.projectDir)
+}
+
+func (b *Builder) Publish(ctx context.Context, build Build) error {
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	if build.Image == nil {
+		return errors.New("build image is nil")
+	}
+
+	return dockerClient.PushImage(ctx, *build.Image, b.buildImageContainerRegistry, buildLogger)
+}
+
+// detectBuild returns a copy of the build with its detected build config and the name of its image.
+// The name is computed before the detection so that it matches the image name the runner checks for,
+// and the stored build keeps its automatic config
+func (b *Builder) detectBuild(build Build) (Build, detect.BuilderType, string, error) {
+	imageName, err := b.GetImageName(build)
+	if err != nil {
+		return build, "", "", err
+	}
+
+	if build.BuildConfig != nil {
+		buildConfig := *build.BuildConfig
+		build.BuildConfig = &buildConfig
+	}
+
+	builderType, err := detect.DetectProjectBuilderType(build.BuildConfig, b.projectDir, nil)
+	if err != nil {
+		return build, "", "", err
+	}
+
+	return build, builderType, imageName, nil
+}
+
 func (b *Builder) GetImageName(build Build) (string, error) {
 	hash, err := build.GetBuildHash()
 	if err != nil {
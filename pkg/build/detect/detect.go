@@ -8,6 +8,7 @@ import (
 	"path"
 	"path/filepath"
 
+	"github.com/daytonaio/daytona/pkg/build/language"
 	"github.com/daytonaio/daytona/pkg/ssh"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
@@ -16,9 +17,13 @@ type BuilderType string
 
 var (
 	BuilderTypeDevcontainer BuilderType = "devcontainer"
+	BuilderTypeDockerfile   BuilderType = "dockerfile"
+	BuilderTypeLanguage     BuilderType = "language"
 	BuilderTypeImage        BuilderType = "image"
 )
 
+const DOCKERFILE_FILEPATH = "Dockerfile"
+
 func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir string, sshClient *ssh.Client) (BuilderType, error) {
 	if buildConfig == nil {
 		return BuilderTypeImage, nil
@@ -28,6 +33,14 @@ func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir s
 		return BuilderTypeDevcontainer, nil
 	}
 
+	if buildConfig.Dockerfile != nil {
+		return BuilderTypeDockerfile, nil
+	}
+
+	if buildConfig.Language != nil {
+		return BuilderTypeLanguage, nil
+	}
+
 	if sshClient != nil {
 		if _, err := sshClient.ReadFile(path.Join(projectDir, ".devcontainer/devcontainer.json")); err == nil {
 			buildConfig.Devcontainer = &buildconfig.DevcontainerConfig{
@@ -41,6 +54,12 @@ func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir s
 			}
 			return BuilderTypeDevcontainer, nil
 		}
+		if _, err := sshClient.ReadFile(path.Join(projectDir, DOCKERFILE_FILEPATH)); err == nil {
+			buildConfig.Dockerfile = &buildconfig.DockerfileConfig{
+				FilePath: DOCKERFILE_FILEPATH,
+			}
+			return BuilderTypeDockerfile, nil
+		}
 	} else {
 		if devcontainerFilePath, pathError := findDevcontainerConfigFilePath(projectDir); pathError == nil {
 			buildConfig.Devcontainer = &buildconfig.DevcontainerConfig{
@@ -49,6 +68,24 @@ func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir s
 
 			return BuilderTypeDevcontainer, nil
 		}
+
+		if isDockerfile, _ := fileExists(filepath.Join(projectDir, DOCKERFILE_FILEPATH)); isDockerfile {
+			buildConfig.Dockerfile = &buildconfig.DockerfileConfig{
+				FilePath: DOCKERFILE_FILEPATH,
+			}
+
+			return BuilderTypeDockerfile, nil
+		}
+	}
+
+	// Repositories without a container configuration get an image generated from their languages
+	if languages := language.Detect(projectDir, sshClient); len(languages) > 0 {
+		buildConfig.Language = &buildconfig.LanguageConfig{}
+		for _, l := range languages {
+			buildConfig.Language.Languages = append(buildConfig.Language.Languages, string(l))
+		}
+
+		return BuilderTypeLanguage, nil
 	}
 
 	return BuilderTypeImage, nil
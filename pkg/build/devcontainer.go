@@ -6,13 +6,15 @@ package build
 import (
 	"context"
 	"errors"
-	"os"
+	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
 	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
+	log "github.com/sirupsen/logrus"
 )
 
 type BuildOutcome struct {
@@ -27,44 +29,28 @@ type DevcontainerBuilder struct {
 	builderDockerPort uint16
 }
 
-func (b *DevcontainerBuilder) Build(build Build) (string, string, error) {
-	builderType, err := detect.DetectProjectBuilderType(build.BuildConfig, b.projectDir, nil)
+func (b *DevcontainerBuilder) Build(ctx context.Context, build Build) (string, string, error) {
+	build, builderType, imageName, err := b.detectBuild(build)
 	if err != nil {
 		return "", "", err
 	}
 
-	if builderType != detect.BuilderTypeDevcontainer {
-		return "", "", errors.New("failed to detect devcontainer config")
+	switch builderType {
+	case detect.BuilderTypeDevcontainer:
+		return b.buildDevcontainer(ctx, build, imageName)
+	case detect.BuilderTypeDockerfile:
+		// Builds with an automatic configuration only know their builder type once the repository is cloned
+		dockerfileBuilder := &DockerfileBuilder{Builder: b.Builder}
+		return dockerfileBuilder.buildDockerfile(ctx, build, imageName)
+	case detect.BuilderTypeLanguage:
+		languageBuilder := &LanguageBuilder{Builder: b.Builder}
+		return languageBuilder.buildLanguage(ctx, build, imageName)
+	default:
+		return "", "", errors.New("failed to detect devcontainer, Dockerfile or project languages")
 	}
-
-	return b.buildDevcontainer(build)
-}
-
-func (b *DevcontainerBuilder) CleanUp() error {
-	return os.RemoveAll(b.projectDir)
-}
-
-func (b *DevcontainerBuilder) Publish(build Build) error {
-	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
-	defer buildLogger.Close()
-
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-	if err != nil {
-		return err
-	}
-
-	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
-		ApiClient: cli,
-	})
-
-	if build.Image == nil {
-		return errors.New("build image is nil")
-	}
-
-	return dockerClient.PushImage(*build.Image, b.buildImageContainerRegistry, buildLogger)
 }
 
-func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, error) {
+func (b *DevcontainerBuilder) buildDevcontainer(ctx context.Context, build Build, imageName string) (string, string, error) {
 	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
 	defer buildLogger.Close()
 
@@ -82,7 +68,7 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		return b.defaultProjectImage, b.defaultProjectUser, err
 	}
 
-	containerId, remoteUser, err := dockerClient.CreateFromDevcontainer(docker.CreateDevcontainerOptions{
+	containerId, remoteUser, err := dockerClient.CreateFromDevcontainer(ctx, docker.CreateDevcontainerOptions{
 		BuildConfig:              build.BuildConfig,
 		ProjectName:              build.Id,
 		ContainerRegistry:        b.buildImageContainerRegistry,
@@ -97,22 +83,42 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		EnvVars:    build.EnvVars,
 	})
 	if err != nil {
+		if ctx.Err() != nil {
+			b.removeBuildContainers(cli, build.Id)
+		}
 		return b.defaultProjectImage, b.defaultProjectUser, err
 	}
 
 	defer dockerClient.RemoveContainer(containerId) // nolint: errcheck
 
-	imageName, err := b.GetImageName(build)
+	_, err = cli.ContainerCommit(ctx, containerId, container.CommitOptions{
+		Reference: imageName,
+	})
 	if err != nil {
-		return b.defaultProjectImage, b.defaultProjectUser, err
+		return b.defaultProjectImage, b.defaultProjectUser, NewBuildError(BuildErrorCategoryCommit, err)
 	}
 
-	_, err = cli.ContainerCommit(context.Background(), containerId, container.CommitOptions{
-		Reference: imageName,
+	return imageName, string(remoteUser), err
+}
+
+// removeBuildContainers removes the containers the devcontainer CLI created for the build before it was cancelled
+func (b *DevcontainerBuilder) removeBuildContainers(cli client.APIClient, buildId string) {
+	containers, err := cli.ContainerList(context.Background(), container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("daytona.build.id=%s", buildId))),
+		All:     true,
 	})
 	if err != nil {
-		return b.defaultProjectImage, b.defaultProjectUser, err
+		log.Error(err)
+		return
 	}
 
-	return imageName, string(remoteUser), err
+	for _, c := range containers {
+		err = cli.ContainerRemove(context.Background(), c.ID, container.RemoveOptions{
+			Force:         true,
+			RemoveVolumes: true,
+		})
+		if err != nil {
+			log.Error(err)
+		}
+	}
 }
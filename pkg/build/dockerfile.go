@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/docker/docker/client"
+)
+
+type DockerfileBuilder struct {
+	*Builder
+}
+
+func (b *DockerfileBuilder) Build(ctx context.Context, build Build) (string, string, error) {
+	build, builderType, imageName, err := b.detectBuild(build)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeDockerfile {
+		return "", "", errors.New("failed to detect Dockerfile config")
+	}
+
+	return b.buildDockerfile(ctx, build, imageName)
+}
+
+func (b *DockerfileBuilder) buildDockerfile(ctx context.Context, build Build, imageName string) (string, string, error) {
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	dockerfileConfig := build.BuildConfig.Dockerfile
+
+	contextDir, dockerfile, err := docker.GetDockerfileBuildPaths(b.projectDir, dockerfileConfig)
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	user, err := dockerClient.BuildImage(ctx, docker.BuildImageOptions{
+		ContextDir: contextDir,
+		Dockerfile: dockerfile,
+		BuildArgs:  dockerfileConfig.BuildArgs,
+		Target:     dockerfileConfig.Target,
+		Tag:        imageName,
+		LogWriter:  buildLogger,
+	})
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	return imageName, user, nil
+}
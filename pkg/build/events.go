@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import "github.com/daytonaio/daytona/pkg/events"
+
+// NewBuildStateEvent returns an event describing the current state of the build
+func NewBuildStateEvent(b *Build) events.Event {
+	event := events.NewEvent(events.EventTypeBuildStateChanged)
+	event.BuildId = b.Id
+	event.PrebuildId = b.PrebuildId
+	event.State = string(b.State)
+
+	return event
+}
+
+// NewBuildFailedEvent returns an event describing why the build failed
+func NewBuildFailedEvent(b *Build, err error) events.Event {
+	event := events.NewEvent(events.EventTypeBuildFailed)
+	event.BuildId = b.Id
+	event.PrebuildId = b.PrebuildId
+	event.State = string(b.State)
+	event.Error = err.Error()
+
+	return event
+}
@@ -54,7 +54,15 @@ func NewBuilderFactory(config BuilderFactoryConfig) IBuilderFactory {
 }
 
 func (f *BuilderFactory) Create(build Build, projectDir string) (IBuilder, error) {
-	// TODO: Implement factory logic after adding prebuilds and other builder types
+	if build.BuildConfig != nil && build.BuildConfig.Devcontainer == nil && build.BuildConfig.Dockerfile != nil {
+		return f.newDockerfileBuilder(projectDir), nil
+	}
+
+	if build.BuildConfig != nil && build.BuildConfig.Devcontainer == nil && build.BuildConfig.Dockerfile == nil && build.BuildConfig.Language != nil {
+		return f.newLanguageBuilder(projectDir), nil
+	}
+
+	// The devcontainer builder falls back to the Dockerfile or language builder if the cloned repository has no devcontainer config
 	return f.newDevcontainerBuilder(projectDir)
 }
 
@@ -82,23 +90,39 @@ func (f *BuilderFactory) newDevcontainerBuilder(projectDir string) (*Devcontaine
 		return nil, err
 	}
 
-	id := stringid.GenerateRandomID()
-	id = stringid.TruncateID(id)
-	id = fmt.Sprintf("%s-%s", "devcontainer-builder", id)
-
 	return &DevcontainerBuilder{
-		Builder: &Builder{
-			id:                          id,
-			projectDir:                  projectDir,
-			image:                       f.image,
-			containerRegistry:           f.containerRegistry,
-			buildImageContainerRegistry: f.buildImageContainerRegistry,
-			buildImageNamespace:         f.buildImageNamespace,
-			buildStore:                  f.buildStore,
-			loggerFactory:               f.loggerFactory,
-			defaultProjectImage:         f.defaultProjectImage,
-			defaultProjectUser:          f.defaultProjectUser,
-		},
+		Builder:           f.newBuilder("devcontainer-builder", projectDir),
 		builderDockerPort: builderDockerPort,
 	}, nil
 }
+
+func (f *BuilderFactory) newDockerfileBuilder(projectDir string) *DockerfileBuilder {
+	return &DockerfileBuilder{
+		Builder: f.newBuilder("dockerfile-builder", projectDir),
+	}
+}
+
+func (f *BuilderFactory) newLanguageBuilder(projectDir string) *LanguageBuilder {
+	return &LanguageBuilder{
+		Builder: f.newBuilder("language-builder", projectDir),
+	}
+}
+
+func (f *BuilderFactory) newBuilder(idPrefix string, projectDir string) *Builder {
+	id := stringid.GenerateRandomID()
+	id = stringid.TruncateID(id)
+	id = fmt.Sprintf("%s-%s", idPrefix, id)
+
+	return &Builder{
+		id:                          id,
+		projectDir:                  projectDir,
+		image:                       f.image,
+		containerRegistry:           f.containerRegistry,
+		buildImageContainerRegistry: f.buildImageContainerRegistry,
+		buildImageNamespace:         f.buildImageNamespace,
+		buildStore:                  f.buildStore,
+		loggerFactory:               f.loggerFactory,
+		defaultProjectImage:         f.defaultProjectImage,
+		defaultProjectUser:          f.defaultProjectUser,
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/build/language"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/docker/docker/client"
+)
+
+// LanguageBuilder builds an image on top of the default project image
+// with the toolchains and dependencies of the languages used in the project
+type LanguageBuilder struct {
+	*Builder
+}
+
+func (b *LanguageBuilder) Build(ctx context.Context, build Build) (string, string, error) {
+	build, builderType, imageName, err := b.detectBuild(build)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeLanguage {
+		return "", "", errors.New("failed to detect project languages")
+	}
+
+	return b.buildLanguage(ctx, build, imageName)
+}
+
+func (b *LanguageBuilder) buildLanguage(ctx context.Context, build Build, imageName string) (string, string, error) {
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	buildLogger.Write([]byte(fmt.Sprintf("Building image for languages: %s\n", strings.Join(build.BuildConfig.Language.Languages, ", "))))
+
+	languages := []language.Language{}
+	for _, l := range build.BuildConfig.Language.Languages {
+		languages = append(languages, language.Language(l))
+	}
+
+	// The image is built on top of the project image like the image built by the Docker provider
+	baseImage, baseUser := build.ContainerConfig.Image, build.ContainerConfig.User
+	if baseImage == "" {
+		baseImage, baseUser = b.defaultProjectImage, b.defaultProjectUser
+	}
+
+	dockerfileContent, err := language.GenerateDockerfile(languages, baseImage, baseUser)
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	user, err := dockerClient.BuildImage(ctx, docker.BuildImageOptions{
+		ContextDir:        b.projectDir,
+		Dockerfile:        language.DOCKERFILE_NAME,
+		DockerfileContent: &dockerfileContent,
+		Tag:               imageName,
+		LogWriter:         buildLogger,
+	})
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	return imageName, user, nil
+}
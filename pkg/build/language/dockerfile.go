@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package language
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Name of the generated Dockerfile in the build context
+const DOCKERFILE_NAME = ".daytona.Dockerfile"
+
+const dependenciesDir = "/tmp/daytona-dependencies"
+
+// GenerateDockerfile generates a Dockerfile that installs the toolchains of the languages missing from the base image
+// and warms the caches of the user with the dependencies of the project.
+// Installing the dependencies is best-effort since it fails for some workspaces and multi-module projects.
+// The build context of the Dockerfile is the project directory
+func GenerateDockerfile(languages []Language, baseImage, user string) (string, error) {
+	if len(languages) == 0 {
+		return "", errors.New("no languages detected")
+	}
+
+	if user == "" {
+		user = "root"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("FROM %s\n", baseImage))
+
+	sb.WriteString("\nUSER root\n")
+	for _, l := range languages {
+		t, ok := toolchains[l]
+		if !ok {
+			return "", fmt.Errorf("unsupported language: %s", l)
+		}
+
+		sb.WriteString(fmt.Sprintf("RUN if ! command -v %s >/dev/null 2>&1; then apt-get update && apt-get install -y --no-install-recommends %s && rm -rf /var/lib/apt/lists/*; fi\n", t.Command, strings.Join(t.Packages, " ")))
+	}
+
+	sb.WriteString(fmt.Sprintf("\nUSER %s\n", user))
+	for _, l := range languages {
+		t := toolchains[l]
+		dir := fmt.Sprintf("%s/%s", dependenciesDir, l)
+
+		sb.WriteString(fmt.Sprintf("COPY --chown=%s %s %s/\n", user, strings.Join(t.DependencyFiles, " "), dir))
+		sb.WriteString(fmt.Sprintf("RUN cd %s && (%s || echo \"Failed to install %s dependencies\"); rm -rf %s\n", dir, t.InstallCommand, l, dir))
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package language
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+)
+
+type Language string
+
+var (
+	Go     Language = "go"
+	Node   Language = "node"
+	Python Language = "python"
+	Rust   Language = "rust"
+	Java   Language = "java"
+)
+
+type toolchain struct {
+	// Command used to check if the toolchain is already installed in the base image
+	Command string
+	// Debian packages installed if the command is not found
+	Packages []string
+	// Files that mark a project written in the language. Only the files in the root of the project are checked
+	ManifestFiles []string
+	// Files copied into the image to install the dependencies. Patterns ending with * are optional
+	DependencyFiles []string
+	// Command that installs the dependencies of the project into the caches of the user
+	InstallCommand string
+}
+
+// Languages are detected and layered in this order
+var languages = []Language{Go, Node, Python, Rust, Java}
+
+var toolchains = map[Language]toolchain{
+	Go: {
+		Command:         "go",
+		Packages:        []string{"golang-go"},
+		ManifestFiles:   []string{"go.mod"},
+		DependencyFiles: []string{"go.mod", "go.sum*"},
+		InstallCommand:  "go mod download",
+	},
+	Node: {
+		Command:         "npm",
+		Packages:        []string{"nodejs", "npm"},
+		ManifestFiles:   []string{"package.json"},
+		DependencyFiles: []string{"package.json", "package-lock.json*"},
+		InstallCommand:  "if [ -f package-lock.json ]; then npm ci --ignore-scripts; else npm install --ignore-scripts; fi",
+	},
+	Python: {
+		Command:         "pip3",
+		Packages:        []string{"python3", "python3-pip"},
+		ManifestFiles:   []string{"pyproject.toml", "requirements.txt"},
+		DependencyFiles: []string{"pyproject.toml*", "requirements.txt*"},
+		// Dependencies declared in pyproject.toml can not be installed without the project sources
+		InstallCommand: "if [ -f requirements.txt ]; then PIP_BREAK_SYSTEM_PACKAGES=1 pip3 install --user -r requirements.txt; fi",
+	},
+	Rust: {
+		Command:         "cargo",
+		Packages:        []string{"cargo"},
+		ManifestFiles:   []string{"Cargo.toml"},
+		DependencyFiles: []string{"Cargo.toml", "Cargo.lock*"},
+		// Cargo refuses to read a manifest without targets
+		InstallCommand: "mkdir -p src && touch src/lib.rs && cargo fetch",
+	},
+	Java: {
+		Command:         "mvn",
+		Packages:        []string{"default-jdk", "maven"},
+		ManifestFiles:   []string{"pom.xml"},
+		DependencyFiles: []string{"pom.xml"},
+		InstallCommand:  "mvn -B dependency:go-offline",
+	},
+}
+
+// Detect returns the languages of the project based on the manifest files found in the project directory.
+// The files are read over SSH if the project was cloned on a remote Docker host
+func Detect(projectDir string, sshClient *ssh.Client) []Language {
+	detected := []Language{}
+
+	for _, l := range languages {
+		for _, manifestFile := range toolchains[l].ManifestFiles {
+			if manifestFileExists(projectDir, manifestFile, sshClient) {
+				detected = append(detected, l)
+				break
+			}
+		}
+	}
+
+	return detected
+}
+
+func manifestFileExists(projectDir, manifestFile string, sshClient *ssh.Client) bool {
+	if sshClient != nil {
+		_, err := sshClient.ReadFile(path.Join(projectDir, manifestFile))
+		return err == nil
+	}
+
+	info, err := os.Stat(filepath.Join(projectDir, manifestFile))
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package language_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/build/language"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	projectDir := t.TempDir()
+
+	require.Empty(t, language.Detect(projectDir, nil))
+
+	for _, file := range []string{"package.json", "go.mod", "requirements.txt"} {
+		err := os.WriteFile(filepath.Join(projectDir, file), []byte{}, 0644)
+		require.NoError(t, err)
+	}
+
+	// Directories are not manifest files
+	err := os.Mkdir(filepath.Join(projectDir, "Cargo.toml"), 0755)
+	require.NoError(t, err)
+
+	require.Equal(t, []language.Language{language.Go, language.Node, language.Python}, language.Detect(projectDir, nil))
+}
+
+func TestGenerateDockerfile(t *testing.T) {
+	dockerfile, err := language.GenerateDockerfile([]language.Language{language.Go, language.Rust}, "daytonaio/workspace-project:latest", "daytona")
+	require.NoError(t, err)
+
+	expected := `FROM daytonaio/workspace-project:latest
+
+USER root
+RUN if ! command -v go >/dev/null 2>&1; then apt-get update && apt-get install -y --no-install-recommends golang-go && rm -rf /var/lib/apt/lists/*; fi
+RUN if ! command -v cargo >/dev/null 2>&1; then apt-get update && apt-get install -y --no-install-recommends cargo && rm -rf /var/lib/apt/lists/*; fi
+
+USER daytona
+COPY --chown=daytona go.mod go.sum* /tmp/daytona-dependencies/go/
+RUN cd /tmp/daytona-dependencies/go && (go mod download || echo "Failed to install go dependencies"); rm -rf /tmp/daytona-dependencies/go
+COPY --chown=daytona Cargo.toml Cargo.lock* /tmp/daytona-dependencies/rust/
+RUN cd /tmp/daytona-dependencies/rust && (mkdir -p src && touch src/lib.rs && cargo fetch || echo "Failed to install rust dependencies"); rm -rf /tmp/daytona-dependencies/rust
+`
+	require.Equal(t, expected, dockerfile)
+
+	_, err = language.GenerateDockerfile([]language.Language{"cobol"}, "daytonaio/workspace-project:latest", "daytona")
+	require.EqualError(t, err, "unsupported language: cobol")
+
+	_, err = language.GenerateDockerfile(nil, "daytonaio/workspace-project:latest", "daytona")
+	require.EqualError(t, err, "no languages detected")
+}
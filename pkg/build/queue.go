@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"sort"
+	"time"
+)
+
+type BuildPriority string
+
+const (
+	// Builds triggered by git provider webhooks
+	BuildPriorityNormal BuildPriority = "normal"
+	// Builds started by users
+	BuildPriorityHigh BuildPriority = "high"
+)
+
+func (p BuildPriority) rank() int {
+	if p == BuildPriorityHigh {
+		return 1
+	}
+
+	// Builds created before priorities were introduced have no priority
+	return 0
+}
+
+// SortBuildQueue sorts builds pending to run in the order the runner starts them.
+// Builds with a higher priority are started first and builds with the same priority in the order they were created
+func SortBuildQueue(builds []*Build) {
+	sort.SliceStable(builds, func(i, j int) bool {
+		if builds[i].Priority.rank() != builds[j].Priority.rank() {
+			return builds[i].Priority.rank() > builds[j].Priority.rank()
+		}
+
+		return builds[i].CreatedAt.Before(builds[j].CreatedAt)
+	})
+}
+
+// GetQueuePositions returns a map of build ID -> 1-based position in the queue of the builds pending to run.
+// Builds that are waiting for a retry are not in the queue until their backoff expires
+func GetQueuePositions(builds []*Build) map[string]int {
+	now := time.Now()
+	queue := []*Build{}
+	for _, b := range builds {
+		if b.isQueued(now) {
+			queue = append(queue, b)
+		}
+	}
+
+	SortBuildQueue(queue)
+
+	positions := make(map[string]int, len(queue))
+	for i, b := range queue {
+		positions[b.Id] = i + 1
+	}
+
+	return positions
+}
+
+// isQueued reports whether the build is pending to run and is not waiting for the backoff of a retry
+func (b *Build) isQueued(now time.Time) bool {
+	return b.State == BuildStatePendingRun && (b.RetryAt == nil || !b.RetryAt.After(now))
+}
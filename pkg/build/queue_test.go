@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetQueuePositions(t *testing.T) {
+	now := time.Now()
+
+	builds := []*build.Build{
+		{Id: "published", State: build.BuildStatePublished, Priority: build.BuildPriorityHigh, CreatedAt: now.Add(-time.Hour)},
+		{Id: "prebuild-1", State: build.BuildStatePendingRun, Priority: build.BuildPriorityNormal, CreatedAt: now.Add(-3 * time.Minute)},
+		{Id: "manual", State: build.BuildStatePendingRun, Priority: build.BuildPriorityHigh, CreatedAt: now.Add(-time.Minute)},
+		{Id: "legacy", State: build.BuildStatePendingRun, CreatedAt: now.Add(-2 * time.Minute)},
+		{Id: "retry", State: build.BuildStatePendingRun, Priority: build.BuildPriorityHigh, CreatedAt: now.Add(-4 * time.Minute), RetryAt: util.Pointer(now.Add(time.Minute))},
+		{Id: "retry-expired", State: build.BuildStatePendingRun, Priority: build.BuildPriorityNormal, CreatedAt: now, RetryAt: util.Pointer(now.Add(-time.Minute))},
+	}
+
+	positions := build.GetQueuePositions(builds)
+
+	require.Equal(t, map[string]int{
+		"manual":        1,
+		"prebuild-1":    2,
+		"legacy":        3,
+		"retry-expired": 4,
+	}, positions)
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+	"time"
+)
+
+type BuildErrorCategory string
+
+const (
+	BuildErrorCategoryClone        BuildErrorCategory = "clone"
+	BuildErrorCategoryDevcontainer BuildErrorCategory = "devcontainer"
+	BuildErrorCategoryCommit       BuildErrorCategory = "commit"
+	BuildErrorCategoryPublish      BuildErrorCategory = "publish"
+)
+
+const (
+	retryBackoffMin = 30 * time.Second
+	retryBackoffMax = 10 * time.Minute
+)
+
+// Retryable returns true if the failures of the category are usually transient.
+// Cloning and publishing depend on the git provider and the container registry being reachable,
+// while devcontainer and commit failures are caused by the build configuration or the local Docker daemon
+func (c BuildErrorCategory) Retryable() bool {
+	return c == BuildErrorCategoryClone || c == BuildErrorCategoryPublish
+}
+
+type BuildAttempt struct {
+	Number        int                 `json:"number" validate:"required"`
+	StartedAt     time.Time           `json:"startedAt" validate:"required"`
+	ErrorCategory *BuildErrorCategory `json:"errorCategory,omitempty" validate:"optional"`
+	Error         *string             `json:"error,omitempty" validate:"optional"`
+} // @name BuildAttempt
+
+// BuildError is returned by builders to classify the step of the build that failed
+type BuildError struct {
+	Category BuildErrorCategory
+	Err      error
+}
+
+func NewBuildError(category BuildErrorCategory, err error) error {
+	return &BuildError{
+		Category: category,
+		Err:      err,
+	}
+}
+
+func (e *BuildError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BuildError) Unwrap() error {
+	return e.Err
+}
+
+// GetErrorCategory returns the category of a build error or the default category of the failed step
+func GetErrorCategory(err error, defaultCategory BuildErrorCategory) BuildErrorCategory {
+	var buildErr *BuildError
+	if errors.As(err, &buildErr) {
+		return buildErr.Category
+	}
+
+	return defaultCategory
+}
+
+// GetRetryBackoff returns the delay before the next attempt of a build that failed the given number of times
+func GetRetryBackoff(failedAttempts int) time.Duration {
+	if failedAttempts < 1 {
+		failedAttempts = 1
+	}
+
+	backoff := retryBackoffMin << (failedAttempts - 1)
+	if backoff > retryBackoffMax || backoff <= 0 {
+		return retryBackoffMax
+	}
+
+	return backoff
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetErrorCategory(t *testing.T) {
+	commitErr := fmt.Errorf("failed to build: %w", build.NewBuildError(build.BuildErrorCategoryCommit, errors.New("no space left on device")))
+
+	require.Equal(t, build.BuildErrorCategoryCommit, build.GetErrorCategory(commitErr, build.BuildErrorCategoryDevcontainer))
+	require.Equal(t, build.BuildErrorCategoryDevcontainer, build.GetErrorCategory(errors.New("invalid devcontainer.json"), build.BuildErrorCategoryDevcontainer))
+	require.EqualError(t, commitErr, "failed to build: no space left on device")
+}
+
+func TestGetRetryBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, build.GetRetryBackoff(1))
+	require.Equal(t, time.Minute, build.GetRetryBackoff(2))
+	require.Equal(t, 2*time.Minute, build.GetRetryBackoff(3))
+	require.Equal(t, 10*time.Minute, build.GetRetryBackoff(10))
+	require.Equal(t, 10*time.Minute, build.GetRetryBackoff(100))
+}
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
@@ -34,6 +36,11 @@ type BuildRunnerInstanceConfig struct {
 	BasePath          string
 	TelemetryEnabled  bool
 	TelemetryService  telemetry.TelemetryService
+	EventBus          *events.EventBus
+	// Maximum number of builds that run at the same time
+	WorkerPoolSize int
+	// Maximum number of attempts of a build that fails with a retryable error
+	MaxBuildAttempts int
 }
 
 type BuildRunner struct {
@@ -48,6 +55,13 @@ type BuildRunner struct {
 	basePath          string
 	telemetryEnabled  bool
 	telemetryService  telemetry.TelemetryService
+	eventBus          *events.EventBus
+	workerPoolSize    int
+	maxBuildAttempts  int
+	// Build ID -> cancels the build run by a worker
+	runningBuilds map[string]context.CancelFunc
+	mutex         sync.Mutex
+	unsubscribe   func()
 }
 
 type BuildProcessConfig struct {
@@ -57,6 +71,8 @@ type BuildProcessConfig struct {
 	ProjectDir  string
 	GitService  git.IGitService
 	Wg          *sync.WaitGroup
+	// Skips cloning and building and only publishes the image of a build whose last attempt failed to publish it
+	PublishOnly bool
 }
 
 type GitProviderStore interface {
@@ -64,6 +80,16 @@ type GitProviderStore interface {
 }
 
 func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
+	workerPoolSize := config.WorkerPoolSize
+	if workerPoolSize <= 0 {
+		workerPoolSize = DEFAULT_WORKER_POOL_SIZE
+	}
+
+	maxBuildAttempts := config.MaxBuildAttempts
+	if maxBuildAttempts <= 0 {
+		maxBuildAttempts = DEFAULT_MAX_BUILD_ATTEMPTS
+	}
+
 	runner := &BuildRunner{
 		Id:                config.BuildRunnerId,
 		scheduler:         config.Scheduler,
@@ -76,12 +102,22 @@ func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
 		basePath:          config.BasePath,
 		telemetryEnabled:  config.TelemetryEnabled,
 		telemetryService:  config.TelemetryService,
+		eventBus:          config.EventBus,
+		workerPoolSize:    workerPoolSize,
+		maxBuildAttempts:  maxBuildAttempts,
+		runningBuilds:     map[string]context.CancelFunc{},
 	}
 
 	return runner
 }
 
 func (r *BuildRunner) Start() error {
+	if r.eventBus != nil {
+		var buildEvents <-chan events.Event
+		buildEvents, r.unsubscribe = r.eventBus.Subscribe(events.EventTypeBuildStateChanged)
+		go r.handleCancelledBuilds(buildEvents)
+	}
+
 	err := r.scheduler.AddFunc(r.runInterval, func() { r.RunBuilds() })
 	if err != nil {
 		return err
@@ -97,9 +133,19 @@ func (r *BuildRunner) Start() error {
 
 func (r *BuildRunner) Stop() {
 	r.scheduler.Stop()
+
+	if r.unsubscribe != nil {
+		r.unsubscribe()
+	}
 }
 
+// RunBuilds starts the builds pending to run on the free workers of the pool.
+// Builds are started in the order of their priority and creation time.
+// Builds that are waiting for a retry are skipped until their backoff expires
 func (r *BuildRunner) RunBuilds() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
 	builds, err := r.buildStore.List(&Filter{
 		States: &[]BuildState{BuildStatePendingRun, BuildStatePublished},
 	})
@@ -108,52 +154,82 @@ func (r *BuildRunner) RunBuilds() {
 		return
 	}
 
-	var wg sync.WaitGroup
+	now := time.Now()
+	queue := []*Build{}
 	for _, b := range builds {
-		if b.State == BuildStatePendingRun {
-			wg.Add(1)
+		if !b.isQueued(now) {
+			continue
+		}
 
-			if b.BuildConfig == nil {
-				return
-			}
+		if _, ok := r.runningBuilds[b.Id]; !ok {
+			queue = append(queue, b)
+		}
+	}
 
-			buildLogger := r.loggerFactory.CreateBuildLogger(b.Id, logs.LogSourceBuilder)
-			defer buildLogger.Close()
+	if len(queue) == 0 || len(r.runningBuilds) >= r.workerPoolSize {
+		return
+	}
 
-			projectDir := filepath.Join(r.basePath, b.Id, "project")
+	SortBuildQueue(queue)
 
-			builder, err := r.builderFactory.Create(*b, projectDir)
-			if err != nil {
-				r.handleBuildError(*b, builder, err, buildLogger)
-				return
-			}
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		log.Error(err)
+		return
+	}
 
-			cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-			if err != nil {
-				log.Error(err)
-				return
-			}
+	for _, b := range queue {
+		if len(r.runningBuilds) >= r.workerPoolSize {
+			return
+		}
 
-			imageName, err := builder.GetImageName(*b)
+		if b.BuildConfig == nil {
+			continue
+		}
+
+		buildLogger := r.loggerFactory.CreateBuildLogger(b.Id, logs.LogSourceBuilder)
+
+		projectDir := filepath.Join(r.basePath, b.Id, "project")
+
+		builder, err := r.builderFactory.Create(*b, projectDir)
+		if err != nil {
+			r.handleBuildError(*b, builder, err, buildLogger)
+			buildLogger.Close()
+			continue
+		}
+
+		imageName, err := builder.GetImageName(*b)
+		if err != nil {
+			r.handleBuildError(*b, builder, err, buildLogger)
+			buildLogger.Close()
+			continue
+		}
+
+		// The image of a build that failed to publish exists locally but was never pushed
+		publishOnly := false
+		if b.LastErrorCategory != nil && *b.LastErrorCategory == BuildErrorCategoryPublish && b.Image != nil {
+			_, _, err = cli.ImageInspectWithRaw(context.Background(), *b.Image)
+			publishOnly = err == nil
+		} else if _, _, err = cli.ImageInspectWithRaw(context.Background(), imageName); err == nil {
+			b.State = BuildStatePublished
+			err = r.saveBuild(b)
 			if err != nil {
 				r.handleBuildError(*b, builder, err, buildLogger)
-				return
 			}
+			buildLogger.Close()
+			continue
+		}
 
-			_, _, err = cli.ImageInspectWithRaw(context.Background(), imageName)
-			if err == nil {
-				b.State = BuildStatePublished
-				err = r.buildStore.Save(b)
-				if err != nil {
-					r.handleBuildError(*b, builder, err, buildLogger)
-					return
-				}
-				return
-			}
+		b.BuildConfig.CachedBuild = GetCachedBuild(b, builds)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		r.runningBuilds[b.Id] = cancel
 
-			b.BuildConfig.CachedBuild = GetCachedBuild(b, builds)
+		go func(b *Build) {
+			defer r.releaseWorker(b.Id)
+			defer buildLogger.Close()
 
-			go r.RunBuildProcess(BuildProcessConfig{
+			r.RunBuildProcess(ctx, BuildProcessConfig{
 				Builder:     builder,
 				BuildLogger: buildLogger,
 				Build:       b,
@@ -162,12 +238,40 @@ func (r *BuildRunner) RunBuilds() {
 					ProjectDir: projectDir,
 					LogWriter:  buildLogger,
 				},
-				Wg: &wg,
+				PublishOnly: publishOnly,
 			})
-		}
+		}(b)
 	}
+}
 
-	wg.Wait()
+// releaseWorker frees the worker of a finished build and starts the next queued build
+func (r *BuildRunner) releaseWorker(buildId string) {
+	r.mutex.Lock()
+	cancel, ok := r.runningBuilds[buildId]
+	if ok {
+		cancel()
+		delete(r.runningBuilds, buildId)
+	}
+	r.mutex.Unlock()
+
+	r.RunBuilds()
+}
+
+// handleCancelledBuilds stops the builds that are cancelled while a worker runs them
+func (r *BuildRunner) handleCancelledBuilds(buildEvents <-chan events.Event) {
+	for event := range buildEvents {
+		if event.State != string(BuildStateCancelled) {
+			continue
+		}
+
+		r.mutex.Lock()
+		cancel, ok := r.runningBuilds[event.BuildId]
+		r.mutex.Unlock()
+
+		if ok {
+			cancel()
+		}
+	}
 }
 
 func (r *BuildRunner) DeleteBuilds() {
@@ -200,7 +304,7 @@ func (r *BuildRunner) DeleteBuilds() {
 			force := b.State == BuildStatePendingForcedDelete
 
 			b.State = BuildStateDeleting
-			err = r.buildStore.Save(b)
+			err = r.saveBuild(b)
 			if err != nil {
 				r.handleBuildError(*b, nil, err, buildLogger)
 				return
@@ -228,13 +332,18 @@ func (r *BuildRunner) DeleteBuilds() {
 	wg.Wait()
 }
 
-func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
+func (r *BuildRunner) RunBuildProcess(ctx context.Context, config BuildProcessConfig) {
 	if config.Wg != nil {
 		defer config.Wg.Done()
 	}
 
 	config.Build.State = BuildStateRunning
-	err := r.buildStore.Save(config.Build)
+	config.Build.RetryAt = nil
+	config.Build.Attempts = append(config.Build.Attempts, BuildAttempt{
+		Number:    len(config.Build.Attempts) + 1,
+		StartedAt: time.Now(),
+	})
+	err := r.saveBuild(config.Build)
 	if err != nil {
 		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
 		return
@@ -253,35 +362,55 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 		auth.Password = gitProviders[0].Token
 	}
 
-	err = config.GitService.CloneRepository(config.Build.Repository, auth)
-	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+	if len(config.Build.Attempts) > 1 {
+		config.BuildLogger.Write([]byte(fmt.Sprintf("Starting build attempt %d of %d\n", len(config.Build.Attempts), r.maxBuildAttempts)))
 	}
 
-	image, user, err := config.Builder.Build(*config.Build)
-	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+	if config.PublishOnly {
+		config.BuildLogger.Write([]byte("Publishing the image of the previous attempt\n"))
+	} else {
+		err = config.GitService.CloneRepository(ctx, config.Build.Repository, auth)
+		if err != nil {
+			r.handleBuildProcessError(ctx, config.Build, config.Builder, BuildErrorCategoryClone, err, config.BuildLogger)
+			return
+		}
+
+		image, user, err := config.Builder.Build(ctx, *config.Build)
+		if err != nil {
+			r.handleBuildProcessError(ctx, config.Build, config.Builder, BuildErrorCategoryDevcontainer, err, config.BuildLogger)
+			return
+		}
+
+		config.Build.Image = &image
+		config.Build.User = &user
+	}
+
+	if r.isBuildCancelled(ctx, config.Build.Id) {
+		r.handleBuildCancelled(*config.Build, config.Builder, config.BuildLogger)
 		return
 	}
 
-	config.Build.Image = &image
-	config.Build.User = &user
 	config.Build.State = BuildStateSuccess
-	err = r.buildStore.Save(config.Build)
+	err = r.saveBuild(config.Build)
 	if err != nil {
 		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
-	err = config.Builder.Publish(*config.Build)
+	err = config.Builder.Publish(ctx, *config.Build)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, config.Build, config.Builder, BuildErrorCategoryPublish, err, config.BuildLogger)
+		return
+	}
+
+	// A build cancelled while its image was pushed stays cancelled even if the push completed
+	if r.isBuildCancelled(ctx, config.Build.Id) {
+		r.handleBuildCancelled(*config.Build, config.Builder, config.BuildLogger)
 		return
 	}
 
 	config.Build.State = BuildStatePublished
-	err = r.buildStore.Save(config.Build)
+	err = r.saveBuild(config.Build)
 	if err != nil {
 		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
 		return
@@ -300,6 +429,90 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	}
 }
 
+// handleBuildProcessError records the failed attempt of a build step and schedules a retry if the error is retryable.
+// Steps that are interrupted because the build was cancelled are not retried
+func (r *BuildRunner) handleBuildProcessError(ctx context.Context, b *Build, builder IBuilder, category BuildErrorCategory, err error, buildLogger logs.Logger) {
+	if ctx.Err() != nil {
+		r.handleBuildCancelled(*b, builder, buildLogger)
+		return
+	}
+
+	category = GetErrorCategory(err, category)
+	b.LastErrorCategory = &category
+
+	if len(b.Attempts) > 0 {
+		errMsg := err.Error()
+		b.Attempts[len(b.Attempts)-1].ErrorCategory = &category
+		b.Attempts[len(b.Attempts)-1].Error = &errMsg
+	}
+
+	if category.Retryable() && len(b.Attempts) < r.maxBuildAttempts {
+		r.handleBuildRetry(*b, builder, err, buildLogger)
+		return
+	}
+
+	r.handleBuildError(*b, builder, err, buildLogger)
+}
+
+func (r *BuildRunner) handleBuildRetry(b Build, builder IBuilder, err error, buildLogger logs.Logger) {
+	backoff := GetRetryBackoff(len(b.Attempts))
+	retryAt := time.Now().Add(backoff)
+
+	msg := fmt.Sprintf("Build attempt %d of %d failed: %s\n", len(b.Attempts), r.maxBuildAttempts, err.Error())
+	msg += fmt.Sprintf("Retrying in %s\n", backoff)
+
+	b.State = BuildStatePendingRun
+	b.RetryAt = &retryAt
+
+	err = r.saveBuild(&b)
+	if err != nil {
+		msg += fmt.Sprintf("Error saving build: %s\n", err.Error())
+	}
+
+	if builder != nil {
+		cleanupErr := builder.CleanUp()
+		if cleanupErr != nil {
+			msg += fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())
+		}
+	}
+
+	buildLogger.Write([]byte(msg + "\n"))
+}
+
+// isBuildCancelled also checks the stored state of the build since the cancellation event may not have been delivered
+func (r *BuildRunner) isBuildCancelled(ctx context.Context, buildId string) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+
+	b, err := r.buildStore.Find(&Filter{
+		Id: &buildId,
+	})
+	if err != nil {
+		return false
+	}
+
+	return b.State == BuildStateCancelled
+}
+
+func (r *BuildRunner) handleBuildCancelled(b Build, builder IBuilder, buildLogger logs.Logger) {
+	b.State = BuildStateCancelled
+
+	err := r.saveBuild(&b)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Error saving build: %s\n", err.Error())))
+	}
+
+	if builder != nil {
+		err = builder.CleanUp()
+		if err != nil {
+			buildLogger.Write([]byte(fmt.Sprintf("Error cleaning up build: %s\n", err.Error())))
+		}
+	}
+
+	buildLogger.Write([]byte("\n \n" + lipgloss.NewStyle().Bold(true).Render("Build cancelled")))
+}
+
 func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, buildLogger logs.Logger) {
 	var errMsg string
 	errMsg += "################################################\n"
@@ -307,7 +520,9 @@ func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, bui
 	errMsg += "################################################\n"
 
 	b.State = BuildStateError
-	err = r.buildStore.Save(&b)
+	r.eventBus.Publish(NewBuildFailedEvent(&b, err))
+
+	err = r.saveBuild(&b)
 	if err != nil {
 		errMsg += fmt.Sprintf("Error saving build: %s\n", err.Error())
 	}
@@ -326,6 +541,18 @@ func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, bui
 	}
 }
 
+// saveBuild persists the build and publishes its new state
+func (r *BuildRunner) saveBuild(b *Build) error {
+	err := r.buildStore.Save(b)
+	if err != nil {
+		return err
+	}
+
+	r.eventBus.Publish(NewBuildStateEvent(b))
+
+	return nil
+}
+
 func (r *BuildRunner) logTelemetry(ctx context.Context, b Build, err error) {
 	telemetryProps := telemetry.NewBuildRunnerEventProps(ctx, b.Id, string(b.State))
 	event := telemetry.BuildRunnerEventRunBuild
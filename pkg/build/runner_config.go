@@ -13,14 +13,21 @@ import (
 	"github.com/google/uuid"
 )
 
-// TODO: add lock when running interval func
 // 10 second interval
 const DEFAULT_POLL_INTERVAL = "*/10 * * * * *"
 
+const DEFAULT_WORKER_POOL_SIZE = 2
+
+const DEFAULT_MAX_BUILD_ATTEMPTS = 3
+
 type Config struct {
 	Id               string `json:"id" validate:"required"`
 	Interval         string `json:"interval" validate:"required"`
 	TelemetryEnabled bool   `json:"telemetryEnabled" validate:"required"`
+	// Maximum number of builds that run at the same time
+	WorkerPoolSize int `json:"workerPoolSize" validate:"required"`
+	// Maximum number of attempts of a build that fails with a retryable error
+	MaxBuildAttempts int `json:"maxBuildAttempts" validate:"required"`
 } // @name BuildRunnerConfig
 
 func GetConfig() (*Config, error) {
@@ -59,6 +66,12 @@ func GetConfig() (*Config, error) {
 	if c.Id == "" {
 		c.Id = uuid.NewString()
 	}
+	if c.WorkerPoolSize <= 0 {
+		c.WorkerPoolSize = DEFAULT_WORKER_POOL_SIZE
+	}
+	if c.MaxBuildAttempts <= 0 {
+		c.MaxBuildAttempts = DEFAULT_MAX_BUILD_ATTEMPTS
+	}
 	err = Save(c)
 	if err != nil {
 		return nil, err
@@ -109,5 +122,7 @@ func getDefaultConfig() *Config {
 		Id:               uuid.NewString(),
 		Interval:         DEFAULT_POLL_INTERVAL,
 		TelemetryEnabled: false,
+		WorkerPoolSize:   DEFAULT_WORKER_POOL_SIZE,
+		MaxBuildAttempts: DEFAULT_MAX_BUILD_ATTEMPTS,
 	}
 }
@@ -4,7 +4,10 @@
 package build_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	t_build "github.com/daytonaio/daytona/internal/testing/build"
 	git_mocks "github.com/daytonaio/daytona/internal/testing/git/mocks"
@@ -17,6 +20,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -36,7 +40,7 @@ type BuildRunnerTestSuite struct {
 	loggerFactory              logs.LoggerFactory
 	mockBuildStore             build.Store
 	mockGitProviderConfigStore t_gitprovider.MockGitProviderConfigStore
-	Runner                     build.BuildRunner
+	Runner                     *build.BuildRunner
 }
 
 func NewBuildRunnerTestSuite() *BuildRunnerTestSuite {
@@ -55,7 +59,7 @@ func TestBuildRunner(t *testing.T) {
 	logTempDir := t.TempDir()
 	s.loggerFactory = logs.NewLoggerFactory(nil, &logTempDir)
 
-	s.Runner = *build.NewBuildRunner(build.BuildRunnerInstanceConfig{
+	s.Runner = build.NewBuildRunner(build.BuildRunnerInstanceConfig{
 		Interval:         "0 */5 * * * *",
 		Scheduler:        &s.mockScheduler,
 		BuildRunnerId:    "1",
@@ -104,31 +108,29 @@ func (s *BuildRunnerTestSuite) TestRun() {
 func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 	pendingBuild := *mocks.MockBuild
 	s.mockGitProviderConfigStore.On("ListConfigsForUrl", pendingBuild.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
-	s.mockGitService.On("CloneRepository", pendingBuild.Repository, &http.BasicAuth{
+	s.mockGitService.On("CloneRepository", mock.Anything, pendingBuild.Repository, &http.BasicAuth{
 		Username: gitProviderConfig.Username,
 	}).Return(nil)
 
 	mockGitService := git_mocks.NewMockGitService()
-	mockGitService.On("CloneRepository", pendingBuild.Repository, &http.BasicAuth{
+	mockGitService.On("CloneRepository", mock.Anything, pendingBuild.Repository, &http.BasicAuth{
 		Username: gitProviderConfig.Username,
 	}).Return(nil)
 
-	runningBuild := *mocks.MockBuild
-	runningBuild.State = build.BuildStateRunning
-	s.mockBuilder.On("Build", runningBuild).Return("image", "user", nil)
+	s.mockBuilder.On("Build", mock.Anything, mock.MatchedBy(func(b build.Build) bool {
+		return b.Id == pendingBuild.Id && b.State == build.BuildStateRunning
+	})).Return("image", "user", nil)
 
-	successBuild := *mocks.MockBuild
-	successBuild.State = build.BuildStateSuccess
-	successBuild.Image = util.Pointer("image")
-	successBuild.User = util.Pointer("user")
-	s.mockBuilder.On("Publish", successBuild).Return(nil)
+	s.mockBuilder.On("Publish", mock.Anything, mock.MatchedBy(func(b build.Build) bool {
+		return b.Id == pendingBuild.Id && b.State == build.BuildStateSuccess && *b.Image == "image" && *b.User == "user"
+	})).Return(nil)
 
 	s.mockBuilder.On("CleanUp").Return(nil)
 
 	mockLogger := logger_mocks.NewMockLogger()
 	mockLogger.On("Write", mock.Anything).Return(0, nil)
 
-	s.Runner.RunBuildProcess(build.BuildProcessConfig{
+	s.Runner.RunBuildProcess(context.Background(), build.BuildProcessConfig{
 		Builder:     &s.mockBuilder,
 		BuildLogger: mockLogger,
 		Build:       mocks.MockBuild,
@@ -144,4 +146,224 @@ func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 	s.Require().Equal(mocks.MockBuild.Image, util.Pointer("image"))
 	s.Require().Equal(mocks.MockBuild.User, util.Pointer("user"))
 	s.Require().Equal(mocks.MockBuild.State, build.BuildStatePublished)
+	s.Require().Len(mocks.MockBuild.Attempts, 1)
+	s.Require().Nil(mocks.MockBuild.Attempts[0].ErrorCategory)
+}
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcessRetry() {
+	failingBuild := *mocks.MockBuild
+	failingBuild.Id = "2"
+	failingBuild.State = build.BuildStatePendingRun
+	failingBuild.Attempts = nil
+
+	s.mockGitProviderConfigStore.On("ListConfigsForUrl", failingBuild.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", mock.Anything, failingBuild.Repository, mock.Anything).Return(errors.New("connection reset by peer"))
+
+	mockBuilder := &mocks.MockBuilder{}
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	s.Runner.RunBuildProcess(context.Background(), build.BuildProcessConfig{
+		Builder:     mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &failingBuild,
+		GitService:  mockGitService,
+	})
+
+	mockBuilder.AssertExpectations(s.T())
+
+	require := s.Require()
+
+	b, err := s.mockBuildStore.Find(&build.Filter{
+		Id: &failingBuild.Id,
+	})
+	require.NoError(err)
+	require.Equal(build.BuildStatePendingRun, b.State)
+	require.NotNil(b.RetryAt)
+	require.Equal(build.BuildErrorCategoryClone, *b.LastErrorCategory)
+	require.Len(b.Attempts, 1)
+	require.Equal("connection reset by peer", *b.Attempts[0].Error)
+}
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcessPublishRetry() {
+	failingBuild := *mocks.MockBuild
+	failingBuild.Id = "3"
+	failingBuild.State = build.BuildStatePendingRun
+	failingBuild.Image = nil
+	failingBuild.User = nil
+	failingBuild.Attempts = nil
+
+	s.mockGitProviderConfigStore.On("ListConfigsForUrl", failingBuild.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", mock.Anything, failingBuild.Repository, mock.Anything).Return(nil).Once()
+
+	mockBuilder := &mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything, mock.Anything).Return("image", "user", nil).Once()
+	mockBuilder.On("Publish", mock.Anything, mock.Anything).Return(errors.New("registry unavailable")).Once()
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	s.Runner.RunBuildProcess(context.Background(), build.BuildProcessConfig{
+		Builder:     mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &failingBuild,
+		GitService:  mockGitService,
+	})
+
+	require := s.Require()
+
+	b, err := s.mockBuildStore.Find(&build.Filter{
+		Id: &failingBuild.Id,
+	})
+	require.NoError(err)
+	require.Equal(build.BuildStatePendingRun, b.State)
+	require.Equal(build.BuildErrorCategoryPublish, *b.LastErrorCategory)
+	require.Equal("image", *b.Image)
+
+	// The retry publishes the image of the failed attempt without cloning and building the project again
+	mockBuilder.On("Publish", mock.Anything, mock.MatchedBy(func(b build.Build) bool {
+		return *b.Image == "image" && *b.User == "user"
+	})).Return(nil).Once()
+
+	s.Runner.RunBuildProcess(context.Background(), build.BuildProcessConfig{
+		Builder:     mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       b,
+		GitService:  mockGitService,
+		PublishOnly: true,
+	})
+
+	mockBuilder.AssertExpectations(s.T())
+	mockGitService.AssertNumberOfCalls(s.T(), "CloneRepository", 1)
+	mockBuilder.AssertNumberOfCalls(s.T(), "Build", 1)
+
+	b, err = s.mockBuildStore.Find(&build.Filter{
+		Id: &failingBuild.Id,
+	})
+	require.NoError(err)
+	require.Equal(build.BuildStatePublished, b.State)
+	require.Len(b.Attempts, 2)
+	require.Nil(b.Attempts[1].ErrorCategory)
+}
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcessCancelledDuringPublish() {
+	cancelledBuild := *mocks.MockBuild
+	cancelledBuild.Id = "4"
+	cancelledBuild.State = build.BuildStatePendingRun
+	cancelledBuild.Attempts = nil
+
+	s.mockGitProviderConfigStore.On("ListConfigsForUrl", cancelledBuild.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", mock.Anything, cancelledBuild.Repository, mock.Anything).Return(nil)
+
+	mockBuilder := &mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything, mock.Anything).Return("image", "user", nil)
+	// The build is cancelled while the image is pushed and the cancellation event is lost
+	mockBuilder.On("Publish", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored := args.Get(1).(build.Build)
+		stored.State = build.BuildStateCancelled
+		err := s.mockBuildStore.Save(&stored)
+		s.Require().NoError(err)
+	}).Return(nil)
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	s.Runner.RunBuildProcess(context.Background(), build.BuildProcessConfig{
+		Builder:     mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &cancelledBuild,
+		GitService:  mockGitService,
+	})
+
+	mockBuilder.AssertExpectations(s.T())
+
+	b, err := s.mockBuildStore.Find(&build.Filter{
+		Id: &cancelledBuild.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateCancelled, b.State)
+}
+
+func TestRunBuildsWorkerPoolLimit(t *testing.T) {
+	buildStore := t_build.NewInMemoryBuildStore()
+	for i, id := range []string{"pool-1", "pool-2", "pool-3"} {
+		b := *mocks.MockBuild
+		b.Id = id
+		b.CreatedAt = time.Now().Add(time.Duration(i) * time.Second)
+		b.Attempts = nil
+		buildConfig := *mocks.MockBuild.BuildConfig
+		b.BuildConfig = &buildConfig
+		err := buildStore.Save(&b)
+		require.NoError(t, err)
+	}
+
+	mockBuilder := &mocks.MockBuilder{}
+	mockBuilder.On("GetImageName", mock.Anything).Return("daytona-worker-pool-test", nil)
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockBuilderFactory := &mocks.MockBuilderFactory{}
+	mockBuilderFactory.On("Create", mock.Anything, mock.Anything).Return(mockBuilder, nil)
+
+	// Builds block in their first step until the test releases them
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+
+	mockGitProviderConfigStore := &t_gitprovider.MockGitProviderConfigStore{}
+	mockGitProviderConfigStore.On("ListConfigsForUrl", mock.Anything).Run(func(args mock.Arguments) {
+		started <- struct{}{}
+		<-release
+	}).Return([]*gitprovider.GitProviderConfig{}, errors.New("build stopped by the test"))
+
+	logsDir := t.TempDir()
+	runner := build.NewBuildRunner(build.BuildRunnerInstanceConfig{
+		BuildStore:       buildStore,
+		GitProviderStore: mockGitProviderConfigStore,
+		BuilderFactory:   mockBuilderFactory,
+		LoggerFactory:    logs.NewLoggerFactory(nil, &logsDir),
+		WorkerPoolSize:   2,
+	})
+
+	requireStarted := func(count int) {
+		for i := 0; i < count; i++ {
+			select {
+			case <-started:
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "build was not started")
+			}
+		}
+
+		select {
+		case <-started:
+			require.FailNow(t, "build was started over the worker pool limit")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	runner.RunBuilds()
+	requireStarted(2)
+
+	runner.RunBuilds()
+	requireStarted(0)
+
+	// The worker of a finished build starts the queued build
+	release <- struct{}{}
+	requireStarted(1)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		builds, err := buildStore.List(&build.Filter{
+			States: &[]build.BuildState{build.BuildStateError},
+		})
+		return err == nil && len(builds) == 3
+	}, 5*time.Second, 10*time.Millisecond)
 }
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 
 	"github.com/daytonaio/daytona/pkg/agent"
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/agent/ssh"
 	"github.com/daytonaio/daytona/pkg/agent/tailscale"
@@ -71,9 +72,12 @@ var AgentCmd = &cobra.Command{
 			LogWriter:         gitLogWriter,
 		}
 
+		activityTracker := activity.NewTracker()
+
 		sshServer := &ssh.Server{
 			ProjectDir:        c.ProjectDir,
 			DefaultProjectDir: os.Getenv("HOME"),
+			Activity:          activityTracker,
 		}
 
 		tailscaleHostname := project.GetProjectHostname(c.WorkspaceId, c.ProjectName)
@@ -83,6 +87,7 @@ var AgentCmd = &cobra.Command{
 
 		toolBoxServer := &toolbox.Server{
 			ProjectDir: c.ProjectDir,
+			Activity:   activityTracker,
 		}
 
 		telemetryEnabled := os.Getenv("DAYTONA_TELEMETRY_ENABLED") == "true"
@@ -102,6 +107,7 @@ var AgentCmd = &cobra.Command{
 			Tailscale:        tailscaleServer,
 			LogWriter:        agentLogWriter,
 			TelemetryEnabled: telemetryEnabled,
+			Activity:         activityTracker,
 		}
 
 		return agent.Start()
@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var buildCancelCmd = &cobra.Command{
+	Use:   "cancel [BUILD]",
+	Short: "Cancel a build that is pending to run, running or being published",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		var buildId string
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 {
+			buildList, res, err := apiClient.BuildAPI.ListBuilds(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			cancellableBuilds := []apiclient.Build{}
+			for _, b := range buildList {
+				if b.State == apiclient.BuildStatePendingRun || b.State == apiclient.BuildStateRunning {
+					cancellableBuilds = append(cancellableBuilds, b)
+				}
+			}
+
+			if len(cancellableBuilds) == 0 {
+				views_util.NotifyEmptyBuildList(false)
+				return nil
+			}
+
+			build := selection.GetBuildFromPrompt(cancellableBuilds, "Cancel")
+			if build == nil {
+				return nil
+			}
+			buildId = build.Id
+		} else {
+			buildId = args[0]
+		}
+
+		res, err := apiClient.BuildAPI.CancelBuild(ctx, buildId).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Build %s has been cancelled", buildId))
+		return nil
+	},
+}
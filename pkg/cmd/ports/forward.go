@@ -15,7 +15,8 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
 	"github.com/daytonaio/daytona/internal/util"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/frpc"
 	"github.com/daytonaio/daytona/pkg/views"
 	log "github.com/sirupsen/logrus"
@@ -46,16 +47,23 @@ var PortForwardCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		workspace, err := apiclient.GetWorkspace(args[1], true)
+		workspace, err := apiclient_util.GetWorkspace(args[1], true)
 		if err != nil {
 			return err
 		}
 		workspaceId = workspace.Id
 
+		if publicPreview {
+			err = RequirePortExposure(workspace)
+			if err != nil {
+				return err
+			}
+		}
+
 		if len(args) == 3 {
 			projectName = args[2]
 		} else {
-			projectName, err = apiclient.GetFirstWorkspaceProjectName(workspaceId, projectName, nil)
+			projectName, err = apiclient_util.GetFirstWorkspaceProjectName(workspaceId, projectName, nil)
 			if err != nil {
 				return err
 			}
@@ -93,17 +101,36 @@ func init() {
 	PortForwardCmd.Flags().BoolVar(&publicPreview, "public", false, "Should be port be available publicly via an URL")
 }
 
+// RequirePortExposure returns an error if the provider of the workspace target can not expose ports publicly
+func RequirePortExposure(workspace *apiclient.WorkspaceDTO) error {
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return err
+	}
+
+	capabilities, err := apiclient_util.GetTargetProviderCapabilities(context.Background(), apiClient, workspace.Target)
+	if err != nil {
+		return err
+	}
+
+	if !capabilities.PortExposure {
+		return fmt.Errorf("the provider of target '%s' does not support exposing ports publicly", workspace.Target)
+	}
+
+	return nil
+}
+
 func ForwardPublicPort(workspaceId, projectName string, hostPort, targetPort uint16) error {
 	views.RenderInfoMessage("Forwarding port to a public URL...")
 
-	apiClient, err := apiclient.GetApiClient(nil)
+	apiClient, err := apiclient_util.GetApiClient(nil)
 	if err != nil {
 		return err
 	}
 
 	serverConfig, res, err := apiClient.ServerAPI.GetConfig(context.Background()).Execute()
 	if err != nil {
-		return apiclient.HandleErrorResponse(res, err)
+		return apiclient_util.HandleErrorResponse(res, err)
 	}
 
 	h := fnv.New64()
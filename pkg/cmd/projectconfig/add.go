@@ -30,6 +30,10 @@ var projectConfigAddCmd = &cobra.Command{
 		var projectConfigName *string
 		ctx := context.Background()
 
+		if cmd.Flags().Changed("idle-timeout") {
+			idleTimeoutFlag = &idleTimeoutValue
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
@@ -147,6 +151,10 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		GitProviderConfigId: createDtos[0].GitProviderConfigId,
 	}
 
+	if idleTimeoutFlag != nil {
+		createProjectConfig.SetIdleTimeout(int32(*idleTimeoutFlag))
+	}
+
 	res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(createProjectConfig).Execute()
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
@@ -160,6 +168,7 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		Prebuilds:           nil,
 		RepositoryUrl:       createProjectConfig.RepositoryUrl,
 		GitProviderConfigId: createProjectConfig.GitProviderConfigId,
+		IdleTimeout:         createProjectConfig.IdleTimeout,
 	}
 
 	if createProjectConfig.Image != nil {
@@ -244,6 +253,10 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		GitProviderConfigId: project.GitProviderConfigId,
 	}
 
+	if idleTimeoutFlag != nil {
+		newProjectConfig.SetIdleTimeout(int32(*idleTimeoutFlag))
+	}
+
 	if newProjectConfig.Image == nil {
 		newProjectConfig.Image = &apiServerConfig.DefaultProjectImage
 	}
@@ -276,6 +289,8 @@ func GetExistingProjectConfigNames(apiClient *apiclient.APIClient) ([]string, er
 }
 
 var nameFlag string
+var idleTimeoutValue int
+var idleTimeoutFlag *int
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -290,5 +305,6 @@ var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 
 func init() {
 	projectConfigAddCmd.Flags().StringVar(&nameFlag, "name", "", "Specify the project config name")
+	projectConfigAddCmd.Flags().IntVar(&idleTimeoutValue, "idle-timeout", 0, "Minutes of inactivity after which projects created from the config are stopped. Set to 0 to disable")
 	workspace_util.AddProjectConfigurationFlags(projectConfigAddCmd, projectConfigurationFlags, false)
 }
@@ -165,6 +165,7 @@ func importProjectConfig(ctx context.Context, apiClient *apiclient.APIClient, co
 		RepositoryUrl:       config.RepositoryUrl,
 		EnvVars:             config.EnvVars,
 		GitProviderConfigId: config.GitProviderConfigId,
+		IdleTimeout:         config.IdleTimeout,
 	}
 
 	if newProjectConfig.Image == nil {
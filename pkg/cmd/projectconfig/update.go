@@ -118,6 +118,11 @@ var projectConfigUpdateCmd = &cobra.Command{
 			RepositoryUrl:       createDto[0].Source.Repository.Url,
 			EnvVars:             createDto[0].EnvVars,
 			GitProviderConfigId: createDto[0].GitProviderConfigId,
+			IdleTimeout:         projectConfig.IdleTimeout,
+		}
+
+		if cmd.Flags().Changed("idle-timeout") {
+			newProjectConfig.SetIdleTimeout(int32(idleTimeoutValue))
 		}
 
 		res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(newProjectConfig).Execute()
@@ -129,3 +134,7 @@ var projectConfigUpdateCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func init() {
+	projectConfigUpdateCmd.Flags().IntVar(&idleTimeoutValue, "idle-timeout", 0, "Minutes of inactivity after which projects created from the config are stopped. Set to 0 to disable")
+}
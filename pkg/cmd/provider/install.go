@@ -25,11 +25,13 @@ import (
 )
 
 var yesFlag bool
+var allowUnsignedFlag bool
 
 var providerInstallCmd = &cobra.Command{
-	Use:     "install",
+	Use:     "install [PROVIDER[@VERSION]]",
 	Short:   "Install provider",
-	Args:    cobra.NoArgs,
+	Long:    "Install a provider. Providers installed with a specific version are pinned to it until they are installed again without a version",
+	Args:    cobra.MaximumNArgs(1),
 	Aliases: []string{"i"},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiClient, err := apiclient_util.GetApiClient(nil)
@@ -53,17 +55,14 @@ var providerInstallCmd = &cobra.Command{
 			return errors.New("could not get providers manifest")
 		}
 
-		providersManifestLatest := providersManifest.GetLatestVersions()
-		if providersManifestLatest == nil {
-			return errors.New("could not get providers manifest")
-		}
-
-		providerList := GetProviderListFromManifest(providersManifestLatest)
-		specificProviderName := "Select a specific version"
-		specificProviderVersion := ""
-		providerList = append(providerList, apiclient.Provider{Name: specificProviderName, Label: &specificProviderName, Version: specificProviderVersion})
+		var providerToInstall *provider_view.ProviderView
+		var pin bool
 
-		providerToInstall, err := provider.GetProviderFromPrompt(provider.ProviderListToView(providerList), "Choose a Provider to Install", false)
+		if len(args) > 0 {
+			providerToInstall, pin, err = getProviderFromArg(args[0], providersManifest)
+		} else {
+			providerToInstall, pin, err = selectProviderToInstall(providersManifest)
+		}
 		if err != nil {
 			if common.IsCtrlCAbort(err) {
 				return nil
@@ -76,24 +75,7 @@ var providerInstallCmd = &cobra.Command{
 			return nil
 		}
 
-		if providerToInstall.Name == specificProviderName {
-			providerList = GetProviderListFromManifest(providersManifest)
-
-			providerToInstall, err = provider.GetProviderFromPrompt(provider.ProviderListToView(providerList), "Choose a specific provider to install", false)
-			if err != nil {
-				if common.IsCtrlCAbort(err) {
-					return nil
-				} else {
-					return err
-				}
-			}
-
-			if providerToInstall == nil {
-				return nil
-			}
-		}
-
-		err = InstallProvider(apiClient, *providerToInstall, providersManifest)
+		err = InstallProvider(apiClient, *providerToInstall, providersManifest, allowUnsignedFlag, pin)
 		if err != nil {
 			return err
 		}
@@ -175,6 +157,7 @@ var providerInstallCmd = &cobra.Command{
 
 func init() {
 	providerInstallCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	providerInstallCmd.Flags().BoolVar(&allowUnsignedFlag, "allow-unsigned", false, "Install the provider even if the manifest has no checksum or signature for it")
 }
 
 func GetProviderListFromManifest(manifest *manager.ProvidersManifest) []apiclient.Provider {
@@ -205,12 +188,74 @@ func ConvertOSToStringMap(downloadUrls map[os.OperatingSystem]string) map[string
 	return stringMap
 }
 
-func InstallProvider(apiClient *apiclient.APIClient, providerToInstall provider_view.ProviderView, providersManifest *manager.ProvidersManifest) error {
-	downloadUrls := ConvertOSToStringMap((*providersManifest)[providerToInstall.Name].Versions[providerToInstall.Version].DownloadUrls)
+// selectProviderToInstall prompts for the provider to install. Versions selected explicitly are pinned
+func selectProviderToInstall(providersManifest *manager.ProvidersManifest) (*provider_view.ProviderView, bool, error) {
+	providersManifestLatest := providersManifest.GetLatestVersions()
+	if providersManifestLatest == nil {
+		return nil, false, errors.New("could not get providers manifest")
+	}
+
+	providerList := GetProviderListFromManifest(providersManifestLatest)
+	specificProviderName := "Select a specific version"
+	specificProviderVersion := ""
+	providerList = append(providerList, apiclient.Provider{Name: specificProviderName, Label: &specificProviderName, Version: specificProviderVersion})
+
+	providerToInstall, err := provider.GetProviderFromPrompt(provider.ProviderListToView(providerList), "Choose a Provider to Install", false)
+	if err != nil || providerToInstall == nil {
+		return nil, false, err
+	}
+
+	if providerToInstall.Name != specificProviderName {
+		return providerToInstall, false, nil
+	}
+
+	providerList = GetProviderListFromManifest(providersManifest)
+
+	providerToInstall, err = provider.GetProviderFromPrompt(provider.ProviderListToView(providerList), "Choose a specific provider to install", false)
+	if err != nil || providerToInstall == nil {
+		return nil, false, err
+	}
+
+	return providerToInstall, providerToInstall.Version != "latest", nil
+}
+
+// getProviderFromArg returns the provider version to install from a PROVIDER[@VERSION] argument
+// and whether the version should be pinned
+func getProviderFromArg(arg string, providersManifest *manager.ProvidersManifest) (*provider_view.ProviderView, bool, error) {
+	providerName, version, versionSet := strings.Cut(arg, "@")
+
+	providerManifest, ok := (*providersManifest)[providerName]
+	if !ok {
+		return nil, false, fmt.Errorf("provider %s not found in manifest", providerName)
+	}
+
+	if !versionSet || version == "latest" {
+		version, _ = providerManifest.FindLatestVersion()
+		if _, ok := providerManifest.Versions["latest"]; ok {
+			version = "latest"
+		}
+
+		return &provider_view.ProviderView{Name: providerName, Label: providerManifest.Label, Version: version}, false, nil
+	}
+
+	if _, ok := providerManifest.Versions[version]; !ok {
+		return nil, false, fmt.Errorf("version %s of provider %s not found in manifest", version, providerName)
+	}
+
+	return &provider_view.ProviderView{Name: providerName, Label: providerManifest.Label, Version: version}, true, nil
+}
+
+func InstallProvider(apiClient *apiclient.APIClient, providerToInstall provider_view.ProviderView, providersManifest *manager.ProvidersManifest, allowUnsigned, pin bool) error {
+	version := (*providersManifest)[providerToInstall.Name].Versions[providerToInstall.Version]
 	err := views_util.WithInlineSpinner("Installing", func() error {
 		res, err := apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
-			Name:         providerToInstall.Name,
-			DownloadUrls: downloadUrls,
+			Name:          providerToInstall.Name,
+			Version:       &providerToInstall.Version,
+			Pin:           &pin,
+			DownloadUrls:  ConvertOSToStringMap(version.DownloadUrls),
+			Checksums:     ConvertOSToStringMap(version.Checksums),
+			Signatures:    ConvertOSToStringMap(version.Signatures),
+			AllowUnsigned: &allowUnsigned,
 		}))
 
 		if err != nil {
@@ -19,4 +19,5 @@ func init() {
 	ProviderCmd.AddCommand(providerUninstallCmd)
 	ProviderCmd.AddCommand(providerInstallCmd)
 	ProviderCmd.AddCommand(providerUpdateCmd)
+	ProviderCmd.AddCommand(providerRollbackCmd)
 }
@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/provider"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var providerRollbackCmd = &cobra.Command{
+	Use:   "rollback [PROVIDER]",
+	Short: "Rollback provider",
+	Long:  "Restore the previously installed version of a provider. The restored version is pinned until the provider is installed again",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		var providerName string
+
+		if len(args) > 0 {
+			providerName = args[0]
+		} else {
+			providerList, res, err := apiClient.ProviderAPI.ListProviders(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(providerList) == 0 {
+				views_util.NotifyEmptyProviderList(false)
+				return nil
+			}
+
+			providerToRollback, err := provider.GetProviderFromPrompt(provider.ProviderListToView(providerList), "Choose a Provider to Rollback", false)
+			if err != nil {
+				if common.IsCtrlCAbort(err) {
+					return nil
+				} else {
+					return err
+				}
+			}
+
+			if providerToRollback == nil {
+				return nil
+			}
+
+			providerName = providerToRollback.Name
+		}
+
+		res, err := apiClient.ProviderAPI.RollbackProvider(ctx, providerName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Provider %s has been rolled back to the previous version", providerName))
+		return nil
+	},
+}
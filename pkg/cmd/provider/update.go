@@ -54,8 +54,15 @@ var providerUpdateCmd = &cobra.Command{
 			return err
 		}
 
+		pinnedVersions := serverConfig.GetPinnedProviderVersions()
+
 		if allFlag {
 			for _, provider := range providerList {
+				if pinnedVersion, ok := pinnedVersions[provider.Name]; ok {
+					fmt.Printf("Skipping provider %s pinned to %s\n", provider.Name, pinnedVersion)
+					continue
+				}
+
 				fmt.Printf("Updating provider %s\n", provider.Name)
 				err := updateProvider(provider.Name, providersManifest, apiClient)
 				if err != nil {
@@ -80,6 +87,10 @@ var providerUpdateCmd = &cobra.Command{
 			return nil
 		}
 
+		if pinnedVersion, ok := pinnedVersions[providerToUpdate.Name]; ok {
+			return fmt.Errorf("provider %s is pinned to %s. Use `daytona provider install %s` to install the latest version", providerToUpdate.Name, pinnedVersion, providerToUpdate.Name)
+		}
+
 		err = updateProvider(providerToUpdate.Name, providersManifest, apiClient)
 		if err != nil {
 			return err
@@ -102,11 +113,11 @@ func updateProvider(providerName string, providersManifest *manager.ProvidersMan
 		version = *latest
 	}
 
-	downloadUrls := ConvertOSToStringMap(version.DownloadUrls)
-
 	res, err := apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
 		Name:         providerName,
-		DownloadUrls: downloadUrls,
+		DownloadUrls: ConvertOSToStringMap(version.DownloadUrls),
+		Checksums:    ConvertOSToStringMap(version.Checksums),
+		Signatures:   ConvertOSToStringMap(version.Signatures),
 	}))
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
@@ -111,7 +111,7 @@ var purgeCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		buildRunner, err := server_cmd.GetBuildRunner(serverConfig, buildRunnerConfig, telemetryService)
+		buildRunner, err := server_cmd.GetBuildRunner(serverConfig, buildRunnerConfig, telemetryService, nil)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/api"
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var rotateKeyYesFlag bool
+
+var rotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Rotate the master key used to encrypt secrets at rest",
+	Long:  "Generates a new master key and re-encrypts the data keys of all target options, git provider tokens, signing keys, container registry passwords and webhook secrets. The server needs to be stopped while the key is rotated.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		apiServer := api.NewApiServer(api.ApiServerConfig{
+			ApiPort: int(c.ApiPort),
+		})
+		if apiServer.HealthCheck() == nil {
+			views.RenderInfoMessage("The Daytona Server is still running. Please stop it before rotating the master key.")
+			return nil
+		}
+
+		if !rotateKeyYesFlag {
+			confirmCheck := false
+			view.ConfirmKeyRotationPrompt(&confirmCheck)
+			if !confirmCheck {
+				views.RenderInfoMessage("Operation cancelled.")
+				return nil
+			}
+		}
+
+		masterKeyFilePath, err := server.GetMasterKeyFilePath(c)
+		if err != nil {
+			return err
+		}
+
+		currentEncryptor, err := getEncryptor(c)
+		if err != nil {
+			return err
+		}
+
+		newMasterKey, err := encryption.GenerateKey()
+		if err != nil {
+			return err
+		}
+
+		newEncryptor, err := encryption.NewEncryptor(newMasterKey)
+		if err != nil {
+			return err
+		}
+
+		dbPath, err := getDbPath()
+		if err != nil {
+			return err
+		}
+
+		dbConnection := db.GetSQLiteConnection(dbPath)
+
+		// Creating the stores migrates their tables and encrypts secrets that are still stored in plaintext
+		_, err = db.NewProviderTargetStore(dbConnection, currentEncryptor)
+		if err != nil {
+			return err
+		}
+		_, err = db.NewGitProviderConfigStore(dbConnection, currentEncryptor)
+		if err != nil {
+			return err
+		}
+		_, err = db.NewContainerRegistryStore(dbConnection, currentEncryptor)
+		if err != nil {
+			return err
+		}
+		_, err = db.NewWebhookStore(dbConnection, currentEncryptor)
+		if err != nil {
+			return err
+		}
+
+		if encryption.IsMasterKeyFromEnv() {
+			err = db.RotateMasterKey(dbConnection, currentEncryptor, newEncryptor)
+			if err != nil {
+				return err
+			}
+
+			views.RenderInfoMessageBold(fmt.Sprintf("Master key rotated. Set %s to the new master key before starting the server:\n\n%s", encryption.MASTER_KEY_ENV_VAR, encryption.EncodeKey(newMasterKey)))
+			return nil
+		}
+
+		// The new key is kept next to the current one until the secrets are re-encrypted so it can not get lost
+		pendingKeyFilePath := masterKeyFilePath + ".new"
+		err = encryption.SaveMasterKey(pendingKeyFilePath, newMasterKey)
+		if err != nil {
+			return err
+		}
+
+		err = db.RotateMasterKey(dbConnection, currentEncryptor, newEncryptor)
+		if err != nil {
+			os.Remove(pendingKeyFilePath)
+			return err
+		}
+
+		err = os.Rename(pendingKeyFilePath, masterKeyFilePath)
+		if err != nil {
+			return fmt.Errorf("secrets were re-encrypted but the new master key could not be moved from %s to %s: %w", pendingKeyFilePath, masterKeyFilePath, err)
+		}
+
+		views.RenderInfoMessageBold("Master key rotated")
+		return nil
+	},
+}
+
+func init() {
+	rotateKeyCmd.Flags().BoolVarP(&rotateKeyYesFlag, "yes", "y", false, "Skip the confirmation prompt")
+}
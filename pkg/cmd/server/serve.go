@@ -22,8 +22,12 @@ import (
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server"
@@ -32,15 +36,18 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/headscale"
+	"github.com/daytonaio/daytona/pkg/server/operations"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/views"
 	started_view "github.com/daytonaio/daytona/pkg/views/server/started"
 
+	"github.com/docker/docker/client"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -102,7 +109,7 @@ var ServeCmd = &cobra.Command{
 			return err
 		}
 
-		buildRunner, err := GetBuildRunner(c, buildRunnerConfig, telemetryService)
+		buildRunner, err := GetBuildRunner(c, buildRunnerConfig, telemetryService, server.EventBus)
 		if err != nil {
 			return err
 		}
@@ -209,11 +216,24 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 
 	dbConnection := db.GetSQLiteConnection(dbPath)
 
+	encryptor, err := getEncryptor(c)
+	if err != nil {
+		return nil, err
+	}
+
 	apiKeyStore, err := db.NewApiKeyStore(dbConnection)
 	if err != nil {
 		return nil, err
 	}
-	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection)
+	webhookStore, err := db.NewWebhookStore(dbConnection, encryptor)
+	if err != nil {
+		return nil, err
+	}
+	snapshotStore, err := db.NewSnapshotStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection, encryptor)
 	if err != nil {
 		return nil, err
 	}
@@ -225,11 +245,11 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	if err != nil {
 		return nil, err
 	}
-	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection)
+	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection, encryptor)
 	if err != nil {
 		return nil, err
 	}
-	providerTargetStore, err := db.NewProviderTargetStore(dbConnection)
+	providerTargetStore, err := db.NewProviderTargetStore(dbConnection, encryptor)
 	if err != nil {
 		return nil, err
 	}
@@ -259,9 +279,12 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		Store: containerRegistryStore,
 	})
 
+	eventBus := events.NewEventBus()
+
 	buildService := builds.NewBuildService(builds.BuildServiceConfig{
 		BuildStore:    buildStore,
 		LoggerFactory: loggerFactory,
+		EventBus:      eventBus,
 	})
 
 	gitProviderService := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
@@ -312,8 +335,20 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		c.BuilderRegistryServer = util.GetFrpcRegistryDomain(c.Id, c.Frps.Domain)
 	}
 
+	// The provider manager depends on the target service so it is assigned after the service is created
+	var providerManager *manager.ProviderManager
+
 	providerTargetService := providertargets.NewProviderTargetService(providertargets.ProviderTargetServiceConfig{
 		TargetStore: providerTargetStore,
+		EventBus:    eventBus,
+		GetTargetManifest: func(providerName string) (*provider.ProviderTargetManifest, error) {
+			p, err := providerManager.GetProvider(providerName)
+			if err != nil {
+				return nil, err
+			}
+
+			return (*p).GetTargetManifest()
+		},
 	})
 
 	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
@@ -322,7 +357,7 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 
 	headscaleUrl := util.GetFrpcHeadscaleUrl(c.Frps.Protocol, c.Id, c.Frps.Domain)
 
-	providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{
+	providerManager = manager.NewProviderManager(manager.ProviderManagerConfig{
 		LogsDir:               wsLogsDir,
 		ProviderTargetService: providerTargetService,
 		ApiUrl:                util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain),
@@ -330,7 +365,10 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		ServerUrl:             headscaleUrl,
 		ServerVersion:         version,
 		RegistryUrl:           c.RegistryUrl,
+		ProviderPublicKey:     c.ProviderPublicKey,
+		Scheduler:             build.NewCronScheduler(),
 		BaseDir:               c.ProvidersDir,
+		EventBus:              eventBus,
 		CreateProviderNetworkKey: func(providerName string) (string, error) {
 			return headscaleServer.CreateAuthKey()
 		},
@@ -338,8 +376,30 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		ApiPort:    c.ApiPort,
 	})
 
-	provisioner := provisioner.NewProvisioner(provisioner.ProvisionerConfig{
-		ProviderManager: providerManager,
+	provisioner := provisioner.NewQueuedProvisioner(provisioner.QueuedProvisionerConfig{
+		Provisioner: provisioner.NewProvisioner(provisioner.ProvisionerConfig{
+			ProviderManager: providerManager,
+		}),
+	})
+
+	// Project snapshots are pushed to the same registry as builds
+	var snapshotImageCr *containerregistry.ContainerRegistry
+	if c.BuilderRegistryServer != "" {
+		snapshotImageCr, err = containerRegistryService.Find(c.BuilderRegistryServer)
+		if err != nil {
+			snapshotImageCr = &containerregistry.ContainerRegistry{
+				Server: c.BuilderRegistryServer,
+			}
+		}
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
 	})
 
 	workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
@@ -359,6 +419,20 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		Provisioner:              provisioner,
 		LoggerFactory:            loggerFactory,
 		TelemetryService:         telemetryService,
+		Scheduler:                build.NewCronScheduler(),
+		ProjectIdleTimeout:       c.ProjectIdleTimeout,
+		EventBus:                 eventBus,
+		SnapshotStore:            snapshotStore,
+		DockerClient:             dockerClient,
+		SnapshotImageRegistry:    snapshotImageCr,
+		SnapshotImageNamespace:   getBuildImageNamespace(c),
+	})
+
+	operationService := operations.NewOperationService(operations.OperationServiceConfig{})
+
+	webhookService := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore: webhookStore,
+		EventBus:     eventBus,
 	})
 
 	profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
@@ -376,16 +450,20 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		LocalContainerRegistry:   localContainerRegistry,
 		ApiKeyService:            apiKeyService,
 		WorkspaceService:         workspaceService,
+		OperationService:         operationService,
+		EventBus:                 eventBus,
+		WebhookService:           webhookService,
 		GitProviderService:       gitProviderService,
 		ProviderManager:          providerManager,
 		ProfileDataService:       profileDataService,
 		TelemetryService:         telemetryService,
+		ProvisioningQueue:        provisioner,
 	})
 
 	return s, s.Initialize()
 }
 
-func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetryService telemetry.TelemetryService) (*build.BuildRunner, error) {
+func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetryService telemetry.TelemetryService, eventBus *events.EventBus) (*build.BuildRunner, error) {
 	logsDir, err := build.GetBuildLogsDir()
 	if err != nil {
 		return nil, err
@@ -399,7 +477,12 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 
 	dbConnection := db.GetSQLiteConnection(dbPath)
 
-	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection)
+	encryptor, err := getEncryptor(c)
+	if err != nil {
+		return nil, err
+	}
+
+	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection, encryptor)
 	if err != nil {
 		return nil, err
 	}
@@ -413,13 +496,9 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 		return nil, err
 	}
 
-	buildImageNamespace := c.BuildImageNamespace
-	if buildImageNamespace != "" {
-		buildImageNamespace = fmt.Sprintf("/%s", buildImageNamespace)
-	}
-	buildImageNamespace = strings.TrimSuffix(buildImageNamespace, "/")
+	buildImageNamespace := getBuildImageNamespace(c)
 
-	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection)
+	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection, encryptor)
 	if err != nil {
 		return nil, err
 	}
@@ -472,6 +551,9 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 		LoggerFactory:     loggerFactory,
 		BasePath:          filepath.Join(configDir, "builds"),
 		TelemetryService:  telemetryService,
+		EventBus:          eventBus,
+		WorkerPoolSize:    buildRunnerConfig.WorkerPoolSize,
+		MaxBuildAttempts:  buildRunnerConfig.MaxBuildAttempts,
 	}), nil
 }
 
@@ -513,6 +595,20 @@ func getDbPath() (string, error) {
 	return filepath.Join(configDir, "db"), nil
 }
 
+func getEncryptor(c *server.Config) (*encryption.Encryptor, error) {
+	masterKeyFilePath, err := server.GetMasterKeyFilePath(c)
+	if err != nil {
+		return nil, err
+	}
+
+	masterKey, err := encryption.LoadMasterKey(masterKeyFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load master key: %w", err)
+	}
+
+	return encryption.NewEncryptor(masterKey)
+}
+
 func ensureDefaultProfile(server *server.Server, apiPort uint32) error {
 	existingConfig, err := config.GetConfig()
 	if err != nil {
@@ -543,3 +639,12 @@ func ensureDefaultProfile(server *server.Server, apiPort uint32) error {
 		},
 	})
 }
+
+func getBuildImageNamespace(c *server.Config) string {
+	buildImageNamespace := c.BuildImageNamespace
+	if buildImageNamespace != "" {
+		buildImageNamespace = fmt.Sprintf("/%s", buildImageNamespace)
+	}
+
+	return strings.TrimSuffix(buildImageNamespace, "/")
+}
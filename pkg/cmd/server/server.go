@@ -84,5 +84,6 @@ func init() {
 	ServerCmd.AddCommand(startCmd)
 	ServerCmd.AddCommand(stopCmd)
 	ServerCmd.AddCommand(restartCmd)
+	ServerCmd.AddCommand(rotateKeyCmd)
 	ServerCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Skip the confirmation prompt")
 }
@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	target_view "github.com/daytonaio/daytona/pkg/views/target"
+	"github.com/spf13/cobra"
+)
+
+var varFlags []string
+var nameFlag string
+
+var targetInstantiateCmd = &cobra.Command{
+	Use:   "instantiate [TEMPLATE_NAME]",
+	Short: "Create a target from a target template",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		variables, err := parseVarFlags(varFlags)
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		var templateName string
+
+		if len(args) == 0 {
+			templates := []apiclient.ProviderTarget{}
+			for _, t := range targets {
+				if t.GetIsTemplate() {
+					templates = append(templates, t)
+				}
+			}
+
+			if len(templates) == 0 {
+				views.RenderInfoMessage("No target templates found. Set one with `daytona target set --template`")
+				return nil
+			}
+
+			c, err := config.GetConfig()
+			if err != nil {
+				return err
+			}
+
+			activeProfile, err := c.GetActiveProfile()
+			if err != nil {
+				return err
+			}
+
+			selectedTemplate, err := target_view.GetTargetFromPrompt(templates, activeProfile.Name, nil, false, "Instantiate")
+			if err != nil {
+				if common.IsCtrlCAbort(err) {
+					return nil
+				} else {
+					return err
+				}
+			}
+
+			templateName = selectedTemplate.Name
+		} else {
+			templateName = args[0]
+		}
+
+		targetName := nameFlag
+		if targetName == "" {
+			err = target_view.NewTargetNameInput(&targetName, util.ArrayMap(targets, func(t apiclient.ProviderTarget) string {
+				return t.Name
+			}))
+			if err != nil {
+				return err
+			}
+		}
+
+		target, res, err := apiClient.TargetAPI.InstantiateTargetTemplate(ctx, templateName).Instantiate(apiclient.InstantiateTargetTemplateDTO{
+			Name:      targetName,
+			Variables: variables,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Target %s created from template %s", target.Name, templateName))
+		return nil
+	},
+}
+
+// parseVarFlags parses the variables set in the KEY=VALUE format
+func parseVarFlags(flags []string) (map[string]string, error) {
+	variables := map[string]string{}
+	for _, flag := range flags {
+		name, value, ok := strings.Cut(flag, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid variable %s. Use the NAME=VALUE format", flag)
+		}
+		variables[name] = value
+	}
+
+	return variables, nil
+}
+
+func init() {
+	targetInstantiateCmd.Flags().StringArrayVar(&varFlags, "var", []string{}, "Template variable in the NAME=VALUE format. Can be used multiple times")
+	targetInstantiateCmd.Flags().StringVar(&nameFlag, "name", "", "Name of the new target")
+}
@@ -24,6 +24,10 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+var idleTimeoutFlag int
+var templateFlag bool
+var maxConcurrentOperationsFlag int
+
 var TargetSetCmd = &cobra.Command{
 	Use:     "set",
 	Short:   "Set provider target",
@@ -94,13 +98,25 @@ var TargetSetCmd = &cobra.Command{
 			if providersManifest == nil {
 				return errors.New("could not get providers manifest")
 			}
-			err = provider.InstallProvider(apiClient, *selectedProvider, providersManifest)
+			err = provider.InstallProvider(apiClient, *selectedProvider, providersManifest, false, false)
 			if err != nil {
 				return err
 			}
 			isNewProvider = true
 		}
 
+		// Idle projects are stopped so the provider must support it
+		if cmd.Flags().Changed("idle-timeout") && idleTimeoutFlag > 0 {
+			capabilities, err := apiclient_util.GetProviderCapabilities(ctx, apiClient, selectedProvider.Name)
+			if err != nil {
+				return err
+			}
+
+			if !capabilities.StopStart {
+				return fmt.Errorf("provider '%s' does not support stopping projects so an idle timeout can not be set", selectedProvider.Name)
+			}
+		}
+
 		targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -141,6 +157,10 @@ var TargetSetCmd = &cobra.Command{
 			}
 		}
 
+		if templateFlag {
+			selectedTarget.IsTemplate = true
+		}
+
 		targetManifest, res, err := apiClient.ProviderAPI.GetTargetManifest(context.Background(), selectedProvider.Name).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -158,6 +178,23 @@ var TargetSetCmd = &cobra.Command{
 				Name:    selectedProvider.Name,
 				Version: selectedProvider.Version,
 			},
+			IsTemplate: &selectedTarget.IsTemplate,
+		}
+
+		for _, t := range filteredTargets {
+			if t.Name == selectedTarget.Name {
+				targetData.IdleTimeout = t.IdleTimeout
+				targetData.MaxConcurrentOperations = t.MaxConcurrentOperations
+				break
+			}
+		}
+
+		if cmd.Flags().Changed("idle-timeout") {
+			targetData.SetIdleTimeout(int32(idleTimeoutFlag))
+		}
+
+		if cmd.Flags().Changed("max-concurrent-operations") {
+			targetData.SetMaxConcurrentOperations(int32(maxConcurrentOperationsFlag))
 		}
 
 		res, err = apiClient.TargetAPI.SetTarget(context.Background()).Target(targetData).Execute()
@@ -165,7 +202,18 @@ var TargetSetCmd = &cobra.Command{
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
 
+		if selectedTarget.IsTemplate {
+			views.RenderInfoMessage(fmt.Sprintf("Target template set successfully. Create targets from it with `daytona target instantiate %s`", selectedTarget.Name))
+			return nil
+		}
+
 		views.RenderInfoMessage("Target set successfully and will be used by default")
 		return nil
 	},
 }
+
+func init() {
+	TargetSetCmd.Flags().BoolVar(&templateFlag, "template", false, "Set a target template whose option values can reference variables, e.g. ${region}")
+	TargetSetCmd.Flags().IntVar(&idleTimeoutFlag, "idle-timeout", 0, "Minutes of inactivity after which projects on the target are stopped. Set to 0 to disable")
+	TargetSetCmd.Flags().IntVar(&maxConcurrentOperationsFlag, "max-concurrent-operations", 0, "Maximum number of workspace and project operations that run on the target at the same time. Operations over the limit are queued. Set to 0 to disable")
+}
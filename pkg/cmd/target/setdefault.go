@@ -9,6 +9,7 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/views"
 	target_view "github.com/daytonaio/daytona/pkg/views/target"
@@ -30,11 +31,19 @@ var targetSetDefaultCmd = &cobra.Command{
 		}
 
 		if len(args) == 0 {
-			targetList, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+			targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
 			if err != nil {
 				return apiclient_util.HandleErrorResponse(res, err)
 			}
 
+			// Templates can not be used by default
+			targetList := []apiclient.ProviderTarget{}
+			for _, t := range targets {
+				if !t.GetIsTemplate() {
+					targetList = append(targetList, t)
+				}
+			}
+
 			if len(targetList) == 0 {
 				views_util.NotifyEmptyTargetList(true)
 				return nil
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	status_view "github.com/daytonaio/daytona/pkg/views/target/status"
+	"github.com/spf13/cobra"
+)
+
+var targetStatusCmd = &cobra.Command{
+	Use:   "status [TARGET_NAME]",
+	Short: "Show running and queued operations of targets",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		statusList, res, err := apiClient.TargetAPI.GetTargetsStatus(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if len(args) > 0 {
+			filteredList := []apiclient.TargetQueueStatus{}
+			for _, status := range statusList {
+				if status.Target == args[0] {
+					filteredList = append(filteredList, status)
+				}
+			}
+
+			if len(filteredList) == 0 {
+				return fmt.Errorf("target '%s' not found", args[0])
+			}
+			statusList = filteredList
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(statusList)
+			formattedData.Print()
+			return nil
+		}
+
+		status_view.Render(statusList)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(targetStatusCmd)
+}
@@ -16,7 +16,9 @@ var TargetCmd = &cobra.Command{
 
 func init() {
 	TargetCmd.AddCommand(targetListCmd)
+	TargetCmd.AddCommand(targetInstantiateCmd)
 	TargetCmd.AddCommand(TargetSetCmd)
 	TargetCmd.AddCommand(targetRemoveCmd)
 	TargetCmd.AddCommand(targetSetDefaultCmd)
+	TargetCmd.AddCommand(targetStatusCmd)
 }
@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	webhook_view "github.com/daytonaio/daytona/pkg/views/webhook"
+	"github.com/docker/docker/pkg/stringid"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var secretFlag string
+var eventFlags []string
+
+var addCmd = &cobra.Command{
+	Use:   "add [URL]",
+	Short: "Add a webhook",
+	Long:  "Add a webhook that receives server events as signed HTTP POST requests. Deliveries are signed with an HMAC-SHA256 of the request body in the X-Daytona-Signature header",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		secret := secretFlag
+		if secret == "" {
+			secret = stringid.GenerateRandomID()
+		}
+
+		eventTypes := []apiclient.EventType{}
+		for _, eventFlag := range eventFlags {
+			eventType, err := apiclient.NewEventTypeFromValue(eventFlag)
+			if err != nil {
+				return err
+			}
+			eventTypes = append(eventTypes, *eventType)
+		}
+
+		w, res, err := apiClient.WebhookAPI.CreateWebhook(ctx).Webhook(apiclient.CreateWebhookDTO{
+			Url:    args[0],
+			Secret: secret,
+			Events: eventTypes,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Webhook %s added", w.Id))
+
+		if secretFlag == "" {
+			webhook_view.RenderSecret(secret)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addCmd.Flags().StringVar(&secretFlag, "secret", "", "Secret used to sign deliveries, generated if not provided")
+	addCmd.Flags().StringArrayVar(&eventFlags, "event", []string{}, "Event type to deliver, can be repeated. All events are delivered if not provided")
+
+	err := addCmd.RegisterFlagCompletionFunc("event", getEventTypeCompletions)
+	if err != nil {
+		log.Error("failed to register completion function: ", err)
+	}
+}
+
+func getEventTypeCompletions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	eventTypes := []string{}
+	for _, eventType := range apiclient.AllowedEventTypeEnumValues {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return eventTypes, cobra.ShellCompDirectiveNoFileComp
+}
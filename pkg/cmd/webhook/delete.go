@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:               "delete [WEBHOOK_ID]",
+	Short:             "Delete a webhook",
+	Aliases:           []string{"remove", "rm"},
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: getWebhookIdCompletions,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.WebhookAPI.DeleteWebhook(ctx, args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage("Webhook deleted successfully")
+		return nil
+	},
+}
+
+func getWebhookIdCompletions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	webhookList, _, err := apiClient.WebhookAPI.ListWebhooks(context.Background()).Execute()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var choices []string
+	for _, w := range webhookList {
+		choices = append(choices, w.Id)
+	}
+
+	return choices, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	webhook_view "github.com/daytonaio/daytona/pkg/views/webhook"
+	"github.com/spf13/cobra"
+)
+
+var deliveriesCmd = &cobra.Command{
+	Use:               "deliveries [WEBHOOK_ID]",
+	Short:             "List the delivery history of a webhook",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: getWebhookIdCompletions,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		deliveryList, res, err := apiClient.WebhookAPI.ListWebhookDeliveries(ctx, args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(deliveryList)
+			formattedData.Print()
+			return nil
+		}
+
+		webhook_view.ListDeliveries(deliveryList)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(deliveriesCmd)
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var WebhookCmd = &cobra.Command{
+	Use:     "webhook",
+	Short:   "Manage webhook notifications",
+	Args:    cobra.NoArgs,
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	WebhookCmd.AddCommand(addCmd)
+	WebhookCmd.AddCommand(listCmd)
+	WebhookCmd.AddCommand(deleteCmd)
+	WebhookCmd.AddCommand(deliveriesCmd)
+}
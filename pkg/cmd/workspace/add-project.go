@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var addProjectNameFlag string
+
+var workspaceAddProjectCmd = &cobra.Command{
+	Use:   "add-project [WORKSPACE] [REPOSITORY_URL | PROJECT_CONFIG_NAME]",
+	Short: "Add a project to an existing workspace",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		var projects []apiclient.CreateProjectDTO
+
+		from := time.Now()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		_, err = processCmdArguments(ctx, args[1:], apiClient, &projects)
+		if err != nil {
+			return err
+		}
+
+		projectDto := projects[0]
+
+		existingProjectNames := util.ArrayMap(workspace.Projects, func(p apiclient.Project) string {
+			return p.Name
+		})
+
+		if addProjectNameFlag != "" {
+			projectDto.Name = addProjectNameFlag
+		} else {
+			projectDto.Name = workspace_util.GetSuggestedName(projectDto.Name, existingProjectNames)
+		}
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if profileData != nil && profileData.EnvVars != nil {
+			projectDto.EnvVars = util.MergeEnvVars(profileData.EnvVars, projectDto.EnvVars)
+		} else {
+			projectDto.EnvVars = util.MergeEnvVars(projectDto.EnvVars)
+		}
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, []string{projectDto.Name}, true, false, &from)
+
+		project, res, err := apiClient.WorkspaceAPI.AddProject(ctx, workspace.Id).Project(projectDto).Execute()
+		stopLogs()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		// Make sure terminal cursor is reset
+		fmt.Print("\033[?25h")
+
+		views.RenderInfoMessage(fmt.Sprintf("Project '%s' successfully added to workspace '%s'", project.Name, workspace.Name))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return getWorkspaceNameCompletions()
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+func init() {
+	workspaceAddProjectCmd.Flags().StringVar(&addProjectNameFlag, "name", "", "Specify the project name")
+	workspaceAddProjectCmd.Flags().BoolVar(&blankFlag, "blank", false, "Create a blank project without using existing configurations")
+	workspaceAddProjectCmd.Flags().StringSliceVar(projectConfigurationFlags.Branches, "branch", []string{}, "Specify the Git branch to use in the project")
+
+	workspace_util.AddProjectConfigurationFlags(workspaceAddProjectCmd, projectConfigurationFlags, false)
+}
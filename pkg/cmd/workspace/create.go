@@ -5,6 +5,7 @@ package workspace
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -50,6 +51,12 @@ var CreateCmd = &cobra.Command{
 		var existingProjectConfigNames []string
 		promptUsingTUI := len(args) == 0
 
+		createWorkspaceDto := apiclient.CreateWorkspaceDTO{}
+		err := setWorkspaceExpiry(&createWorkspaceDto)
+		if err != nil {
+			return err
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
@@ -148,6 +155,17 @@ var CreateCmd = &cobra.Command{
 			return err
 		}
 
+		if len(projects) > 1 {
+			capabilities, err := apiclient_util.GetProviderCapabilities(ctx, apiClient, target.ProviderInfo.Name)
+			if err != nil {
+				return err
+			}
+
+			if !capabilities.MultiProject {
+				return fmt.Errorf("target '%s' does not support workspaces with multiple projects", target.Name)
+			}
+		}
+
 		logs_view.CalculateLongestPrefixLength(projectNames)
 
 		logs_view.DisplayLogEntry(logs.LogEntry{
@@ -173,15 +191,15 @@ var CreateCmd = &cobra.Command{
 		logsContext, stopLogs := context.WithCancel(context.Background())
 		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, true, true, nil)
 
-		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceDTO{
-			Id:       id,
-			Name:     workspaceName,
-			Target:   target.Name,
-			Projects: projects,
-		}).Execute()
+		createWorkspaceDto.Id = id
+		createWorkspaceDto.Name = workspaceName
+		createWorkspaceDto.Target = target.Name
+		createWorkspaceDto.Projects = projects
+
+		createdWorkspace, err := createWorkspace(ctx, apiClient, createWorkspaceDto)
 		if err != nil {
 			stopLogs()
-			return apiclient_util.HandleErrorResponse(res, err)
+			return err
 		}
 		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, projects[0].GitProviderConfigId)
 		if err != nil {
@@ -243,6 +261,8 @@ var targetNameFlag string
 var noIdeFlag bool
 var blankFlag bool
 var multiProjectFlag bool
+var ttlFlag time.Duration
+var expiresAtFlag string
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -271,6 +291,8 @@ func init() {
 	CreateCmd.Flags().BoolVar(&multiProjectFlag, "multi-project", false, "Workspace with multiple projects/repos")
 	CreateCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
 	CreateCmd.Flags().StringSliceVar(projectConfigurationFlags.Branches, "branch", []string{}, "Specify the Git branches to use in the projects")
+	CreateCmd.Flags().DurationVar(&ttlFlag, "ttl", 0, "Remove the workspace after the given duration (e.g. '8h')")
+	CreateCmd.Flags().StringVar(&expiresAtFlag, "expires-at", "", "Remove the workspace at the given time (RFC3339, e.g. '2024-12-31T18:00:00Z')")
 
 	workspace_util.AddProjectConfigurationFlags(CreateCmd, projectConfigurationFlags, true)
 }
@@ -524,6 +546,43 @@ func waitForDial(workspace *apiclient.Workspace, activeProfile *config.Profile,
 	}
 }
 
+// createWorkspace creates the workspace through an asynchronous operation so that long builds are not bound to a single request
+func createWorkspace(ctx context.Context, apiClient *apiclient.APIClient, createWorkspaceDto apiclient.CreateWorkspaceDTO) (*apiclient.Workspace, error) {
+	_, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Async(true).Execute()
+	return waitForWorkspaceOperation(ctx, apiClient, res, err)
+}
+
+// waitForWorkspaceOperation waits for the operation accepted by the server and returns the resulting workspace
+func waitForWorkspaceOperation(ctx context.Context, apiClient *apiclient.APIClient, res *http.Response, err error) (*apiclient.Workspace, error) {
+	// The generated client expects a workspace in the response so the accepted operation is read from the response body
+	if res == nil || res.StatusCode != http.StatusAccepted {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	operation, err := apiclient_util.GetAcceptedOperation(res)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err = apiclient_util.WaitForOperation(ctx, apiClient, operation.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := json.Marshal(operation.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspace apiclient.Workspace
+	err = json.Unmarshal(result, &workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
 func dedupProjectNames(projects *[]apiclient.CreateProjectDTO) {
 	projectNames := map[string]int{}
 
@@ -566,3 +625,23 @@ func GetGitProviderGpgKey(apiClient *apiclient.APIClient, ctx context.Context, p
 
 	return gpgKey, nil
 }
+
+func setWorkspaceExpiry(createWorkspaceDto *apiclient.CreateWorkspaceDTO) error {
+	if ttlFlag != 0 && expiresAtFlag != "" {
+		return errors.New("only one of --ttl and --expires-at can be set")
+	}
+
+	if ttlFlag != 0 {
+		createWorkspaceDto.SetTtl(ttlFlag.String())
+	}
+
+	if expiresAtFlag != "" {
+		expiresAt, err := time.Parse(time.RFC3339, expiresAtFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --expires-at value: %w", err)
+		}
+		createWorkspaceDto.SetExpiresAt(expiresAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var duplicateNameFlag string
+var includeChangesFlag bool
+
+const applyChangesTimeout = 5 * time.Minute
+
+var workspaceDuplicateCmd = &cobra.Command{
+	Use:     "duplicate [WORKSPACE]",
+	Short:   "Create a copy of a workspace",
+	Long:    "Create a new workspace on the same target with the projects, env vars and build configs of an existing workspace. Uncommitted and unpushed changes of the source projects are carried over with --include-changes",
+	Aliases: []string{"fork"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		source, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		workspaceName := duplicateNameFlag
+		if workspaceName == "" {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			existingWorkspaceNames := util.ArrayMap(workspaceList, func(w apiclient.WorkspaceDTO) string {
+				return w.Name
+			})
+
+			workspaceName = workspace_util.GetSuggestedName(source.Name, existingWorkspaceNames)
+		}
+
+		duplicateWorkspaceDto := apiclient.DuplicateWorkspaceDTO{
+			Id:   stringid.TruncateID(stringid.GenerateRandomID()),
+			Name: workspaceName,
+		}
+
+		patches := map[string]string{}
+		if includeChangesFlag {
+			commits := map[string]string{}
+
+			for _, p := range source.Projects {
+				diff, err := getProjectChanges(ctx, apiClient, source.Id, p)
+				if err != nil {
+					return err
+				}
+
+				commits[p.Name] = diff.Base
+				patches[p.Name] = diff.Patch
+			}
+
+			duplicateWorkspaceDto.Commits = commits
+		}
+
+		projectNames := util.ArrayMap(source.Projects, func(p apiclient.Project) string {
+			return p.Name
+		})
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, duplicateWorkspaceDto.Id, projectNames, true, true, nil)
+
+		_, res, err := apiClient.WorkspaceAPI.DuplicateWorkspace(ctx, source.Id).Workspace(duplicateWorkspaceDto).Async(true).Execute()
+		createdWorkspace, err := waitForWorkspaceOperation(ctx, apiClient, res, err)
+		stopLogs()
+		if err != nil {
+			return err
+		}
+
+		// Make sure terminal cursor is reset
+		fmt.Print("\033[?25h")
+
+		for projectName, patch := range patches {
+			if patch == "" {
+				continue
+			}
+
+			err = applyProjectChanges(ctx, apiClient, createdWorkspace.Id, projectName, patch)
+			if err != nil {
+				log.Warn(fmt.Sprintf("failed to apply changes to project %s: %s", projectName, err))
+			}
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully duplicated from '%s'", createdWorkspace.Name, source.Name))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return getWorkspaceNameCompletions()
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+// getProjectChanges returns a patch of the project working tree against the last commit it shares with the commit the project was cloned at,
+// or with the remote branch it tracks if the clone commit is not known.
+// That commit is known to exist in the remote repository so the new project can be cloned at it
+func getProjectChanges(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string, p apiclient.Project) (*apiclient.GitDiffResponse, error) {
+	projectDir, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, workspaceId, p.Name).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	// HEAD can be a commit that was not pushed, so the base always comes from the remote
+	base := "@{upstream}"
+	if p.Repository.Sha != "" {
+		base = p.Repository.Sha
+	}
+
+	diffRequest := apiClient.WorkspaceToolboxAPI.GitDiff(ctx, workspaceId, p.Name).Path(projectDir.GetDir()).Base(base)
+
+	diff, res, err := diffRequest.Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return diff, nil
+}
+
+var errProjectNotReady = errors.New("project repository is not ready")
+
+// applyProjectChanges applies the patch once the project repository is cloned.
+// A patch that does not apply is not retried
+func applyProjectChanges(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, projectName, patch string) error {
+	timeout := time.After(applyChangesTimeout)
+
+	for {
+		err := applyPatch(ctx, apiClient, workspaceId, projectName, patch)
+		if !errors.Is(err, errProjectNotReady) {
+			return err
+		}
+
+		select {
+		case <-timeout:
+			return err
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+func applyPatch(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, projectName, patch string) error {
+	// The project agent starts and clones the repository after the project is started
+	projectDir, _, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, workspaceId, projectName).Execute()
+	if err != nil {
+		return errProjectNotReady
+	}
+
+	_, _, err = apiClient.WorkspaceToolboxAPI.GitGitStatus(ctx, workspaceId, projectName).Path(projectDir.GetDir()).Execute()
+	if err != nil {
+		return errProjectNotReady
+	}
+
+	res, err := apiClient.WorkspaceToolboxAPI.GitApplyPatch(ctx, workspaceId, projectName).Params(apiclient.GitApplyRequest{
+		Path:  projectDir.GetDir(),
+		Patch: patch,
+	}).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func init() {
+	workspaceDuplicateCmd.Flags().StringVar(&duplicateNameFlag, "name", "", "Specify the name of the new workspace")
+	workspaceDuplicateCmd.Flags().BoolVar(&includeChangesFlag, "include-changes", false, "Apply uncommitted and unpushed changes of the source projects to the new workspace")
+}
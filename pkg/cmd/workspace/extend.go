@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var extendDurationFlag time.Duration
+
+var workspaceExtendCmd = &cobra.Command{
+	Use:   "extend [WORKSPACE]",
+	Short: "Push back the expiry of a workspace",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		var workspaceId string
+
+		if extendDurationFlag <= 0 {
+			return fmt.Errorf("--duration must be a positive duration")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "Extend")
+			if workspace == nil {
+				return nil
+			}
+			workspaceId = workspace.Id
+		} else {
+			workspaceId = args[0]
+		}
+
+		workspace, res, err := apiClient.WorkspaceAPI.ExtendWorkspace(ctx, workspaceId).Extend(apiclient.ExtendWorkspaceDTO{
+			Duration: extendDurationFlag.String(),
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339, workspace.GetExpiresAt())
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' will now be removed at %s", workspace.Name, expiresAt.Local().Format(time.RFC1123)))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	workspaceExtendCmd.Flags().DurationVarP(&extendDurationFlag, "duration", "d", time.Hour, "Duration by which to push back the workspace expiry (e.g. '2h')")
+}
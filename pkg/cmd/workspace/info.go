@@ -33,7 +33,7 @@ var InfoCmd = &cobra.Command{
 		var workspace *apiclient.WorkspaceDTO
 
 		if len(args) == 0 {
-			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(true).Execute()
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(format.FormatFlag != "").Execute()
 			if err != nil {
 				return apiclient_util.HandleErrorResponse(res, err)
 			}
@@ -53,7 +53,8 @@ var InfoCmd = &cobra.Command{
 			}
 
 		} else {
-			workspace, err = apiclient_util.GetWorkspace(args[0], true)
+			// Provider info is only needed for the formatted output
+			workspace, err = apiclient_util.GetWorkspace(args[0], format.FormatFlag != "")
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var migrateTargetFlag string
+var migrateSnapshotFlag bool
+var migrateIncludeChangesFlag bool
+
+var workspaceMigrateCmd = &cobra.Command{
+	Use:   "migrate [WORKSPACE]",
+	Short: "Move a workspace to another target",
+	Long:  "Stop the workspace, recreate its projects on another target and remove them from the current one. Uncommitted work is carried over with --snapshot (project container images) or --include-changes (git patches). If the workspace can not be created on the new target, it is restored on the current one",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		if migrateTargetFlag == "" {
+			return errors.New("target is required")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		ws, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		migrateWorkspaceDto := apiclient.MigrateWorkspaceDTO{
+			Target:       migrateTargetFlag,
+			UseSnapshots: &migrateSnapshotFlag,
+		}
+
+		// Changes are read before the workspace is stopped by the migration
+		patches := map[string]string{}
+		if migrateIncludeChangesFlag {
+			commits := map[string]string{}
+
+			for _, p := range ws.Projects {
+				diff, err := getProjectChanges(ctx, apiClient, ws.Id, p)
+				if err != nil {
+					return err
+				}
+
+				commits[p.Name] = diff.Base
+				patches[p.Name] = diff.Patch
+			}
+
+			migrateWorkspaceDto.Commits = commits
+		}
+
+		projectNames := util.ArrayMap(ws.Projects, func(p apiclient.Project) string {
+			return p.Name
+		})
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, ws.Id, projectNames, true, true, nil)
+
+		_, res, err := apiClient.WorkspaceAPI.MigrateWorkspace(ctx, ws.Id).Migrate(migrateWorkspaceDto).Async(true).Execute()
+		migratedWorkspace, err := waitForWorkspaceOperation(ctx, apiClient, res, err)
+		stopLogs()
+		if err != nil {
+			return err
+		}
+
+		// Make sure terminal cursor is reset
+		fmt.Print("\033[?25h")
+
+		for projectName, patch := range patches {
+			if patch == "" {
+				continue
+			}
+
+			err = applyProjectChanges(ctx, apiClient, migratedWorkspace.Id, projectName, patch)
+			if err != nil {
+				log.Warn(fmt.Sprintf("failed to apply changes to project %s: %s", projectName, err))
+			}
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully migrated to target '%s'", migratedWorkspace.Name, migratedWorkspace.Target))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return getWorkspaceNameCompletions()
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+func init() {
+	workspaceMigrateCmd.Flags().StringVarP(&migrateTargetFlag, "target", "t", "", "Specify the target to migrate the workspace to")
+	workspaceMigrateCmd.Flags().BoolVar(&migrateSnapshotFlag, "snapshot", false, "Carry the project containers over to the new target as snapshot images")
+	workspaceMigrateCmd.Flags().BoolVar(&migrateIncludeChangesFlag, "include-changes", false, "Apply uncommitted and unpushed changes of the projects after they are recreated")
+	workspaceMigrateCmd.MarkFlagsMutuallyExclusive("snapshot", "include-changes")
+}
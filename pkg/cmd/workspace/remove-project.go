@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var workspaceRemoveProjectCmd = &cobra.Command{
+	Use:     "remove-project [WORKSPACE] [PROJECT]",
+	Short:   "Remove a project from a workspace",
+	Aliases: []string{"rm-project"},
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		workspaceId := args[0]
+		projectName := args[1]
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		confirmed := yesFlag
+		if !confirmed {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Remove project '%s'?", projectName)).
+						Description(fmt.Sprintf("Are you sure you want to remove the project '%s' from the workspace '%s'?", projectName, workspaceId)).
+						Value(&confirmed),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				return err
+			}
+		}
+
+		if !confirmed {
+			fmt.Println("Operation canceled.")
+			return nil
+		}
+
+		res, err := apiClient.WorkspaceAPI.RemoveProject(ctx, workspaceId, projectName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Project '%s' successfully removed from workspace '%s'", projectName, workspaceId))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return getWorkspaceNameCompletions()
+		}
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+func init() {
+	workspaceRemoveProjectCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm removal without prompt")
+}
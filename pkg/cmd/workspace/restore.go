@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var restoreProjectNameFlag string
+
+var workspaceRestoreCmd = &cobra.Command{
+	Use:   "restore [WORKSPACE] [SNAPSHOT_ID]",
+	Short: "Restore a project snapshot as a new project in a workspace",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		snapshotId := args[1]
+
+		from := time.Now()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		projectName := restoreProjectNameFlag
+		if projectName == "" {
+			snapshotList, res, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, workspace.Id).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			for _, s := range snapshotList {
+				if s.Id == snapshotId {
+					projectName = s.ProjectName
+				}
+			}
+
+			if projectName == "" {
+				return fmt.Errorf("snapshot %s not found in workspace %s, specify the project name with --name to restore it", snapshotId, workspace.Name)
+			}
+
+			existingProjectNames := util.ArrayMap(workspace.Projects, func(p apiclient.Project) string {
+				return p.Name
+			})
+
+			projectName = workspace_util.GetSuggestedName(projectName, existingProjectNames)
+		}
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, []string{projectName}, true, false, &from)
+
+		project, res, err := apiClient.WorkspaceAPI.RestoreSnapshot(ctx, workspace.Id).Restore(apiclient.RestoreSnapshotDTO{
+			SnapshotId:  snapshotId,
+			ProjectName: projectName,
+		}).Execute()
+		stopLogs()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		// Make sure terminal cursor is reset
+		fmt.Print("\033[?25h")
+
+		views.RenderInfoMessage(fmt.Sprintf("Snapshot '%s' successfully restored as project '%s' in workspace '%s'", snapshotId, project.Name, workspace.Name))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return getWorkspaceNameCompletions()
+		}
+		if len(args) == 1 {
+			return getSnapshotIdCompletions(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+func init() {
+	workspaceRestoreCmd.Flags().StringVar(&restoreProjectNameFlag, "name", "", "Specify the restored project name")
+}
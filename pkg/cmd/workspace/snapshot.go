@@ -0,0 +1,140 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	snapshot_view "github.com/daytonaio/daytona/pkg/views/workspace/snapshot"
+	"github.com/spf13/cobra"
+)
+
+var includeVolumeFlag bool
+
+var workspaceSnapshotCmd = &cobra.Command{
+	Use:   "snapshot [WORKSPACE] [PROJECT]",
+	Short: "Snapshot a project to the container registry",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		projectName := args[1]
+
+		from := time.Now()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, []string{projectName}, true, false, &from)
+
+		snapshot, res, err := apiClient.WorkspaceAPI.SnapshotProject(ctx, workspace.Id, projectName).Snapshot(apiclient.CreateSnapshotDTO{
+			IncludeVolume: &includeVolumeFlag,
+		}).Execute()
+		stopLogs()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Snapshot '%s' of project '%s' successfully created", snapshot.Id, projectName))
+		views.RenderTip(fmt.Sprintf("Use 'daytona workspace restore %s %s' to restore it", workspace.Name, snapshot.Id))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return getWorkspaceNameCompletions()
+		}
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+var workspaceListSnapshotsCmd = &cobra.Command{
+	Use:     "list-snapshots [WORKSPACE]",
+	Short:   "List project snapshots of a workspace",
+	Aliases: []string{"ls-snapshots"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		snapshotList, res, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(snapshotList)
+			formattedData.Print()
+			return nil
+		}
+
+		snapshot_view.ListSnapshots(snapshotList)
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return getWorkspaceNameCompletions()
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+func getSnapshotIdCompletions(workspaceId string) ([]string, cobra.ShellCompDirective) {
+	ctx := context.Background()
+
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	snapshotList, _, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, workspaceId).Execute()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var choices []string
+	for _, s := range snapshotList {
+		choices = append(choices, s.Id)
+	}
+
+	return choices, cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	workspaceSnapshotCmd.Flags().BoolVar(&includeVolumeFlag, "include-volume", false, "Include the project directory in the snapshot")
+
+	format.RegisterFormatFlag(workspaceListSnapshotsCmd)
+}
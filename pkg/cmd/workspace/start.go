@@ -302,11 +302,16 @@ func StartWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName str
 	go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, projectNames, true, true, &from)
 
 	if projectName == "" {
-		res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, workspaceId).Execute()
+		operation, res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, workspaceId).Async(true).Execute()
 		if err != nil {
 			stopLogs()
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
+		_, err = apiclient_util.WaitForOperation(ctx, apiClient, operation.Id)
+		if err != nil {
+			stopLogs()
+			return err
+		}
 		time.Sleep(100 * time.Millisecond)
 		stopLogs()
 		return nil
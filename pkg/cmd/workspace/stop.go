@@ -162,11 +162,12 @@ func StopWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName stri
 	if projectName == "" {
 		message = fmt.Sprintf("Workspace '%s' is stopping", workspaceId)
 		stopFunc = func() error {
-			res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspaceId).Execute()
+			operation, res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspaceId).Async(true).Execute()
 			if err != nil {
 				return apiclient_util.HandleErrorResponse(res, err)
 			}
-			return nil
+			_, err = apiclient_util.WaitForOperation(ctx, apiClient, operation.Id)
+			return err
 		}
 	} else {
 		message = fmt.Sprintf("Project '%s' from workspace '%s' is stopping", projectName, workspaceId)
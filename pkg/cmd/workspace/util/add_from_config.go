@@ -47,6 +47,7 @@ func AddProjectFromConfig(projectConfig *apiclient.ProjectConfig, apiClient *api
 		Image:       &projectConfig.Image,
 		User:        &projectConfig.User,
 		EnvVars:     projectConfig.EnvVars,
+		IdleTimeout: projectConfig.IdleTimeout,
 	}
 	*projects = append(*projects, *project)
 
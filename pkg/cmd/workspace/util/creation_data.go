@@ -101,6 +101,7 @@ func GetProjectsCreationDataFromPrompt(config ProjectsDataPromptConfig) ([]apicl
 					Image:       config.Defaults.Image,
 					User:        config.Defaults.ImageUser,
 					EnvVars:     projectConfig.EnvVars,
+					IdleTimeout: projectConfig.IdleTimeout,
 				}
 
 				if projectConfig.Image != "" {
@@ -267,6 +268,12 @@ func GetCreateProjectDtoFromFlags(projectConfigurationFlags ProjectConfiguration
 
 	}
 
+	if *projectConfigurationFlags.Builder == views_util.DOCKERFILE {
+		project.BuildConfig.Dockerfile = &apiclient.DockerfileConfig{
+			FilePath: create.DOCKERFILE_FILEPATH,
+		}
+	}
+
 	if *projectConfigurationFlags.Builder == views_util.NONE || *projectConfigurationFlags.CustomImage != "" || *projectConfigurationFlags.CustomImageUser != "" {
 		project.BuildConfig = nil
 		if *projectConfigurationFlags.CustomImage != "" || *projectConfigurationFlags.CustomImageUser != "" {
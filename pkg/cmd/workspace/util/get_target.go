@@ -30,12 +30,24 @@ func GetTarget(config GetTargetConfig) (*target_view.TargetView, error) {
 	if config.TargetNameFlag != "" {
 		for _, t := range config.TargetList {
 			if t.Name == config.TargetNameFlag {
+				if t.GetIsTemplate() {
+					return nil, fmt.Errorf("target '%s' is a template. Use `daytona target instantiate` to create a target from it", t.Name)
+				}
 				return util.Pointer(target_view.GetTargetViewFromTarget(t)), nil
 			}
 		}
 		return nil, fmt.Errorf("target '%s' not found", config.TargetNameFlag)
 	}
 
+	// Templates can not be used to create workspaces
+	targetList := []apiclient.ProviderTarget{}
+	for _, t := range config.TargetList {
+		if !t.GetIsTemplate() {
+			targetList = append(targetList, t)
+		}
+	}
+	config.TargetList = targetList
+
 	if !config.PromptUsingTUI {
 		for _, t := range config.TargetList {
 			if t.IsDefault {
@@ -83,7 +95,7 @@ func GetTarget(config GetTargetConfig) (*target_view.TargetView, error) {
 	err = provider.InstallProvider(config.ApiClient, provider_view.ProviderView{
 		Name:    selectedTarget.ProviderInfo.Name,
 		Version: selectedTarget.ProviderInfo.Version,
-	}, providersManifest)
+	}, providersManifest, false, false)
 	if err != nil {
 		return nil, err
 	}
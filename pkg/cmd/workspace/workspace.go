@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var WorkspaceCmd = &cobra.Command{
+	Use:     "workspace",
+	Short:   "Manage workspaces",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	WorkspaceCmd.AddCommand(workspaceExtendCmd)
+	WorkspaceCmd.AddCommand(workspaceDuplicateCmd)
+	WorkspaceCmd.AddCommand(workspaceMigrateCmd)
+	WorkspaceCmd.AddCommand(workspaceAddProjectCmd)
+	WorkspaceCmd.AddCommand(workspaceRemoveProjectCmd)
+	WorkspaceCmd.AddCommand(workspaceSnapshotCmd)
+	WorkspaceCmd.AddCommand(workspaceListSnapshotsCmd)
+	WorkspaceCmd.AddCommand(workspaceRestoreCmd)
+}
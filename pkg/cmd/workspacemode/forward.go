@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 	defaultPortForwardCmd "github.com/daytonaio/daytona/pkg/cmd/ports"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -23,6 +24,16 @@ var portForwardCmd = &cobra.Command{
 			return err
 		}
 
+		workspace, err := apiclient.GetWorkspace(workspaceId, false)
+		if err != nil {
+			return err
+		}
+
+		err = defaultPortForwardCmd.RequirePortExposure(workspace)
+		if err != nil {
+			return err
+		}
+
 		errChan := make(chan error)
 		go func() {
 			errChan <- defaultPortForwardCmd.ForwardPublicPort(workspaceId, projectName, uint16(port), uint16(port))
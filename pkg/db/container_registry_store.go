@@ -8,19 +8,26 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 )
 
 type ContainerRegistryStore struct {
-	db *gorm.DB
+	db        *gorm.DB
+	encryptor *encryption.Encryptor
 }
 
-func NewContainerRegistryStore(db *gorm.DB) (*ContainerRegistryStore, error) {
+func NewContainerRegistryStore(db *gorm.DB, encryptor *encryption.Encryptor) (*ContainerRegistryStore, error) {
 	err := db.AutoMigrate(&ContainerRegistryDTO{})
 	if err != nil {
 		return nil, err
 	}
 
-	return &ContainerRegistryStore{db: db}, nil
+	err = encryptPlaintextSecrets(db, containerRegistrySecrets, encryptor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerRegistryStore{db: db, encryptor: encryptor}, nil
 }
 
 func (s *ContainerRegistryStore) List() ([]*containerregistry.ContainerRegistry, error) {
@@ -32,6 +39,10 @@ func (s *ContainerRegistryStore) List() ([]*containerregistry.ContainerRegistry,
 
 	containerregistryTargets := []*containerregistry.ContainerRegistry{}
 	for _, containerRegistryDTO := range containerRegistryDTOs {
+		err := decryptSecrets(containerRegistrySecrets(&containerRegistryDTO), s.encryptor)
+		if err != nil {
+			return nil, err
+		}
 		containerregistryTargets = append(containerregistryTargets, ToContainerRegistry(containerRegistryDTO))
 	}
 
@@ -48,11 +59,22 @@ func (s *ContainerRegistryStore) Find(server string) (*containerregistry.Contain
 		return nil, tx.Error
 	}
 
+	err := decryptSecrets(containerRegistrySecrets(&containerRegistryDTO), s.encryptor)
+	if err != nil {
+		return nil, err
+	}
+
 	return ToContainerRegistry(containerRegistryDTO), nil
 }
 
 func (s *ContainerRegistryStore) Save(cr *containerregistry.ContainerRegistry) error {
-	tx := s.db.Save(ToContainerRegistryDTO(cr))
+	containerRegistryDTO := ToContainerRegistryDTO(cr)
+	err := encryptSecrets(containerRegistrySecrets(&containerRegistryDTO), s.encryptor)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Save(containerRegistryDTO)
 	if tx.Error != nil {
 		return tx.Error
 	}
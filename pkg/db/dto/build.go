@@ -11,21 +11,25 @@ import (
 )
 
 type BuildDTO struct {
-	Id              string                          `json:"id" gorm:"primaryKey"`
-	State           string                          `json:"state"`
-	Image           *string                         `json:"image,omitempty"`
-	User            *string                         `json:"user,omitempty"`
-	ContainerConfig containerconfig.ContainerConfig `gorm:"serializer:json"`
-	BuildConfig     *ProjectBuildDTO                `json:"build,omitempty" gorm:"serializer:json"`
-	Repository      RepositoryDTO                   `gorm:"serializer:json"`
-	EnvVars         map[string]string               `json:"envVars" gorm:"serializer:json"`
-	PrebuildId      string                          `json:"prebuildId"`
-	CreatedAt       time.Time                       `json:"createdAt"`
-	UpdatedAt       time.Time                       `json:"updatedAt"`
+	Id                string                          `json:"id" gorm:"primaryKey"`
+	State             string                          `json:"state"`
+	Image             *string                         `json:"image,omitempty"`
+	User              *string                         `json:"user,omitempty"`
+	ContainerConfig   containerconfig.ContainerConfig `gorm:"serializer:json"`
+	BuildConfig       *ProjectBuildDTO                `json:"build,omitempty" gorm:"serializer:json"`
+	Repository        RepositoryDTO                   `gorm:"serializer:json"`
+	EnvVars           map[string]string               `json:"envVars" gorm:"serializer:json"`
+	PrebuildId        string                          `json:"prebuildId"`
+	Priority          string                          `json:"priority"`
+	Attempts          []build.BuildAttempt            `json:"attempts" gorm:"serializer:json"`
+	LastErrorCategory *string                         `json:"lastErrorCategory,omitempty"`
+	RetryAt           *time.Time                      `json:"retryAt,omitempty"`
+	CreatedAt         time.Time                       `json:"createdAt"`
+	UpdatedAt         time.Time                       `json:"updatedAt"`
 }
 
 func ToBuildDTO(build *build.Build) BuildDTO {
-	return BuildDTO{
+	buildDTO := BuildDTO{
 		Id:              build.Id,
 		State:           string(build.State),
 		Image:           build.Image,
@@ -35,13 +39,23 @@ func ToBuildDTO(build *build.Build) BuildDTO {
 		Repository:      ToRepositoryDTO(build.Repository),
 		EnvVars:         build.EnvVars,
 		PrebuildId:      build.PrebuildId,
+		Priority:        string(build.Priority),
+		Attempts:        build.Attempts,
+		RetryAt:         build.RetryAt,
 		CreatedAt:       build.CreatedAt,
 		UpdatedAt:       build.UpdatedAt,
 	}
+
+	if build.LastErrorCategory != nil {
+		lastErrorCategory := string(*build.LastErrorCategory)
+		buildDTO.LastErrorCategory = &lastErrorCategory
+	}
+
+	return buildDTO
 }
 
 func ToBuild(buildDTO BuildDTO) *build.Build {
-	return &build.Build{
+	b := &build.Build{
 		Id:              buildDTO.Id,
 		State:           build.BuildState(buildDTO.State),
 		Image:           buildDTO.Image,
@@ -51,7 +65,17 @@ func ToBuild(buildDTO BuildDTO) *build.Build {
 		Repository:      ToRepository(buildDTO.Repository),
 		EnvVars:         buildDTO.EnvVars,
 		PrebuildId:      buildDTO.PrebuildId,
+		Priority:        build.BuildPriority(buildDTO.Priority),
+		Attempts:        buildDTO.Attempts,
+		RetryAt:         buildDTO.RetryAt,
 		CreatedAt:       buildDTO.CreatedAt,
 		UpdatedAt:       buildDTO.UpdatedAt,
 	}
+
+	if buildDTO.LastErrorCategory != nil {
+		lastErrorCategory := build.BuildErrorCategory(*buildDTO.LastErrorCategory)
+		b.LastErrorCategory = &lastErrorCategory
+	}
+
+	return b
 }
@@ -10,16 +10,17 @@ import (
 )
 
 type RepositoryDTO struct {
-	Id       string                  `json:"id"`
-	Url      string                  `json:"url"`
-	Name     string                  `json:"name"`
-	Owner    string                  `json:"owner"`
-	Sha      string                  `json:"sha"`
-	Source   string                  `json:"source"`
-	Branch   string                  `json:"branch"`
-	PrNumber *uint32                 `json:"prNumber,omitempty"`
-	Path     *string                 `json:"path,omitempty"`
-	Target   gitprovider.CloneTarget `json:"cloneTarget,omitempty"`
+	Id         string                  `json:"id"`
+	Url        string                  `json:"url"`
+	Name       string                  `json:"name"`
+	Owner      string                  `json:"owner"`
+	Sha        string                  `json:"sha"`
+	Source     string                  `json:"source"`
+	Branch     string                  `json:"branch"`
+	PrNumber   *uint32                 `json:"prNumber,omitempty"`
+	Path       *string                 `json:"path,omitempty"`
+	Target     gitprovider.CloneTarget `json:"cloneTarget,omitempty"`
+	KeepBranch bool                    `json:"keepBranch,omitempty"`
 }
 
 type FileStatusDTO struct {
@@ -38,17 +39,31 @@ type GitStatusDTO struct {
 }
 
 type ProjectStateDTO struct {
-	UpdatedAt string        `json:"updatedAt"`
-	Uptime    uint64        `json:"uptime"`
-	GitStatus *GitStatusDTO `json:"gitStatus"`
+	UpdatedAt    string        `json:"updatedAt"`
+	Uptime       uint64        `json:"uptime"`
+	GitStatus    *GitStatusDTO `json:"gitStatus"`
+	LastActivity string        `json:"lastActivity,omitempty"`
 }
 
 type ProjectBuildDevcontainerDTO struct {
 	FilePath string `json:"filePath"`
 }
 
+type ProjectBuildDockerfileDTO struct {
+	FilePath  string            `json:"filePath"`
+	Context   string            `json:"context,omitempty"`
+	BuildArgs map[string]string `json:"buildArgs,omitempty"`
+	Target    string            `json:"target,omitempty"`
+}
+
+type ProjectBuildLanguageDTO struct {
+	Languages []string `json:"languages"`
+}
+
 type ProjectBuildDTO struct {
 	Devcontainer *ProjectBuildDevcontainerDTO `json:"devcontainer"`
+	Dockerfile   *ProjectBuildDockerfileDTO   `json:"dockerfile,omitempty"`
+	Language     *ProjectBuildLanguageDTO     `json:"language,omitempty"`
 }
 
 type ProjectDTO struct {
@@ -62,6 +77,10 @@ type ProjectDTO struct {
 	ApiKey              string           `json:"apiKey"`
 	State               *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
 	GitProviderConfigId *string          `json:"gitProviderConfigId,omitempty"`
+	IdleTimeout         *int             `json:"idleTimeout,omitempty"`
+	Status              string           `json:"status"`
+	LastError           *string          `json:"lastError,omitempty"`
+	SnapshotId          *string          `json:"snapshotId,omitempty"`
 }
 
 func ToProjectDTO(project *project.Project) ProjectDTO {
@@ -76,21 +95,26 @@ func ToProjectDTO(project *project.Project) ProjectDTO {
 		State:               ToProjectStateDTO(project.State),
 		ApiKey:              project.ApiKey,
 		GitProviderConfigId: project.GitProviderConfigId,
+		IdleTimeout:         project.IdleTimeout,
+		Status:              string(project.Status),
+		LastError:           project.LastError,
+		SnapshotId:          project.SnapshotId,
 	}
 }
 
 func ToRepositoryDTO(repo *gitprovider.GitRepository) RepositoryDTO {
 	repoDTO := RepositoryDTO{
-		Url:      repo.Url,
-		Name:     repo.Name,
-		Id:       repo.Id,
-		Owner:    repo.Owner,
-		Sha:      repo.Sha,
-		Source:   repo.Source,
-		Branch:   repo.Branch,
-		PrNumber: repo.PrNumber,
-		Path:     repo.Path,
-		Target:   repo.Target,
+		Url:        repo.Url,
+		Name:       repo.Name,
+		Id:         repo.Id,
+		Owner:      repo.Owner,
+		Sha:        repo.Sha,
+		Source:     repo.Source,
+		Branch:     repo.Branch,
+		PrNumber:   repo.PrNumber,
+		Path:       repo.Path,
+		Target:     repo.Target,
+		KeepBranch: repo.KeepBranch,
 	}
 
 	return repoDTO
@@ -134,9 +158,10 @@ func ToProjectStateDTO(state *project.ProjectState) *ProjectStateDTO {
 	}
 
 	return &ProjectStateDTO{
-		UpdatedAt: state.UpdatedAt,
-		Uptime:    state.Uptime,
-		GitStatus: ToGitStatusDTO(state.GitStatus),
+		UpdatedAt:    state.UpdatedAt,
+		Uptime:       state.Uptime,
+		GitStatus:    ToGitStatusDTO(state.GitStatus),
+		LastActivity: state.LastActivity,
 	}
 }
 
@@ -145,18 +170,38 @@ func ToProjectBuildDTO(build *buildconfig.BuildConfig) *ProjectBuildDTO {
 		return nil
 	}
 
-	if build.Devcontainer == nil {
-		return &ProjectBuildDTO{}
-	}
+	buildDTO := &ProjectBuildDTO{}
 
-	return &ProjectBuildDTO{
-		Devcontainer: &ProjectBuildDevcontainerDTO{
+	if build.Devcontainer != nil {
+		buildDTO.Devcontainer = &ProjectBuildDevcontainerDTO{
 			FilePath: build.Devcontainer.FilePath,
-		},
+		}
+	}
+
+	if build.Dockerfile != nil {
+		buildDTO.Dockerfile = &ProjectBuildDockerfileDTO{
+			FilePath:  build.Dockerfile.FilePath,
+			Context:   build.Dockerfile.Context,
+			BuildArgs: build.Dockerfile.BuildArgs,
+			Target:    build.Dockerfile.Target,
+		}
+	}
+
+	if build.Language != nil {
+		buildDTO.Language = &ProjectBuildLanguageDTO{
+			Languages: build.Language.Languages,
+		}
 	}
+
+	return buildDTO
 }
 
 func ToProject(projectDTO ProjectDTO) *project.Project {
+	status := project.ProjectStatus(projectDTO.Status)
+	if status == "" {
+		status = project.ProjectStatusUnknown
+	}
+
 	return &project.Project{
 		Name:                projectDTO.Name,
 		Image:               projectDTO.Image,
@@ -168,6 +213,10 @@ func ToProject(projectDTO ProjectDTO) *project.Project {
 		State:               ToProjectState(projectDTO.State),
 		ApiKey:              projectDTO.ApiKey,
 		GitProviderConfigId: projectDTO.GitProviderConfigId,
+		IdleTimeout:         projectDTO.IdleTimeout,
+		Status:              status,
+		LastError:           projectDTO.LastError,
+		SnapshotId:          projectDTO.SnapshotId,
 	}
 }
 
@@ -209,24 +258,26 @@ func ToProjectState(stateDTO *ProjectStateDTO) *project.ProjectState {
 	}
 
 	return &project.ProjectState{
-		UpdatedAt: stateDTO.UpdatedAt,
-		Uptime:    stateDTO.Uptime,
-		GitStatus: ToGitStatus(stateDTO.GitStatus),
+		UpdatedAt:    stateDTO.UpdatedAt,
+		Uptime:       stateDTO.Uptime,
+		GitStatus:    ToGitStatus(stateDTO.GitStatus),
+		LastActivity: stateDTO.LastActivity,
 	}
 }
 
 func ToRepository(repoDTO RepositoryDTO) *gitprovider.GitRepository {
 	repo := gitprovider.GitRepository{
-		Url:      repoDTO.Url,
-		Id:       repoDTO.Id,
-		Name:     repoDTO.Name,
-		Owner:    repoDTO.Owner,
-		Branch:   repoDTO.Branch,
-		Sha:      repoDTO.Sha,
-		PrNumber: repoDTO.PrNumber,
-		Source:   repoDTO.Source,
-		Path:     repoDTO.Path,
-		Target:   gitprovider.CloneTarget(repoDTO.Target),
+		Url:        repoDTO.Url,
+		Id:         repoDTO.Id,
+		Name:       repoDTO.Name,
+		Owner:      repoDTO.Owner,
+		Branch:     repoDTO.Branch,
+		Sha:        repoDTO.Sha,
+		PrNumber:   repoDTO.PrNumber,
+		Source:     repoDTO.Source,
+		Path:       repoDTO.Path,
+		Target:     gitprovider.CloneTarget(repoDTO.Target),
+		KeepBranch: repoDTO.KeepBranch,
 	}
 
 	return &repo
@@ -237,13 +288,28 @@ func ToProjectBuild(buildDTO *ProjectBuildDTO) *buildconfig.BuildConfig {
 		return nil
 	}
 
-	if buildDTO.Devcontainer == nil {
-		return &buildconfig.BuildConfig{}
-	}
+	build := &buildconfig.BuildConfig{}
 
-	return &buildconfig.BuildConfig{
-		Devcontainer: &buildconfig.DevcontainerConfig{
+	if buildDTO.Devcontainer != nil {
+		build.Devcontainer = &buildconfig.DevcontainerConfig{
 			FilePath: buildDTO.Devcontainer.FilePath,
-		},
+		}
 	}
+
+	if buildDTO.Dockerfile != nil {
+		build.Dockerfile = &buildconfig.DockerfileConfig{
+			FilePath:  buildDTO.Dockerfile.FilePath,
+			Context:   buildDTO.Dockerfile.Context,
+			BuildArgs: buildDTO.Dockerfile.BuildArgs,
+			Target:    buildDTO.Dockerfile.Target,
+		}
+	}
+
+	if buildDTO.Language != nil {
+		build.Language = &buildconfig.LanguageConfig{
+			Languages: buildDTO.Language.Languages,
+		}
+	}
+
+	return build
 }
@@ -17,6 +17,7 @@ type ProjectConfigDTO struct {
 	Prebuilds           []PrebuildDTO     `gorm:"serializer:json"`
 	IsDefault           bool              `json:"isDefault"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId" validate:"optional"`
+	IdleTimeout         *int              `json:"idleTimeout,omitempty"`
 }
 
 type PrebuildDTO struct {
@@ -43,6 +44,7 @@ func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfig.IsDefault,
 		GitProviderConfigId: projectConfig.GitProviderConfigId,
+		IdleTimeout:         projectConfig.IdleTimeout,
 	}
 }
 
@@ -62,6 +64,7 @@ func ToProjectConfig(projectConfigDTO ProjectConfigDTO) *config.ProjectConfig {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfigDTO.IsDefault,
 		GitProviderConfigId: projectConfigDTO.GitProviderConfigId,
+		IdleTimeout:         projectConfigDTO.IdleTimeout,
 	}
 }
 
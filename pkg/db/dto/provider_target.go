@@ -6,22 +6,28 @@ package dto
 import "github.com/daytonaio/daytona/pkg/provider"
 
 type ProviderTargetDTO struct {
-	Name            string  `json:"name" gorm:"primaryKey"`
-	ProviderName    string  `json:"providerName"`
-	ProviderLabel   *string `json:"providerLabel,omitempty"`
-	ProviderVersion string  `json:"providerVersion"`
-	Options         string  `json:"options"`
-	IsDefault       bool    `json:"isDefault"`
+	Name                    string  `json:"name" gorm:"primaryKey"`
+	ProviderName            string  `json:"providerName"`
+	ProviderLabel           *string `json:"providerLabel,omitempty"`
+	ProviderVersion         string  `json:"providerVersion"`
+	Options                 string  `json:"options"`
+	IsDefault               bool    `json:"isDefault"`
+	IdleTimeout             *int    `json:"idleTimeout,omitempty"`
+	IsTemplate              bool    `json:"isTemplate"`
+	MaxConcurrentOperations *int    `json:"maxConcurrentOperations,omitempty"`
 }
 
 func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTargetDTO {
 	return ProviderTargetDTO{
-		Name:            providerTarget.Name,
-		ProviderName:    providerTarget.ProviderInfo.Name,
-		ProviderLabel:   providerTarget.ProviderInfo.Label,
-		ProviderVersion: providerTarget.ProviderInfo.Version,
-		Options:         providerTarget.Options,
-		IsDefault:       providerTarget.IsDefault,
+		Name:                    providerTarget.Name,
+		ProviderName:            providerTarget.ProviderInfo.Name,
+		ProviderLabel:           providerTarget.ProviderInfo.Label,
+		ProviderVersion:         providerTarget.ProviderInfo.Version,
+		Options:                 providerTarget.Options,
+		IsDefault:               providerTarget.IsDefault,
+		IdleTimeout:             providerTarget.IdleTimeout,
+		IsTemplate:              providerTarget.IsTemplate,
+		MaxConcurrentOperations: providerTarget.MaxConcurrentOperations,
 	}
 }
 
@@ -33,7 +39,10 @@ func ToProviderTarget(providerTargetDTO ProviderTargetDTO) *provider.ProviderTar
 			Label:   providerTargetDTO.ProviderLabel,
 			Version: providerTargetDTO.ProviderVersion,
 		},
-		Options:   providerTargetDTO.Options,
-		IsDefault: providerTargetDTO.IsDefault,
+		Options:                 providerTargetDTO.Options,
+		IsDefault:               providerTargetDTO.IsDefault,
+		IdleTimeout:             providerTargetDTO.IdleTimeout,
+		IsTemplate:              providerTargetDTO.IsTemplate,
+		MaxConcurrentOperations: providerTargetDTO.MaxConcurrentOperations,
 	}
 }
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+)
+
+type SnapshotDTO struct {
+	Id                  string        `gorm:"primaryKey"`
+	WorkspaceId         string        `gorm:"index"`
+	ProjectName         string        `gorm:"not null"`
+	Image               string        `gorm:"not null"`
+	User                string        `gorm:"not null"`
+	IncludesVolume      bool          `gorm:"not null"`
+	Repository          RepositoryDTO `gorm:"serializer:json"`
+	GitProviderConfigId *string
+	EnvVars             map[string]string `gorm:"serializer:json"`
+	CreatedAt           time.Time
+}
+
+func ToSnapshotDTO(s *snapshot.Snapshot) SnapshotDTO {
+	return SnapshotDTO{
+		Id:                  s.Id,
+		WorkspaceId:         s.WorkspaceId,
+		ProjectName:         s.ProjectName,
+		Image:               s.Image,
+		User:                s.User,
+		IncludesVolume:      s.IncludesVolume,
+		Repository:          ToRepositoryDTO(s.Repository),
+		GitProviderConfigId: s.GitProviderConfigId,
+		EnvVars:             s.EnvVars,
+		CreatedAt:           s.CreatedAt,
+	}
+}
+
+func ToSnapshot(snapshotDTO SnapshotDTO) *snapshot.Snapshot {
+	return &snapshot.Snapshot{
+		Id:                  snapshotDTO.Id,
+		WorkspaceId:         snapshotDTO.WorkspaceId,
+		ProjectName:         snapshotDTO.ProjectName,
+		Image:               snapshotDTO.Image,
+		User:                snapshotDTO.User,
+		IncludesVolume:      snapshotDTO.IncludesVolume,
+		Repository:          ToRepository(snapshotDTO.Repository),
+		GitProviderConfigId: snapshotDTO.GitProviderConfigId,
+		EnvVars:             snapshotDTO.EnvVars,
+		CreatedAt:           snapshotDTO.CreatedAt,
+	}
+}
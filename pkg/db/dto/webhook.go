@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type WebhookDTO struct {
+	Id        string             `gorm:"primaryKey"`
+	Url       string             `gorm:"not null"`
+	Secret    string             `gorm:"not null"`
+	Events    []events.EventType `gorm:"serializer:json"`
+	CreatedAt time.Time
+}
+
+type WebhookDeliveryDTO struct {
+	Id            string `gorm:"primaryKey"`
+	WebhookId     string `gorm:"index"`
+	EventId       string
+	EventType     events.EventType
+	Status        webhook.DeliveryStatus `gorm:"index"`
+	Attempts      int
+	StatusCode    *int
+	Error         *string
+	NextAttemptAt *time.Time
+	Payload       string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func ToWebhookDTO(w *webhook.Webhook) WebhookDTO {
+	return WebhookDTO{
+		Id:        w.Id,
+		Url:       w.Url,
+		Secret:    w.Secret,
+		Events:    w.Events,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func ToWebhook(webhookDTO WebhookDTO) *webhook.Webhook {
+	return &webhook.Webhook{
+		Id:        webhookDTO.Id,
+		Url:       webhookDTO.Url,
+		Secret:    webhookDTO.Secret,
+		Events:    webhookDTO.Events,
+		CreatedAt: webhookDTO.CreatedAt,
+	}
+}
+
+func ToWebhookDeliveryDTO(delivery *webhook.Delivery) WebhookDeliveryDTO {
+	return WebhookDeliveryDTO{
+		Id:            delivery.Id,
+		WebhookId:     delivery.WebhookId,
+		EventId:       delivery.EventId,
+		EventType:     delivery.EventType,
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		StatusCode:    delivery.StatusCode,
+		Error:         delivery.Error,
+		NextAttemptAt: delivery.NextAttemptAt,
+		Payload:       delivery.Payload,
+		CreatedAt:     delivery.CreatedAt,
+		UpdatedAt:     delivery.UpdatedAt,
+	}
+}
+
+func ToWebhookDelivery(deliveryDTO WebhookDeliveryDTO) *webhook.Delivery {
+	return &webhook.Delivery{
+		Id:            deliveryDTO.Id,
+		WebhookId:     deliveryDTO.WebhookId,
+		EventId:       deliveryDTO.EventId,
+		EventType:     deliveryDTO.EventType,
+		Status:        deliveryDTO.Status,
+		Attempts:      deliveryDTO.Attempts,
+		StatusCode:    deliveryDTO.StatusCode,
+		Error:         deliveryDTO.Error,
+		NextAttemptAt: deliveryDTO.NextAttemptAt,
+		Payload:       deliveryDTO.Payload,
+		CreatedAt:     deliveryDTO.CreatedAt,
+		UpdatedAt:     deliveryDTO.UpdatedAt,
+	}
+}
@@ -5,16 +5,20 @@ package dto
 
 import (
 	"errors"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type WorkspaceDTO struct {
-	Id       string       `gorm:"primaryKey"`
-	Name     string       `json:"name" gorm:"unique"`
-	Target   string       `json:"target"`
-	ApiKey   string       `json:"apiKey"`
-	Projects []ProjectDTO `gorm:"serializer:json"`
+	Id        string       `gorm:"primaryKey"`
+	Name      string       `json:"name" gorm:"unique"`
+	Target    string       `json:"target"`
+	ApiKey    string       `json:"apiKey"`
+	ExpiresAt *time.Time   `json:"expiresAt"`
+	Status    string       `json:"status"`
+	LastError *string      `json:"lastError,omitempty"`
+	Projects  []ProjectDTO `gorm:"serializer:json"`
 }
 
 func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
@@ -29,10 +33,13 @@ func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
 
 func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 	workspaceDTO := WorkspaceDTO{
-		Id:     workspace.Id,
-		Name:   workspace.Name,
-		Target: workspace.Target,
-		ApiKey: workspace.ApiKey,
+		Id:        workspace.Id,
+		Name:      workspace.Name,
+		Target:    workspace.Target,
+		ApiKey:    workspace.ApiKey,
+		ExpiresAt: workspace.ExpiresAt,
+		Status:    string(workspace.Status),
+		LastError: workspace.LastError,
 	}
 
 	for _, project := range workspace.Projects {
@@ -43,11 +50,19 @@ func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 }
 
 func ToWorkspace(workspaceDTO WorkspaceDTO) *workspace.Workspace {
+	status := workspace.WorkspaceStatus(workspaceDTO.Status)
+	if status == "" {
+		status = workspace.WorkspaceStatusUnknown
+	}
+
 	workspace := workspace.Workspace{
-		Id:     workspaceDTO.Id,
-		Name:   workspaceDTO.Name,
-		Target: workspaceDTO.Target,
-		ApiKey: workspaceDTO.ApiKey,
+		Id:        workspaceDTO.Id,
+		Name:      workspaceDTO.Name,
+		Target:    workspaceDTO.Target,
+		ApiKey:    workspaceDTO.ApiKey,
+		ExpiresAt: workspaceDTO.ExpiresAt,
+		Status:    status,
+		LastError: workspaceDTO.LastError,
 	}
 
 	for _, projectDTO := range workspaceDTO.Projects {
@@ -7,20 +7,27 @@ import (
 	"gorm.io/gorm"
 
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 )
 
 type GitProviderConfigStore struct {
-	db *gorm.DB
+	db        *gorm.DB
+	encryptor *encryption.Encryptor
 }
 
-func NewGitProviderConfigStore(db *gorm.DB) (*GitProviderConfigStore, error) {
+func NewGitProviderConfigStore(db *gorm.DB, encryptor *encryption.Encryptor) (*GitProviderConfigStore, error) {
 	err := db.AutoMigrate(&GitProviderConfigDTO{})
 	if err != nil {
 		return nil, err
 	}
 
-	return &GitProviderConfigStore{db: db}, nil
+	err = encryptPlaintextSecrets(db, gitProviderConfigSecrets, encryptor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GitProviderConfigStore{db: db, encryptor: encryptor}, nil
 }
 
 func (p *GitProviderConfigStore) List() ([]*gitprovider.GitProviderConfig, error) {
@@ -32,6 +39,10 @@ func (p *GitProviderConfigStore) List() ([]*gitprovider.GitProviderConfig, error
 
 	gitProviders := []*gitprovider.GitProviderConfig{}
 	for _, gitProviderDTO := range gitProviderDTOs {
+		err := decryptSecrets(gitProviderConfigSecrets(&gitProviderDTO), p.encryptor)
+		if err != nil {
+			return nil, err
+		}
 		gitProvider := ToGitProviderConfig(gitProviderDTO)
 		gitProviders = append(gitProviders, &gitProvider)
 	}
@@ -49,6 +60,11 @@ func (p *GitProviderConfigStore) Find(id string) (*gitprovider.GitProviderConfig
 		return nil, tx.Error
 	}
 
+	err := decryptSecrets(gitProviderConfigSecrets(&gitProviderDTO), p.encryptor)
+	if err != nil {
+		return nil, err
+	}
+
 	gitProvider := ToGitProviderConfig(gitProviderDTO)
 
 	return &gitProvider, nil
@@ -56,6 +72,18 @@ func (p *GitProviderConfigStore) Find(id string) (*gitprovider.GitProviderConfig
 
 func (p *GitProviderConfigStore) Save(gitProvider *gitprovider.GitProviderConfig) error {
 	gitProviderDTO := ToGitProviderConfigDTO(*gitProvider)
+
+	// The DTO shares the signing key with the config, so it is copied before it is encrypted in place
+	if gitProviderDTO.SigningKey != nil {
+		signingKey := *gitProviderDTO.SigningKey
+		gitProviderDTO.SigningKey = &signingKey
+	}
+
+	err := encryptSecrets(gitProviderConfigSecrets(&gitProviderDTO), p.encryptor)
+	if err != nil {
+		return err
+	}
+
 	tx := p.db.Save(&gitProviderDTO)
 	if tx.Error != nil {
 		return tx.Error
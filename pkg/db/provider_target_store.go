@@ -7,20 +7,27 @@ import (
 	"gorm.io/gorm"
 
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 	"github.com/daytonaio/daytona/pkg/provider"
 )
 
 type ProviderTargetStore struct {
-	db *gorm.DB
+	db        *gorm.DB
+	encryptor *encryption.Encryptor
 }
 
-func NewProviderTargetStore(db *gorm.DB) (*ProviderTargetStore, error) {
+func NewProviderTargetStore(db *gorm.DB, encryptor *encryption.Encryptor) (*ProviderTargetStore, error) {
 	err := db.AutoMigrate(&ProviderTargetDTO{})
 	if err != nil {
 		return nil, err
 	}
 
-	return &ProviderTargetStore{db: db}, nil
+	err = encryptPlaintextSecrets(db, providerTargetSecrets, encryptor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProviderTargetStore{db: db, encryptor: encryptor}, nil
 }
 
 func (s *ProviderTargetStore) List(filter *provider.TargetFilter) ([]*provider.ProviderTarget, error) {
@@ -33,6 +40,10 @@ func (s *ProviderTargetStore) List(filter *provider.TargetFilter) ([]*provider.P
 
 	targets := []*provider.ProviderTarget{}
 	for _, targetDTO := range targetDTOs {
+		err := decryptSecrets(providerTargetSecrets(&targetDTO), s.encryptor)
+		if err != nil {
+			return nil, err
+		}
 		targets = append(targets, ToProviderTarget(targetDTO))
 	}
 
@@ -50,11 +61,22 @@ func (s *ProviderTargetStore) Find(filter *provider.TargetFilter) (*provider.Pro
 		return nil, tx.Error
 	}
 
+	err := decryptSecrets(providerTargetSecrets(&targetDTO), s.encryptor)
+	if err != nil {
+		return nil, err
+	}
+
 	return ToProviderTarget(targetDTO), nil
 }
 
 func (s *ProviderTargetStore) Save(target *provider.ProviderTarget) error {
-	tx := s.db.Save(ToProviderTargetDTO(target))
+	targetDTO := ToProviderTargetDTO(target)
+	err := encryptSecrets(providerTargetSecrets(&targetDTO), s.encryptor)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Save(targetDTO)
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -82,6 +104,9 @@ func processTargetFilters(tx *gorm.DB, filter *provider.TargetFilter) *gorm.DB {
 		if filter.Default != nil {
 			tx = tx.Where("is_default = ?", *filter.Default)
 		}
+		if filter.Template != nil {
+			tx = tx.Where("is_template = ?", *filter.Template)
+		}
 	}
 
 	return tx
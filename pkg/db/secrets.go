@@ -0,0 +1,141 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+)
+
+// RotateMasterKey re-encrypts the data keys of all secrets stored in the DB with the new master key.
+// Secrets that are still stored in plaintext are encrypted with the new master key
+func RotateMasterKey(db *gorm.DB, currentEncryptor, newEncryptor *encryption.Encryptor) error {
+	rewrap := func(value string) (string, error) {
+		return currentEncryptor.Rewrap(value, newEncryptor)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := updateSecrets(tx, providerTargetSecrets, rewrap)
+		if err != nil {
+			return fmt.Errorf("failed to rotate target secrets: %w", err)
+		}
+
+		err = updateSecrets(tx, gitProviderConfigSecrets, rewrap)
+		if err != nil {
+			return fmt.Errorf("failed to rotate git provider secrets: %w", err)
+		}
+
+		err = updateSecrets(tx, containerRegistrySecrets, rewrap)
+		if err != nil {
+			return fmt.Errorf("failed to rotate container registry secrets: %w", err)
+		}
+
+		err = updateSecrets(tx, webhookSecrets, rewrap)
+		if err != nil {
+			return fmt.Errorf("failed to rotate webhook secrets: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// encryptPlaintextSecrets encrypts the secrets that were stored before encryption at rest was introduced
+func encryptPlaintextSecrets[T any](db *gorm.DB, getSecrets func(*T) []*string, encryptor *encryption.Encryptor) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		return updateSecrets(tx, getSecrets, func(value string) (string, error) {
+			if encryption.IsEncrypted(value) {
+				return value, nil
+			}
+			return encryptor.Encrypt(value)
+		})
+	})
+}
+
+// updateSecrets applies the update to the secrets of every row and saves the rows that changed
+func updateSecrets[T any](tx *gorm.DB, getSecrets func(*T) []*string, update func(string) (string, error)) error {
+	rows := []T{}
+	err := tx.Find(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	for i := range rows {
+		changed := false
+		for _, secret := range getSecrets(&rows[i]) {
+			if *secret == "" {
+				continue
+			}
+
+			value, err := update(*secret)
+			if err != nil {
+				return err
+			}
+
+			if value != *secret {
+				*secret = value
+				changed = true
+			}
+		}
+
+		if changed {
+			err := tx.Save(&rows[i]).Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// decryptSecrets decrypts the secrets of a DTO in place
+func decryptSecrets(secrets []*string, encryptor *encryption.Encryptor) error {
+	for _, secret := range secrets {
+		value, err := encryptor.Decrypt(*secret)
+		if err != nil {
+			return err
+		}
+		*secret = value
+	}
+
+	return nil
+}
+
+// encryptSecrets encrypts the secrets of a DTO in place
+func encryptSecrets(secrets []*string, encryptor *encryption.Encryptor) error {
+	for _, secret := range secrets {
+		value, err := encryptor.Encrypt(*secret)
+		if err != nil {
+			return err
+		}
+		*secret = value
+	}
+
+	return nil
+}
+
+func providerTargetSecrets(targetDTO *ProviderTargetDTO) []*string {
+	return []*string{&targetDTO.Options}
+}
+
+func gitProviderConfigSecrets(gitProviderDTO *GitProviderConfigDTO) []*string {
+	secrets := []*string{&gitProviderDTO.Token}
+	if gitProviderDTO.SigningKey != nil {
+		secrets = append(secrets, gitProviderDTO.SigningKey)
+	}
+
+	return secrets
+}
+
+func containerRegistrySecrets(containerRegistryDTO *ContainerRegistryDTO) []*string {
+	return []*string{&containerRegistryDTO.Password}
+}
+
+func webhookSecrets(webhookDTO *WebhookDTO) []*string {
+	return []*string{&webhookDTO.Secret}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+	"gorm.io/gorm"
+)
+
+type SnapshotStore struct {
+	db *gorm.DB
+}
+
+func NewSnapshotStore(db *gorm.DB) (*SnapshotStore, error) {
+	err := db.AutoMigrate(&SnapshotDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotStore{db: db}, nil
+}
+
+func (s *SnapshotStore) List(filter *snapshot.SnapshotFilter) ([]*snapshot.Snapshot, error) {
+	snapshotDTOs := []SnapshotDTO{}
+	tx := processSnapshotFilters(s.db, filter).Order("created_at desc").Find(&snapshotDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	snapshots := []*snapshot.Snapshot{}
+	for _, snapshotDTO := range snapshotDTOs {
+		snapshots = append(snapshots, ToSnapshot(snapshotDTO))
+	}
+
+	return snapshots, nil
+}
+
+func (s *SnapshotStore) Find(id string) (*snapshot.Snapshot, error) {
+	snapshotDTO := SnapshotDTO{}
+	tx := s.db.Where("id = ?", id).First(&snapshotDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, snapshot.ErrSnapshotNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToSnapshot(snapshotDTO), nil
+}
+
+func (s *SnapshotStore) Save(snap *snapshot.Snapshot) error {
+	snapshotDTO := ToSnapshotDTO(snap)
+	tx := s.db.Save(&snapshotDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processSnapshotFilters(tx *gorm.DB, filter *snapshot.SnapshotFilter) *gorm.DB {
+	if filter != nil {
+		if filter.WorkspaceId != nil {
+			tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+		}
+		if filter.ProjectName != nil {
+			tx = tx.Where("project_name = ?", *filter.ProjectName)
+		}
+	}
+
+	return tx
+}
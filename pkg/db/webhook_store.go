@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"gorm.io/gorm"
+)
+
+type WebhookStore struct {
+	db        *gorm.DB
+	encryptor *encryption.Encryptor
+}
+
+func NewWebhookStore(db *gorm.DB, encryptor *encryption.Encryptor) (*WebhookStore, error) {
+	err := db.AutoMigrate(&WebhookDTO{}, &WebhookDeliveryDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = encryptPlaintextSecrets(db, webhookSecrets, encryptor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookStore{db: db, encryptor: encryptor}, nil
+}
+
+func (s *WebhookStore) List() ([]*webhook.Webhook, error) {
+	webhookDTOs := []WebhookDTO{}
+	tx := s.db.Order("created_at").Find(&webhookDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	webhooks := []*webhook.Webhook{}
+	for _, webhookDTO := range webhookDTOs {
+		err := decryptSecrets(webhookSecrets(&webhookDTO), s.encryptor)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, ToWebhook(webhookDTO))
+	}
+
+	return webhooks, nil
+}
+
+func (s *WebhookStore) Find(id string) (*webhook.Webhook, error) {
+	webhookDTO := WebhookDTO{}
+	tx := s.db.Where("id = ?", id).First(&webhookDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, tx.Error
+	}
+
+	err := decryptSecrets(webhookSecrets(&webhookDTO), s.encryptor)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToWebhook(webhookDTO), nil
+}
+
+func (s *WebhookStore) Save(w *webhook.Webhook) error {
+	webhookDTO := ToWebhookDTO(w)
+	err := encryptSecrets(webhookSecrets(&webhookDTO), s.encryptor)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Save(&webhookDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) Delete(w *webhook.Webhook) error {
+	tx := s.db.Where("id = ?", w.Id).Delete(&WebhookDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) ListDeliveries(webhookId string) ([]*webhook.Delivery, error) {
+	deliveryDTOs := []WebhookDeliveryDTO{}
+	tx := s.db.Where("webhook_id = ?", webhookId).Order("created_at desc").Find(&deliveryDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	deliveries := []*webhook.Delivery{}
+	for _, deliveryDTO := range deliveryDTOs {
+		deliveries = append(deliveries, ToWebhookDelivery(deliveryDTO))
+	}
+
+	return deliveries, nil
+}
+
+func (s *WebhookStore) ListPendingDeliveries() ([]*webhook.Delivery, error) {
+	deliveryDTOs := []WebhookDeliveryDTO{}
+	tx := s.db.Where("status = ?", webhook.DeliveryStatusPending).Order("created_at").Find(&deliveryDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	deliveries := []*webhook.Delivery{}
+	for _, deliveryDTO := range deliveryDTOs {
+		deliveries = append(deliveries, ToWebhookDelivery(deliveryDTO))
+	}
+
+	return deliveries, nil
+}
+
+func (s *WebhookStore) SaveDelivery(delivery *webhook.Delivery) error {
+	deliveryDTO := ToWebhookDeliveryDTO(delivery)
+	tx := s.db.Save(&deliveryDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) DeleteDeliveries(webhookId string) error {
+	tx := s.db.Where("webhook_id = ?", webhookId).Delete(&WebhookDeliveryDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) PruneDeliveries(webhookId string, keep int) error {
+	recentDeliveryIds := s.db.Model(&WebhookDeliveryDTO{}).Select("id").Where("webhook_id = ?", webhookId).Order("created_at desc").Limit(keep)
+
+	tx := s.db.Where("webhook_id = ? AND status != ? AND id NOT IN (?)", webhookId, webhook.DeliveryStatusPending, recentDeliveryIds).Delete(&WebhookDeliveryDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/moby/patternmatcher"
+	"github.com/moby/patternmatcher/ignorefile"
+)
+
+type BuildImageOptions struct {
+	// Directory sent to the Docker daemon as the build context
+	ContextDir string
+	// Path of the Dockerfile relative to the build context
+	Dockerfile string
+	// Content of a generated Dockerfile. If set, it is added to the build context at the Dockerfile path
+	DockerfileContent *string
+	BuildArgs         map[string]string
+	// Stage of a multi-stage Dockerfile to build. The last stage is built if empty
+	Target    string
+	Tag       string
+	LogWriter io.Writer
+	// Reads the build context from the remote Docker host over SSH if set
+	SshClient *ssh.Client
+}
+
+// BuildImage builds an image from a Dockerfile and returns the user the image runs as.
+// Files matched by the .dockerignore file of the build context are not sent to the Docker daemon
+func (d *DockerClient) BuildImage(ctx context.Context, opts BuildImageOptions) (string, error) {
+	logWriter := opts.LogWriter
+	if logWriter == nil {
+		logWriter = io.Discard
+	}
+
+	excludes, err := readDockerignore(opts.ContextDir, opts.Dockerfile, opts.SshClient)
+	if err != nil {
+		return "", err
+	}
+
+	buildContext, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeBuildContext(writer, opts, excludes))
+	}()
+	defer buildContext.Close()
+
+	buildArgs := map[string]*string{}
+	for key, value := range opts.BuildArgs {
+		buildArgs[key] = &value
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Building image from %s...\n", opts.Dockerfile)))
+
+	res, err := d.apiClient.ImageBuild(ctx, buildContext, types.ImageBuildOptions{
+		Dockerfile:  filepath.ToSlash(opts.Dockerfile),
+		Tags:        []string{opts.Tag},
+		BuildArgs:   buildArgs,
+		Target:      opts.Target,
+		Remove:      true,
+		ForceRemove: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	err = jsonmessage.DisplayJSONMessagesStream(res.Body, logWriter, 0, false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	logWriter.Write([]byte("Image built successfully\n"))
+
+	image, _, err := d.apiClient.ImageInspectWithRaw(ctx, opts.Tag)
+	if err != nil {
+		return "", err
+	}
+
+	if image.Config == nil || image.Config.User == "" {
+		return "root", nil
+	}
+
+	return image.Config.User, nil
+}
+
+// readDockerignore returns a matcher for the .dockerignore file of the build context or nil if there is none.
+// Like the Docker CLI, the Dockerfile and the .dockerignore file are always sent to the Docker daemon
+func readDockerignore(contextDir, dockerfile string, sshClient *ssh.Client) (*patternmatcher.PatternMatcher, error) {
+	var content []byte
+	if sshClient != nil {
+		var err error
+		content, err = sshClient.ReadFile(path.Join(contextDir, ".dockerignore"))
+		if err != nil {
+			// The file does not exist if it can not be read
+			return nil, nil
+		}
+	} else {
+		var err error
+		content, err = os.ReadFile(filepath.Join(contextDir, ".dockerignore"))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+
+	patterns, err := ignorefile.ReadAll(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	patterns = append(patterns, "!.dockerignore", "!"+filepath.ToSlash(filepath.Clean(dockerfile)))
+
+	return patternmatcher.New(patterns)
+}
+
+func writeBuildContext(w io.Writer, opts BuildImageOptions, excludes *patternmatcher.PatternMatcher) error {
+	tarWriter := tar.NewWriter(w)
+
+	var err error
+	if opts.SshClient != nil {
+		err = writeRemoteFiles(tarWriter, opts, excludes)
+	} else {
+		err = writeLocalFiles(tarWriter, opts, excludes)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.DockerfileContent != nil {
+		err = tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     filepath.ToSlash(opts.Dockerfile),
+			Mode:     0644,
+			Size:     int64(len(*opts.DockerfileContent)),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write([]byte(*opts.DockerfileContent))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tarWriter.Close()
+}
+
+// isSkipped returns true if the file is not sent to the Docker daemon
+func isSkipped(relPath string, opts BuildImageOptions, excludes *patternmatcher.PatternMatcher) (bool, error) {
+	// The generated Dockerfile replaces the file of the build context
+	if opts.DockerfileContent != nil && relPath == filepath.Clean(opts.Dockerfile) {
+		return true, nil
+	}
+
+	if excludes == nil {
+		return false, nil
+	}
+
+	return excludes.MatchesOrParentMatches(relPath)
+}
+
+func writeLocalFiles(tarWriter *tar.Writer, opts BuildImageOptions, excludes *patternmatcher.PatternMatcher) error {
+	return filepath.WalkDir(opts.ContextDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(opts.ContextDir, path)
+		if err != nil {
+			return err
+		}
+
+		if relPath == "." {
+			return nil
+		}
+
+		skipped, err := isSkipped(relPath, opts, excludes)
+		if err != nil {
+			return err
+		}
+
+		if skipped {
+			// Files of an excluded directory can be included again by an exclusion pattern
+			if entry.IsDir() && excludes != nil && !excludes.Exclusions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tarWriter, file)
+		return err
+	})
+}
+
+// writeRemoteFiles archives the build context on the remote Docker host and copies the files that are not excluded
+func writeRemoteFiles(tarWriter *tar.Writer, opts BuildImageOptions, excludes *patternmatcher.PatternMatcher) error {
+	session, err := opts.SshClient.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+
+	err = session.Start(fmt.Sprintf("tar -C %s -cf - .", shellQuote(opts.ContextDir)))
+	if err != nil {
+		return err
+	}
+
+	tarReader := tar.NewReader(stdout)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		relPath := path.Clean(header.Name)
+		if relPath == "." {
+			continue
+		}
+
+		skipped, err := isSkipped(filepath.FromSlash(relPath), opts, excludes)
+		if err != nil {
+			return err
+		}
+
+		if skipped {
+			continue
+		}
+
+		header.Name = relPath
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(tarWriter, tarReader)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = session.Wait()
+	if err != nil {
+		return fmt.Errorf("failed to archive the build context: %w: %s", err, stderr.String())
+	}
+
+	return nil
+}
+
+// shellQuote quotes the value so that it is passed to the remote shell as a single argument
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
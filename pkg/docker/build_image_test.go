@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func writeContextFiles(t *testing.T, contextDir string, files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(contextDir, name)
+
+		err := os.MkdirAll(filepath.Dir(filePath), 0755)
+		require.NoError(t, err)
+
+		err = os.WriteFile(filePath, []byte(content), 0644)
+		require.NoError(t, err)
+	}
+}
+
+// mockImageBuild returns the regular files of the build context sent to the Docker daemon
+func (s *DockerClientTestSuite) mockImageBuild(tag string) map[string]string {
+	files := map[string]string{}
+
+	s.mockClient.On("ImageBuild", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		tarReader := tar.NewReader(args.Get(1).(io.Reader))
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			s.Require().NoError(err)
+
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+
+			content, err := io.ReadAll(tarReader)
+			s.Require().NoError(err)
+			files[header.Name] = string(content)
+		}
+	}).Return(types.ImageBuildResponse{
+		Body: io.NopCloser(strings.NewReader("")),
+	}, nil)
+
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, tag).Return(types.ImageInspect{
+		Config: &container.Config{
+			User: "daytona",
+		},
+	}, nil)
+
+	return files
+}
+
+func (s *DockerClientTestSuite) TestBuildImageExcludesDockerignoreFiles() {
+	contextDir := s.T().TempDir()
+	writeContextFiles(s.T(), contextDir, map[string]string{
+		".dockerignore":            "# Comment\nDockerfile\nnode_modules\n**/*.log\n!keep.log\n/secret\n",
+		"Dockerfile":               "FROM test",
+		"main.go":                  "main",
+		"app.log":                  "log",
+		"keep.log":                 "log",
+		"secret/key":               "key",
+		"node_modules/pkg/main.js": "module",
+		"src/main.go":              "main",
+		"src/nested/trace.log":     "log",
+	})
+
+	files := s.mockImageBuild("test-image")
+
+	user, err := s.dockerClient.BuildImage(context.Background(), docker.BuildImageOptions{
+		ContextDir: contextDir,
+		Dockerfile: "Dockerfile",
+		Tag:        "test-image",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("daytona", user)
+
+	// The Dockerfile and .dockerignore file are sent even if they are excluded
+	s.Require().Equal(map[string]string{
+		".dockerignore": "# Comment\nDockerfile\nnode_modules\n**/*.log\n!keep.log\n/secret\n",
+		"Dockerfile":    "FROM test",
+		"main.go":       "main",
+		"keep.log":      "log",
+		"src/main.go":   "main",
+	}, files)
+}
+
+func (s *DockerClientTestSuite) TestBuildImageWithGeneratedDockerfile() {
+	contextDir := s.T().TempDir()
+	writeContextFiles(s.T(), contextDir, map[string]string{
+		".daytona.Dockerfile": "FROM old",
+		"go.mod":              "module test",
+	})
+
+	files := s.mockImageBuild("test-image")
+
+	dockerfileContent := "FROM test"
+	_, err := s.dockerClient.BuildImage(context.Background(), docker.BuildImageOptions{
+		ContextDir:        contextDir,
+		Dockerfile:        ".daytona.Dockerfile",
+		DockerfileContent: &dockerfileContent,
+		Tag:               "test-image",
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(map[string]string{
+		".daytona.Dockerfile": "FROM test",
+		"go.mod":              "module test",
+	}, files)
+}
+
+func TestGetDockerfileBuildPaths(t *testing.T) {
+	projectDir := filepath.Join(t.TempDir(), "project")
+
+	contextDir, dockerfile, err := docker.GetDockerfileBuildPaths(projectDir, &buildconfig.DockerfileConfig{
+		FilePath: "Dockerfile",
+	})
+	require.NoError(t, err)
+	require.Equal(t, projectDir, contextDir)
+	require.Equal(t, "Dockerfile", dockerfile)
+
+	contextDir, dockerfile, err = docker.GetDockerfileBuildPaths(projectDir, &buildconfig.DockerfileConfig{
+		FilePath: "docker/app/Dockerfile",
+		Context:  "docker",
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(projectDir, "docker"), contextDir)
+	require.Equal(t, filepath.Join("app", "Dockerfile"), dockerfile)
+
+	for _, config := range []buildconfig.DockerfileConfig{
+		{FilePath: "Dockerfile", Context: ".."},
+		{FilePath: "Dockerfile", Context: "docker/../../other"},
+		{FilePath: "../Dockerfile"},
+		{FilePath: "."},
+	} {
+		_, _, err := docker.GetDockerfileBuildPaths(projectDir, &config)
+		require.Error(t, err, "context %q, file path %q", config.Context, config.FilePath)
+	}
+}
@@ -48,10 +48,12 @@ type IDockerClient interface {
 	ExecSync(containerID string, config container.ExecOptions, outputWriter io.Writer) (*ExecResult, error)
 	GetContainerLogs(containerName string, logWriter io.Writer) error
 	PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
-	PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
+	PushImage(ctx context.Context, imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
 	DeleteImage(imageName string, force bool, logWriter io.Writer) error
+	SnapshotProject(opts SnapshotProjectOptions) error
 
-	CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error)
+	CreateFromDevcontainer(ctx context.Context, opts CreateDevcontainerOptions) (string, RemoteUser, error)
+	BuildImage(ctx context.Context, opts BuildImageOptions) (string, error)
 	RemoveContainer(containerName string) error
 }
 
@@ -40,6 +40,11 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 	// This is only an optimisation for images with tag 'latest'
 	pulledImages := map[string]bool{}
 
+	// Projects restored from a snapshot run the snapshot image which already contains the project directory
+	if opts.Project.SnapshotId != nil {
+		return d.createProjectFromImage(opts, pulledImages, false)
+	}
+
 	if opts.Project.BuildConfig != nil {
 		err := d.PullImage(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
 		if err != nil {
@@ -59,8 +64,12 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 
 		switch builderType {
 		case detect.BuilderTypeDevcontainer:
-			_, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
+			_, _, err := d.CreateFromDevcontainer(context.Background(), d.toCreateDevcontainerOptions(opts, true))
 			return err
+		case detect.BuilderTypeDockerfile:
+			return d.createProjectFromDockerfile(opts, pulledImages)
+		case detect.BuilderTypeLanguage:
+			return d.createProjectFromLanguage(opts, pulledImages)
 		case detect.BuilderTypeImage:
 			return d.createProjectFromImage(opts, pulledImages, true)
 		default:
@@ -54,7 +54,7 @@ type CreateDevcontainerOptions struct {
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
 }
 
-func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error) {
+func (d *DockerClient) CreateFromDevcontainer(ctx context.Context, opts CreateDevcontainerOptions) (string, RemoteUser, error) {
 	// Ensure that the devcontainer config exists
 	if opts.SshClient != nil {
 		_, err := opts.SshClient.ReadFile(path.Join(opts.ProjectDir, opts.BuildConfig.Devcontainer.FilePath))
@@ -68,13 +68,11 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		}
 	}
 
-	socketForwardId, err := d.ensureDockerSockForward(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
+	socketForwardId, err := d.ensureDockerSockForward(ctx, opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
 	if err != nil {
 		return "", "", err
 	}
 
-	ctx := context.Background()
-
 	paths := d.getDevcontainerPaths(opts.ProjectDir, opts.BuildConfig.Devcontainer.FilePath)
 
 	if opts.SshClient != nil {
@@ -89,13 +87,13 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		}
 	}
 
-	rawConfig, config, err := d.readDevcontainerConfig(&opts, paths, socketForwardId)
+	rawConfig, config, err := d.readDevcontainerConfig(ctx, &opts, paths, socketForwardId)
 	if err != nil {
 		return "", "", err
 	}
 
 	if opts.Prebuild {
-		err = d.runInitializeCommand(opts.ProjectDir, config.MergedConfiguration.InitializeCommand, opts.LogWriter, opts.SshClient)
+		err = d.runInitializeCommand(ctx, opts.ProjectDir, config.MergedConfiguration.InitializeCommand, opts.LogWriter, opts.SshClient)
 		if err != nil {
 			return "", "", err
 		}
@@ -150,7 +148,7 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 			if opts.SshClient != nil {
 				composeFilePath = path.Join(paths.ProjectTarget, filepath.Dir(opts.BuildConfig.Devcontainer.FilePath), composeFilePath)
 
-				composeFileContent, err := d.getRemoteComposeContent(&opts, paths, socketForwardId, composeFilePath)
+				composeFileContent, err := d.getRemoteComposeContent(ctx, &opts, paths, socketForwardId, composeFilePath)
 				if err != nil {
 					return "", err
 				}
@@ -292,7 +290,7 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		devcontainerCmd = append(devcontainerCmd, "--prebuild")
 	}
 
-	output, err := d.execDevcontainerCommand(strings.Join(devcontainerCmd, " "), &opts, paths, paths.ProjectTarget, socketForwardId, true, []mount.Mount{
+	output, err := d.execDevcontainerCommand(ctx, strings.Join(devcontainerCmd, " "), &opts, paths, paths.ProjectTarget, socketForwardId, true, []mount.Mount{
 		{
 			Type:   mount.TypeBind,
 			Source: paths.OverridesDir,
@@ -323,9 +321,7 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 	return result.ContainerId, RemoteUser(result.RemoteUser), nil
 }
 
-func (d *DockerClient) ensureDockerSockForward(builderImage string, builderContainerRegistry *containerregistry.ContainerRegistry, logWriter io.Writer) (string, error) {
-	ctx := context.Background()
-
+func (d *DockerClient) ensureDockerSockForward(ctx context.Context, builderImage string, builderContainerRegistry *containerregistry.ContainerRegistry, logWriter io.Writer) (string, error) {
 	containers, err := d.apiClient.ContainerList(ctx, container.ListOptions{
 		Filters: filters.NewArgs(filters.Arg("name", dockerSockForwardContainer)),
 		All:     true,
@@ -375,7 +371,7 @@ func (d *DockerClient) ensureDockerSockForward(builderImage string, builderConta
 	return c.ID, d.apiClient.ContainerStart(ctx, dockerSockForwardContainer, container.StartOptions{})
 }
 
-func (d *DockerClient) readDevcontainerConfig(opts *CreateDevcontainerOptions, paths DevcontainerPaths, socketForwardId string) (string, *devcontainer.Root, error) {
+func (d *DockerClient) readDevcontainerConfig(ctx context.Context, opts *CreateDevcontainerOptions, paths DevcontainerPaths, socketForwardId string) (string, *devcontainer.Root, error) {
 	opts.LogWriter.Write([]byte("Reading devcontainer configuration...\n"))
 
 	// Sleep is there to make sure the logs get read
@@ -383,7 +379,7 @@ func (d *DockerClient) readDevcontainerConfig(opts *CreateDevcontainerOptions, p
 
 	// We need to override localEnvs to the host env variables
 	// FIXME: This will not work for features that require localEnv
-	configEnvOverride, err := d.execDevcontainerCommand(strings.Join(cmd, " "), opts, paths, paths.ProjectTarget, socketForwardId, false, nil)
+	configEnvOverride, err := d.execDevcontainerCommand(ctx, strings.Join(cmd, " "), opts, paths, paths.ProjectTarget, socketForwardId, false, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -422,7 +418,7 @@ func (d *DockerClient) readDevcontainerConfig(opts *CreateDevcontainerOptions, p
 		"1",
 	}...)
 
-	output, err := d.execDevcontainerCommand(strings.Join(devcontainerCmd, " "), opts, paths, paths.ProjectTarget, socketForwardId, false, []mount.Mount{
+	output, err := d.execDevcontainerCommand(ctx, strings.Join(devcontainerCmd, " "), opts, paths, paths.ProjectTarget, socketForwardId, false, []mount.Mount{
 		{
 			Type:   mount.TypeBind,
 			Source: paths.OverridesDir,
@@ -449,7 +445,7 @@ func (d *DockerClient) readDevcontainerConfig(opts *CreateDevcontainerOptions, p
 	return rawConfig, &rootConfig, nil
 }
 
-func (d *DockerClient) runInitializeCommand(projectDir string, initializeCommand devcontainer.Command, logWriter io.Writer, sshClient *ssh.Client) error {
+func (d *DockerClient) runInitializeCommand(ctx context.Context, projectDir string, initializeCommand devcontainer.Command, logWriter io.Writer, sshClient *ssh.Client) error {
 	if initializeCommand == nil {
 		return nil
 	}
@@ -459,7 +455,7 @@ func (d *DockerClient) runInitializeCommand(projectDir string, initializeCommand
 	switch initializeCommand := initializeCommand.(type) {
 	case string:
 		cmd := []string{"sh", "-c", initializeCommand}
-		return execDevcontainerCommand(projectDir, cmd, logWriter, sshClient)
+		return execDevcontainerCommand(ctx, projectDir, cmd, logWriter, sshClient)
 	case []interface{}:
 		var commandArray []string
 		for _, arg := range initializeCommand {
@@ -469,7 +465,7 @@ func (d *DockerClient) runInitializeCommand(projectDir string, initializeCommand
 			}
 			commandArray = append(commandArray, argString)
 		}
-		return execDevcontainerCommand(projectDir, commandArray, logWriter, sshClient)
+		return execDevcontainerCommand(ctx, projectDir, commandArray, logWriter, sshClient)
 	case map[string]interface{}:
 		commands := map[string][]string{}
 		for name, command := range initializeCommand {
@@ -492,7 +488,7 @@ func (d *DockerClient) runInitializeCommand(projectDir string, initializeCommand
 		for name, command := range commands {
 			go func() {
 				logWriter.Write([]byte(fmt.Sprintf("Running %s\n", name)))
-				err := execDevcontainerCommand(projectDir, command, logWriter, sshClient)
+				err := execDevcontainerCommand(ctx, projectDir, command, logWriter, sshClient)
 				if err != nil {
 					logWriter.Write([]byte(fmt.Sprintf("Error running %s: %v\n", name, err)))
 					errChan <- err
@@ -520,9 +516,7 @@ func (d *DockerClient) runInitializeCommand(projectDir string, initializeCommand
 	return fmt.Errorf("invalid command type: %v", initializeCommand)
 }
 
-func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontainerOptions, paths DevcontainerPaths, workdir, socketForwardId string, writeOutput bool, extraMounts []mount.Mount) (string, error) {
-	ctx := context.Background()
-
+func (d *DockerClient) execDevcontainerCommand(ctx context.Context, cmd string, opts *CreateDevcontainerOptions, paths DevcontainerPaths, workdir, socketForwardId string, writeOutput bool, extraMounts []mount.Mount) (string, error) {
 	mounts := []mount.Mount{
 		{
 			Type:   mount.TypeBind,
@@ -600,12 +594,12 @@ func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontai
 	return output, nil
 }
 
-func (d *DockerClient) getRemoteComposeContent(opts *CreateDevcontainerOptions, paths DevcontainerPaths, socketForwardId, composePath string) (string, error) {
+func (d *DockerClient) getRemoteComposeContent(ctx context.Context, opts *CreateDevcontainerOptions, paths DevcontainerPaths, socketForwardId, composePath string) (string, error) {
 	if opts.SshClient == nil {
 		return "", nil
 	}
 
-	output, err := d.execDevcontainerCommand(fmt.Sprintf("docker compose -f %s config", composePath), opts, paths, filepath.Dir(composePath), socketForwardId, false, nil)
+	output, err := d.execDevcontainerCommand(ctx, fmt.Sprintf("docker compose -f %s config", composePath), opts, paths, filepath.Dir(composePath), socketForwardId, false, nil)
 	if err != nil {
 		return "", err
 	}
@@ -652,7 +646,7 @@ func (d *DockerClient) getHostEnvVars(sshClient *ssh.Client) (map[string]string,
 	return envMap, nil
 }
 
-func execDevcontainerCommand(projectDir string, command []string, logWriter io.Writer, sshClient *ssh.Client) error {
+func execDevcontainerCommand(ctx context.Context, projectDir string, command []string, logWriter io.Writer, sshClient *ssh.Client) error {
 	if sshClient != nil {
 		if command[0] == "sh" {
 			cmd := fmt.Sprintf(`sh -c "cd %s && %s"`, projectDir, strings.Join(command[2:], " "))
@@ -661,7 +655,7 @@ func execDevcontainerCommand(projectDir string, command []string, logWriter io.W
 		return sshClient.Exec(strings.Join(command, " "), logWriter)
 	}
 
-	cmd := exec.Command(command[0], command[1:]...)
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
 	cmd.Stdout = logWriter
 	cmd.Stderr = logWriter
 	cmd.Env = os.Environ()
@@ -0,0 +1,138 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/build/language"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+)
+
+// createProjectFromDockerfile creates the project container from the image built from the project Dockerfile
+func (d *DockerClient) createProjectFromDockerfile(opts *CreateProjectOptions, pulledImages map[string]bool) error {
+	dockerfileConfig := opts.Project.BuildConfig.Dockerfile
+
+	contextDir, dockerfile, err := GetDockerfileBuildPaths(opts.ProjectDir, dockerfileConfig)
+	if err != nil {
+		return err
+	}
+
+	err = d.buildProjectImage(opts, pulledImages, BuildImageOptions{
+		ContextDir: contextDir,
+		Dockerfile: dockerfile,
+		BuildArgs:  dockerfileConfig.BuildArgs,
+		Target:     dockerfileConfig.Target,
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.createProjectFromImage(opts, pulledImages, true)
+}
+
+// createProjectFromLanguage creates the project container from an image that adds the toolchains
+// and dependencies of the project languages to the project image.
+// The project image is used as is if the image can not be built
+func (d *DockerClient) createProjectFromLanguage(opts *CreateProjectOptions, pulledImages map[string]bool) error {
+	languages := []language.Language{}
+	for _, l := range opts.Project.BuildConfig.Language.Languages {
+		languages = append(languages, language.Language(l))
+	}
+
+	dockerfileContent, err := language.GenerateDockerfile(languages, opts.Project.Image, opts.Project.User)
+	if err == nil {
+		err = d.buildProjectImage(opts, pulledImages, BuildImageOptions{
+			ContextDir:        opts.ProjectDir,
+			Dockerfile:        language.DOCKERFILE_NAME,
+			DockerfileContent: &dockerfileContent,
+		})
+	}
+	if err != nil && opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to build the image for the project languages: %s. Using the project image\n", err)))
+	}
+
+	return d.createProjectFromImage(opts, pulledImages, true)
+}
+
+// buildProjectImage sets the project image to an image built on the Docker host.
+// The image of a published build is used instead if available
+func (d *DockerClient) buildProjectImage(opts *CreateProjectOptions, pulledImages map[string]bool, buildOpts BuildImageOptions) error {
+	buildConfig := opts.Project.BuildConfig
+
+	if buildConfig.CachedBuild != nil {
+		opts.Project.Image = buildConfig.CachedBuild.Image
+		opts.Project.User = buildConfig.CachedBuild.User
+		return nil
+	}
+
+	imageName := getProjectImageName(opts.Project)
+
+	buildOpts.Tag = imageName
+	buildOpts.LogWriter = opts.LogWriter
+	buildOpts.SshClient = opts.SshClient
+
+	user, err := d.BuildImage(context.Background(), buildOpts)
+	if err != nil {
+		return err
+	}
+
+	opts.Project.Image = imageName
+	opts.Project.User = user
+	pulledImages[imageName] = true
+
+	return nil
+}
+
+// GetDockerfileBuildPaths returns the build context directory and the path of the Dockerfile relative to it.
+// Paths that point outside of the project directory are rejected
+func GetDockerfileBuildPaths(projectDir string, dockerfileConfig *buildconfig.DockerfileConfig) (string, string, error) {
+	contextDir := filepath.Join(projectDir, dockerfileConfig.Context)
+	if !isInDir(projectDir, contextDir) {
+		return "", "", fmt.Errorf("the Dockerfile context %s is outside of the project directory", dockerfileConfig.Context)
+	}
+
+	dockerfilePath := filepath.Join(projectDir, dockerfileConfig.FilePath)
+	if !isInDir(projectDir, dockerfilePath) || dockerfilePath == filepath.Clean(projectDir) {
+		return "", "", fmt.Errorf("the Dockerfile %s is outside of the project directory", dockerfileConfig.FilePath)
+	}
+
+	dockerfile, err := filepath.Rel(contextDir, dockerfilePath)
+	if err != nil {
+		return "", "", err
+	}
+
+	return contextDir, dockerfile, nil
+}
+
+func isInDir(dir, path string) bool {
+	relPath, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator)) && !filepath.IsAbs(relPath)
+}
+
+// getContainerUser returns the user the project container runs as
+func (d *DockerClient) getContainerUser(p *project.Project) (string, error) {
+	c, err := d.apiClient.ContainerInspect(context.Background(), d.GetProjectContainerName(p))
+	if err != nil {
+		return "", err
+	}
+
+	if c.Config == nil || c.Config.User == "" {
+		return "root", nil
+	}
+
+	return c.Config.User, nil
+}
+
+func getProjectImageName(p *project.Project) string {
+	return strings.ToLower(fmt.Sprintf("daytona-%s-%s:latest", p.WorkspaceId, p.Name))
+}
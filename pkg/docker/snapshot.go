@@ -0,0 +1,143 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+)
+
+type SnapshotProjectOptions struct {
+	Project   *project.Project
+	ImageName string
+	// Copy the project directory mounted into the container into the snapshot image
+	IncludeVolume     bool
+	ContainerRegistry *containerregistry.ContainerRegistry
+	LogWriter         io.Writer
+}
+
+// SnapshotProject commits the project container to an image and pushes it to the container registry
+func (d *DockerClient) SnapshotProject(opts SnapshotProjectOptions) error {
+	ctx := context.Background()
+
+	c, err := d.apiClient.ContainerInspect(ctx, d.GetProjectContainerName(opts.Project))
+	if err != nil {
+		return err
+	}
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Committing project %s to %s\n", opts.Project.Name, opts.ImageName)))
+	}
+
+	_, err = d.apiClient.ContainerCommit(ctx, c.ID, container.CommitOptions{
+		Reference: opts.ImageName,
+		// Stopped containers can be committed but not paused
+		Pause:   c.State != nil && c.State.Running,
+		Changes: getClearLabelChanges(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.IncludeVolume {
+		err = d.copyProjectMountsToImage(ctx, c, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.PushImage(ctx, opts.ImageName, opts.ContainerRegistry, opts.LogWriter)
+}
+
+// copyProjectMountsToImage copies the project directory, which is mounted into the container and
+// therefore not part of a commit, into the image by committing a container created from the image
+func (d *DockerClient) copyProjectMountsToImage(ctx context.Context, c types.ContainerJSON, opts SnapshotProjectOptions) error {
+	mounts := getProjectMounts(c, opts.Project.Name)
+	if len(mounts) == 0 {
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte("No project volume found, the snapshot only contains the container filesystem\n"))
+		}
+		return nil
+	}
+
+	snapshotContainer, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image: opts.ImageName,
+	}, nil, nil, nil, "")
+	if err != nil {
+		return err
+	}
+
+	defer d.RemoveContainer(snapshotContainer.ID) // nolint:errcheck
+
+	for _, m := range mounts {
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Copying %s into the snapshot\n", m.Destination)))
+		}
+
+		err = d.copyBetweenContainers(ctx, c.ID, snapshotContainer.ID, m.Destination)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = d.apiClient.ContainerCommit(ctx, snapshotContainer.ID, container.CommitOptions{
+		Reference: opts.ImageName,
+	})
+
+	return err
+}
+
+func (d *DockerClient) copyBetweenContainers(ctx context.Context, sourceId, destinationId, dirPath string) error {
+	content, _, err := d.apiClient.CopyFromContainer(ctx, sourceId, dirPath)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	return d.apiClient.CopyToContainer(ctx, destinationId, path.Dir(dirPath), content, container.CopyToContainerOptions{})
+}
+
+// getProjectMounts returns the mounts of the container that hold the project directory
+func getProjectMounts(c types.ContainerJSON, projectName string) []types.MountPoint {
+	mounts := []types.MountPoint{}
+	for _, m := range c.Mounts {
+		if path.Base(m.Destination) == projectName {
+			mounts = append(mounts, m)
+		}
+	}
+
+	return mounts
+}
+
+// getClearLabelChanges returns commit changes that clear the Daytona and devcontainer labels of the
+// container so that containers created from the snapshot are not mistaken for the snapshotted project
+func getClearLabelChanges(c types.ContainerJSON) []string {
+	if c.Config == nil {
+		return nil
+	}
+
+	labels := []string{}
+	for label := range c.Config.Labels {
+		if strings.HasPrefix(label, "daytona.") || strings.HasPrefix(label, "devcontainer.") {
+			labels = append(labels, fmt.Sprintf(`%s=""`, label))
+		}
+	}
+
+	if len(labels) == 0 {
+		return nil
+	}
+
+	sort.Strings(labels)
+
+	return []string{"LABEL " + strings.Join(labels, " ")}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"io"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestSnapshotProject() {
+	imageName := "registry.example.com/s-123:snapshot1"
+
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID: "container1",
+			State: &types.ContainerState{
+				Running: true,
+			},
+		},
+		Config: &container.Config{
+			Labels: map[string]string{
+				"daytona.workspace.id": project1.WorkspaceId,
+				"maintainer":           "daytona",
+			},
+		},
+		Mounts: []types.MountPoint{
+			{Destination: "/var/run/docker.sock"},
+			{Destination: "/home/test-user/test"},
+		},
+	}, nil)
+
+	s.mockClient.On("ContainerCommit", mock.Anything, "container1", container.CommitOptions{
+		Reference: imageName,
+		Pause:     true,
+		Changes:   []string{`LABEL daytona.workspace.id=""`},
+	}).Return(types.IDResponse{ID: "image1"}, nil)
+
+	s.mockClient.On("ContainerCreate", mock.Anything, &container.Config{Image: imageName}, mock.Anything, mock.Anything, mock.Anything, "").
+		Return(container.CreateResponse{ID: "snapshot-container"}, nil)
+	s.mockClient.On("CopyFromContainer", mock.Anything, "container1", "/home/test-user/test").
+		Return(io.NopCloser(strings.NewReader("")), container.PathStat{}, nil)
+	s.mockClient.On("CopyToContainer", mock.Anything, "snapshot-container", "/home/test-user", mock.Anything, container.CopyToContainerOptions{}).Return(nil)
+	s.mockClient.On("ContainerCommit", mock.Anything, "snapshot-container", container.CommitOptions{
+		Reference: imageName,
+	}).Return(types.IDResponse{ID: "image2"}, nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, "snapshot-container", container.RemoveOptions{RemoveVolumes: true, Force: true}).Return(nil)
+
+	s.mockClient.On("ImagePush", mock.Anything, imageName, mock.Anything).Return(io.NopCloser(strings.NewReader("")), nil)
+
+	err := s.dockerClient.SnapshotProject(docker.SnapshotProjectOptions{
+		Project:       project1,
+		ImageName:     imageName,
+		IncludeVolume: true,
+	})
+	require.Nil(s.T(), err)
+}
@@ -30,6 +30,11 @@ func (d *DockerClient) StartProject(opts *CreateProjectOptions, daytonaDownloadU
 		var remoteUser RemoteUser
 		remoteUser, err = d.startDevcontainerProject(opts)
 		containerUser = string(remoteUser)
+	case detect.BuilderTypeDockerfile, detect.BuilderTypeLanguage:
+		err = d.startImageProject(opts)
+		if err == nil {
+			containerUser, err = d.getContainerUser(opts.Project)
+		}
 	case detect.BuilderTypeImage:
 		err = d.startImageProject(opts)
 	default:
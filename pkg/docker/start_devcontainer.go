@@ -4,6 +4,7 @@
 package docker
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"strings"
@@ -19,12 +20,12 @@ func (d *DockerClient) startDevcontainerProject(opts *CreateProjectOptions) (Rem
 		}
 	}()
 
-	_, remoteUser, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, false))
+	_, remoteUser, err := d.CreateFromDevcontainer(context.Background(), d.toCreateDevcontainerOptions(opts, false))
 	return remoteUser, err
 }
 
 func (d *DockerClient) runDevcontainerUserCommands(opts *CreateProjectOptions) error {
-	socketForwardId, err := d.ensureDockerSockForward(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
+	socketForwardId, err := d.ensureDockerSockForward(context.Background(), opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
 	if err != nil {
 		return err
 	}
@@ -47,7 +48,7 @@ func (d *DockerClient) runDevcontainerUserCommands(opts *CreateProjectOptions) e
 
 	createDevcontainerOptions := d.toCreateDevcontainerOptions(opts, true)
 
-	_, err = d.execDevcontainerCommand(cmd, &createDevcontainerOptions, paths, paths.ProjectTarget, socketForwardId, true, []mount.Mount{
+	_, err = d.execDevcontainerCommand(context.Background(), cmd, &createDevcontainerOptions, paths, paths.ProjectTarget, socketForwardId, true, []mount.Mount{
 		{
 			Type:   mount.TypeBind,
 			Source: paths.OverridesDir,
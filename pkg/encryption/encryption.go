@@ -0,0 +1,190 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Encrypted values have the format enc:v1:<master key ID>:<encrypted data key>:<encrypted value>
+const encryptedValuePrefix = "enc:v1:"
+
+// Encryptor encrypts values with envelope encryption.
+// Each value is encrypted with its own random data key which is in turn encrypted with the master key,
+// so rotating the master key only requires re-encrypting the data keys
+type Encryptor struct {
+	keyId     string
+	masterKey cipher.AEAD
+}
+
+func NewEncryptor(masterKey []byte) (*Encryptor, error) {
+	if len(masterKey) != KEY_SIZE {
+		return nil, ErrInvalidMasterKey
+	}
+
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(masterKey)
+
+	return &Encryptor{
+		keyId:     hex.EncodeToString(hash[:4]),
+		masterKey: aead,
+	}, nil
+}
+
+// IsEncrypted returns true if the value was encrypted by an Encryptor
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedValuePrefix)
+}
+
+// Encrypt returns the encrypted value. Empty values are not encrypted
+func (e *Encryptor) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	dataKey := make([]byte, KEY_SIZE)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	dataKeyAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := seal(dataKeyAEAD, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+
+	encryptedDataKey, err := seal(e.masterKey, dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	return e.format(encryptedDataKey, ciphertext), nil
+}
+
+// Decrypt returns the decrypted value. Values that are not encrypted are returned unchanged
+func (e *Encryptor) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	dataKey, ciphertext, err := e.parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	dataKeyAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dataKeyAEAD, ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrDecryptionFailed, err)
+	}
+
+	return string(plaintext), nil
+}
+
+// Rewrap re-encrypts the data key of the value with the master key of the target Encryptor.
+// Values that are not encrypted yet are encrypted by the target Encryptor
+func (e *Encryptor) Rewrap(value string, target *Encryptor) (string, error) {
+	if !IsEncrypted(value) {
+		return target.Encrypt(value)
+	}
+
+	dataKey, ciphertext, err := e.parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	encryptedDataKey, err := seal(target.masterKey, dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	return target.format(encryptedDataKey, ciphertext), nil
+}
+
+func (e *Encryptor) format(encryptedDataKey, ciphertext []byte) string {
+	return encryptedValuePrefix + strings.Join([]string{
+		e.keyId,
+		base64.StdEncoding.EncodeToString(encryptedDataKey),
+		base64.StdEncoding.EncodeToString(ciphertext),
+	}, ":")
+}
+
+// parse returns the decrypted data key and the encrypted value
+func (e *Encryptor) parse(value string) ([]byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, encryptedValuePrefix), ":")
+	if len(parts) != 3 {
+		return nil, nil, ErrMalformedValue
+	}
+
+	if parts[0] != e.keyId {
+		return nil, nil, ErrMasterKeyMismatch
+	}
+
+	encryptedDataKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, ErrMalformedValue
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, nil, ErrMalformedValue
+	}
+
+	dataKey, err := open(e.masterKey, encryptedDataKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrDecryptionFailed, err)
+	}
+
+	return dataKey, ciphertext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the data and prepends the random nonce to the result
+func seal(aead cipher.AEAD, data []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+func open(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
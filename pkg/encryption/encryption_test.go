@@ -0,0 +1,100 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+func newEncryptor(t *testing.T) *encryption.Encryptor {
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+
+	encryptor, err := encryption.NewEncryptor(key)
+	require.NoError(t, err)
+
+	return encryptor
+}
+
+func TestEncryptor(t *testing.T) {
+	t.Run("Values are encrypted and decrypted", func(t *testing.T) {
+		encryptor := newEncryptor(t)
+
+		encrypted, err := encryptor.Encrypt("secret")
+		require.NoError(t, err)
+		require.True(t, encryption.IsEncrypted(encrypted))
+		require.NotContains(t, encrypted, "secret")
+
+		decrypted, err := encryptor.Decrypt(encrypted)
+		require.NoError(t, err)
+		require.Equal(t, "secret", decrypted)
+	})
+
+	t.Run("Plaintext values are decrypted unchanged", func(t *testing.T) {
+		decrypted, err := newEncryptor(t).Decrypt("secret")
+		require.NoError(t, err)
+		require.Equal(t, "secret", decrypted)
+	})
+
+	t.Run("Values encrypted with a different master key are rejected", func(t *testing.T) {
+		encrypted, err := newEncryptor(t).Encrypt("secret")
+		require.NoError(t, err)
+
+		_, err = newEncryptor(t).Decrypt(encrypted)
+		require.True(t, encryption.IsMasterKeyMismatch(err))
+	})
+
+	t.Run("Rewrapped values are decrypted with the new master key", func(t *testing.T) {
+		oldEncryptor := newEncryptor(t)
+		newEncryptor := newEncryptor(t)
+
+		encrypted, err := oldEncryptor.Encrypt("secret")
+		require.NoError(t, err)
+
+		rewrapped, err := oldEncryptor.Rewrap(encrypted, newEncryptor)
+		require.NoError(t, err)
+
+		decrypted, err := newEncryptor.Decrypt(rewrapped)
+		require.NoError(t, err)
+		require.Equal(t, "secret", decrypted)
+
+		_, err = oldEncryptor.Decrypt(rewrapped)
+		require.True(t, encryption.IsMasterKeyMismatch(err))
+	})
+}
+
+func TestLoadMasterKey(t *testing.T) {
+	t.Run("Master key is generated and reused", func(t *testing.T) {
+		keyFilePath := filepath.Join(t.TempDir(), "master.key")
+
+		key, err := encryption.LoadMasterKey(keyFilePath)
+		require.NoError(t, err)
+		require.Len(t, key, encryption.KEY_SIZE)
+
+		loadedKey, err := encryption.LoadMasterKey(keyFilePath)
+		require.NoError(t, err)
+		require.Equal(t, key, loadedKey)
+	})
+
+	t.Run("Master key from the environment takes precedence", func(t *testing.T) {
+		key, err := encryption.GenerateKey()
+		require.NoError(t, err)
+		t.Setenv(encryption.MASTER_KEY_ENV_VAR, encryption.EncodeKey(key))
+
+		loadedKey, err := encryption.LoadMasterKey(filepath.Join(t.TempDir(), "master.key"))
+		require.NoError(t, err)
+		require.Equal(t, key, loadedKey)
+	})
+
+	t.Run("Invalid master key is rejected", func(t *testing.T) {
+		t.Setenv(encryption.MASTER_KEY_ENV_VAR, "short")
+
+		_, err := encryption.LoadMasterKey(filepath.Join(t.TempDir(), "master.key"))
+		require.True(t, encryption.IsInvalidMasterKey(err))
+	})
+}
@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import "errors"
+
+var (
+	ErrInvalidMasterKey  = errors.New("invalid master key: expected a base64 encoded 32 byte key")
+	ErrMasterKeyMismatch = errors.New("value was encrypted with a different master key")
+	ErrMalformedValue    = errors.New("malformed encrypted value")
+	ErrDecryptionFailed  = errors.New("failed to decrypt value")
+)
+
+func IsInvalidMasterKey(err error) bool {
+	return errors.Is(err, ErrInvalidMasterKey)
+}
+
+func IsMasterKeyMismatch(err error) bool {
+	return errors.Is(err, ErrMasterKeyMismatch)
+}
+
+func IsDecryptionFailed(err error) bool {
+	return errors.Is(err, ErrDecryptionFailed)
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Size of the master key and the data keys in bytes (AES-256)
+const KEY_SIZE = 32
+
+// Environment variable holding the base64 encoded master key. Takes precedence over the master key file
+const MASTER_KEY_ENV_VAR = "DAYTONA_MASTER_KEY"
+
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KEY_SIZE)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func EncodeKey(key []byte) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func DecodeKey(encodedKey string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil || len(key) != KEY_SIZE {
+		return nil, ErrInvalidMasterKey
+	}
+
+	return key, nil
+}
+
+// IsMasterKeyFromEnv returns true if the master key is set with the DAYTONA_MASTER_KEY environment variable
+func IsMasterKeyFromEnv() bool {
+	return os.Getenv(MASTER_KEY_ENV_VAR) != ""
+}
+
+// LoadMasterKey returns the master key from the DAYTONA_MASTER_KEY environment variable or from the key file.
+// If neither exists, a new master key is generated and saved to the key file
+func LoadMasterKey(keyFilePath string) ([]byte, error) {
+	if IsMasterKeyFromEnv() {
+		return DecodeKey(os.Getenv(MASTER_KEY_ENV_VAR))
+	}
+
+	content, err := os.ReadFile(keyFilePath)
+	if err == nil {
+		return DecodeKey(string(content))
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveMasterKey(keyFilePath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// SaveMasterKey writes the base64 encoded master key to the key file.
+// The key is written to a temporary file first so the key file is never left partially written
+func SaveMasterKey(keyFilePath string, key []byte) error {
+	err := os.MkdirAll(filepath.Dir(keyFilePath), 0700)
+	if err != nil {
+		return err
+	}
+
+	tmpFilePath := keyFilePath + ".tmp"
+	err = os.WriteFile(tmpFilePath, []byte(EncodeKey(key)), 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilePath, keyFilePath)
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"slices"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Number of events buffered for each subscriber before new events are dropped for it
+const subscriberBufferSize = 100
+
+// EventBus fans out published events to all subscribers.
+// A nil bus discards all events so that publishers can use it without checks.
+type EventBus struct {
+	mutex       sync.RWMutex
+	subscribers map[chan Event][]EventType
+	handlers    []func(Event)
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: map[chan Event][]EventType{},
+	}
+}
+
+// Publish never blocks - subscribers that can not keep up miss the event.
+// Handlers are called synchronously and receive every event
+func (b *EventBus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, handler := range b.handlers {
+		handler(event)
+	}
+
+	for ch, types := range b.subscribers {
+		if len(types) > 0 && !slices.Contains(types, event.Type) {
+			continue
+		}
+
+		select {
+		case ch <- event:
+		default:
+			log.Tracef("Dropping event %s for a slow subscriber", event.Id)
+		}
+	}
+}
+
+// Subscribe returns a channel that receives events of the given types, or all events if no types are given.
+// The returned function unsubscribes and closes the channel.
+// Subscribing to a nil bus returns a channel that never receives events.
+func (b *EventBus) Subscribe(types ...EventType) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBufferSize)
+
+	if b != nil {
+		b.mutex.Lock()
+		b.subscribers[ch] = types
+		b.mutex.Unlock()
+	}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			if b != nil {
+				b.mutex.Lock()
+				delete(b.subscribers, ch)
+				b.mutex.Unlock()
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Handle registers a handler that is called with every published event, for consumers that can not miss events.
+// Handlers are called by the publisher so they must return quickly and must not publish events
+func (b *EventBus) Handle(handler func(Event)) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.handlers = append(b.handlers, handler)
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBus(t *testing.T) {
+	t.Run("Subscribers receive published events", func(t *testing.T) {
+		bus := events.NewEventBus()
+
+		ch, unsubscribe := bus.Subscribe()
+		defer unsubscribe()
+
+		event := events.NewEvent(events.EventTypeWorkspaceCreated)
+		event.WorkspaceId = "workspace1"
+		bus.Publish(event)
+
+		received := <-ch
+		require.Equal(t, event, received)
+	})
+
+	t.Run("Subscribers only receive events of the requested types", func(t *testing.T) {
+		bus := events.NewEventBus()
+
+		ch, unsubscribe := bus.Subscribe(events.EventTypeBuildStateChanged)
+		defer unsubscribe()
+
+		bus.Publish(events.NewEvent(events.EventTypeWorkspaceStarted))
+		bus.Publish(events.NewEvent(events.EventTypeBuildStateChanged))
+
+		received := <-ch
+		require.Equal(t, events.EventTypeBuildStateChanged, received.Type)
+		require.Empty(t, ch)
+	})
+
+	t.Run("Unsubscribe closes the channel", func(t *testing.T) {
+		bus := events.NewEventBus()
+
+		ch, unsubscribe := bus.Subscribe()
+		unsubscribe()
+
+		bus.Publish(events.NewEvent(events.EventTypeWorkspaceStopped))
+
+		_, ok := <-ch
+		require.False(t, ok)
+	})
+
+	t.Run("Handlers receive every event", func(t *testing.T) {
+		bus := events.NewEventBus()
+
+		received := []events.Event{}
+		bus.Handle(func(event events.Event) {
+			received = append(received, event)
+		})
+
+		ch, unsubscribe := bus.Subscribe()
+		defer unsubscribe()
+
+		// Events over the subscriber buffer are dropped for subscribers but not for handlers
+		for i := 0; i < 150; i++ {
+			bus.Publish(events.NewEvent(events.EventTypeWorkspaceStarted))
+		}
+
+		require.Len(t, received, 150)
+		require.Len(t, ch, 100)
+	})
+
+	t.Run("Publish on a nil bus is a no-op", func(t *testing.T) {
+		var bus *events.EventBus
+		bus.Publish(events.NewEvent(events.EventTypeTargetChanged))
+	})
+
+	t.Run("Subscribe on a nil bus returns a channel without events", func(t *testing.T) {
+		var bus *events.EventBus
+
+		ch, unsubscribe := bus.Subscribe()
+		bus.Publish(events.NewEvent(events.EventTypeTargetChanged))
+		require.Empty(t, ch)
+
+		unsubscribe()
+
+		_, ok := <-ch
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string // @name EventType
+
+const (
+	EventTypeWorkspaceCreated     EventType = "workspace.created"
+	EventTypeWorkspaceStarted     EventType = "workspace.started"
+	EventTypeWorkspaceStopped     EventType = "workspace.stopped"
+	EventTypeWorkspaceError       EventType = "workspace.error"
+	EventTypeProjectStatusChanged EventType = "project.status-changed"
+	EventTypeBuildStateChanged    EventType = "build.state-changed"
+	EventTypeBuildFailed          EventType = "build.failed"
+	EventTypePrebuildTriggered    EventType = "prebuild.triggered"
+	EventTypeProviderInstalled    EventType = "provider.installed"
+	EventTypeTargetChanged        EventType = "target.changed"
+)
+
+var EventTypes = []EventType{
+	EventTypeWorkspaceCreated,
+	EventTypeWorkspaceStarted,
+	EventTypeWorkspaceStopped,
+	EventTypeWorkspaceError,
+	EventTypeProjectStatusChanged,
+	EventTypeBuildStateChanged,
+	EventTypeBuildFailed,
+	EventTypePrebuildTriggered,
+	EventTypeProviderInstalled,
+	EventTypeTargetChanged,
+}
+
+// IsValid reports whether the event type is one of the known event types
+func (t EventType) IsValid() bool {
+	for _, eventType := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Event struct {
+	Id          string    `json:"id" validate:"required"`
+	Type        EventType `json:"type" validate:"required"`
+	Timestamp   time.Time `json:"timestamp" validate:"required"`
+	WorkspaceId string    `json:"workspaceId,omitempty" validate:"optional"`
+	ProjectName string    `json:"projectName,omitempty" validate:"optional"`
+	BuildId     string    `json:"buildId,omitempty" validate:"optional"`
+	PrebuildId  string    `json:"prebuildId,omitempty" validate:"optional"`
+	Provider    string    `json:"provider,omitempty" validate:"optional"`
+	Target      string    `json:"target,omitempty" validate:"optional"`
+	// New status of the project or state of the build
+	State string `json:"state,omitempty" validate:"optional"`
+	Error string `json:"error,omitempty" validate:"optional"`
+} // @name Event
+
+func NewEvent(eventType EventType) Event {
+	return Event{
+		Id:        uuid.NewString(),
+		Type:      eventType,
+		Timestamp: time.Now(),
+	}
+}
@@ -4,6 +4,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,7 +16,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 )
 
-func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+func (s *Service) CloneRepository(ctx context.Context, repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
 	cloneOptions := &git.CloneOptions{
 		URL:             repo.Url,
 		SingleBranch:    true,
@@ -38,7 +39,7 @@ func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.Ba
 
 	cloneOptions.ReferenceName = plumbing.ReferenceName("refs/heads/" + repo.Branch)
 
-	_, err := git.PlainClone(s.ProjectDir, false, cloneOptions)
+	_, err := git.PlainCloneContext(ctx, s.ProjectDir, false, cloneOptions)
 	if err != nil {
 		return err
 	}
@@ -54,9 +55,16 @@ func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.Ba
 			return err
 		}
 
-		err = w.Checkout(&git.CheckoutOptions{
-			Hash: plumbing.NewHash(repo.Sha),
-		})
+		if repo.KeepBranch {
+			err = w.Reset(&git.ResetOptions{
+				Commit: plumbing.NewHash(repo.Sha),
+				Mode:   git.HardReset,
+			})
+		} else {
+			err = w.Checkout(&git.CheckoutOptions{
+				Hash: plumbing.NewHash(repo.Sha),
+			})
+		}
 		if err != nil {
 			return err
 		}
@@ -82,7 +90,11 @@ func (s *Service) CloneRepositoryCmd(repo *gitprovider.GitRepository, auth *http
 
 	if repo.Target == gitprovider.CloneTargetCommit {
 		cloneCmd = append(cloneCmd, "&&", "cd", s.ProjectDir)
-		cloneCmd = append(cloneCmd, "&&", "git", "checkout", repo.Sha)
+		if repo.KeepBranch {
+			cloneCmd = append(cloneCmd, "&&", "git", "reset", "--hard", repo.Sha)
+		} else {
+			cloneCmd = append(cloneCmd, "&&", "git", "checkout", repo.Sha)
+		}
 	}
 
 	return cloneCmd
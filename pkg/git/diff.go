@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Diff returns a binary patch of the working tree, including untracked files, against a base commit.
+// The base commit is the merge base of HEAD and baseRef, or HEAD if baseRef is empty.
+// Commits that are not part of baseRef are included in the patch
+func (s *Service) Diff(baseRef string) (string, string, error) {
+	base, err := s.getDiffBase(baseRef)
+	if err != nil {
+		return "", "", err
+	}
+
+	indexDir, err := os.MkdirTemp("", "daytona-diff-")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.RemoveAll(indexDir)
+
+	// Changes are staged in a temporary index so that the user's index is left untouched
+	env := append(os.Environ(), fmt.Sprintf("GIT_INDEX_FILE=%s", filepath.Join(indexDir, "index")))
+
+	_, err = s.runGitCommand(env, nil, "read-tree", base)
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = s.runGitCommand(env, nil, "add", "--all")
+	if err != nil {
+		return "", "", err
+	}
+
+	patch, err := s.runGitCommand(env, nil, "diff", "--cached", "--binary", base)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base, patch, nil
+}
+
+// Apply applies a patch created by Diff to the working tree
+func (s *Service) Apply(patch string) error {
+	if patch == "" {
+		return nil
+	}
+
+	_, err := s.runGitCommand(nil, strings.NewReader(patch), "apply", "--binary", "--whitespace=nowarn", "-")
+	return err
+}
+
+func (s *Service) getDiffBase(baseRef string) (string, error) {
+	var out string
+	var err error
+
+	if baseRef == "" {
+		out, err = s.runGitCommand(nil, nil, "rev-parse", "HEAD")
+	} else {
+		out, err = s.runGitCommand(nil, nil, "merge-base", "HEAD", baseRef)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+func (s *Service) runGitCommand(env []string, stdin io.Reader, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", s.ProjectDir}, args...)...)
+	cmd.Env = env
+	cmd.Stdin = stdin
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return string(out), nil
+}
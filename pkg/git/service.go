@@ -4,6 +4,7 @@
 package git
 
 import (
+	"context"
 	"io"
 	"os"
 	"path/filepath"
@@ -26,7 +27,7 @@ var MapStatus map[git.StatusCode]project.Status = map[git.StatusCode]project.Sta
 }
 
 type IGitService interface {
-	CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error
+	CloneRepository(ctx context.Context, repo *gitprovider.GitRepository, auth *http.BasicAuth) error
 	CloneRepositoryCmd(repo *gitprovider.GitRepository, auth *http.BasicAuth) []string
 	RepositoryExists() (bool, error)
 	SetGitConfig(userData *gitprovider.GitUser, providerConfig *gitprovider.GitProviderConfig) error
@@ -96,3 +96,11 @@ func (s *GitServiceTestSuite) TestCloneRepositoryCmd_WithoutCreds() {
 	cloneCmd = s.gitService.CloneRepositoryCmd(repoWithCloneTargetCommit, nil)
 	s.Require().Equal([]string{"git", "clone", "--single-branch", "--branch", "\"main\"", "https://github.com/daytonaio/daytona", "/workdir", "&&", "cd", "/workdir", "&&", "git", "checkout", "1234567890"}, cloneCmd)
 }
+
+func (s *GitServiceTestSuite) TestCloneRepositoryCmd_KeepBranch() {
+	repo := *repoWithCloneTargetCommit
+	repo.KeepBranch = true
+
+	cloneCmd := s.gitService.CloneRepositoryCmd(&repo, nil)
+	s.Require().Equal([]string{"git", "clone", "--single-branch", "--branch", "\"main\"", "https://github.com/daytonaio/daytona", "/workdir", "&&", "cd", "/workdir", "&&", "git", "reset", "--hard", "1234567890"}, cloneCmd)
+}
@@ -46,6 +46,8 @@ type GitRepository struct {
 	Source   string      `json:"source" validate:"required"`
 	Path     *string     `json:"path,omitempty" validate:"optional"`
 	Target   CloneTarget `json:"cloneTarget,omitempty" validate:"optional"`
+	// Moves the cloned branch to the commit of a commit clone instead of checking the commit out with a detached HEAD
+	KeepBranch bool `json:"keepBranch,omitempty" validate:"optional"`
 } // @name GitRepository
 
 type GitNamespace struct {
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package operation
+
+import (
+	"context"
+	"time"
+)
+
+type OperationType string // @name OperationType
+
+const (
+	OperationTypeCreateWorkspace  OperationType = "create-workspace"
+	OperationTypeStartWorkspace   OperationType = "start-workspace"
+	OperationTypeStopWorkspace    OperationType = "stop-workspace"
+	OperationTypeMigrateWorkspace OperationType = "migrate-workspace"
+)
+
+type OperationState string // @name OperationState
+
+const (
+	OperationStatePending   OperationState = "pending"
+	OperationStateRunning   OperationState = "running"
+	OperationStateSuccess   OperationState = "success"
+	OperationStateError     OperationState = "error"
+	OperationStateCancelled OperationState = "cancelled"
+)
+
+type Operation struct {
+	Id   string        `json:"id" validate:"required"`
+	Type OperationType `json:"type" validate:"required"`
+	// ID or name of the workspace the operation acts on
+	ResourceId string         `json:"resourceId" validate:"required"`
+	State      OperationState `json:"state" validate:"required"`
+	// Describes the step the operation is currently executing
+	Progress  string      `json:"progress,omitempty" validate:"optional"`
+	Result    interface{} `json:"result,omitempty" validate:"optional"`
+	Error     *string     `json:"error,omitempty" validate:"optional"`
+	CreatedAt time.Time   `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time   `json:"updatedAt" validate:"required"`
+} // @name Operation
+
+func (o *Operation) IsDone() bool {
+	return o.State == OperationStateSuccess || o.State == OperationStateError || o.State == OperationStateCancelled
+}
+
+type operationContextKey string
+
+const progressContextKey operationContextKey = "operation-progress"
+
+// WithProgressReporter returns a context that forwards progress reported by long-running actions to the given function
+func WithProgressReporter(ctx context.Context, report func(progress string)) context.Context {
+	return context.WithValue(ctx, progressContextKey, report)
+}
+
+// ReportProgress updates the progress of the operation running under the context, if any
+func ReportProgress(ctx context.Context, progress string) {
+	report, ok := ctx.Value(progressContextKey).(func(string))
+	if !ok {
+		return
+	}
+
+	report(progress)
+}
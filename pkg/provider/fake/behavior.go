@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// MethodBehavior configures the latency and failures injected into a provider method
+type MethodBehavior struct {
+	// Go duration, e.g. "500ms"
+	Latency string `json:"Latency,omitempty"`
+	// Probability between 0 and 1 that the method fails
+	FailureRate float64 `json:"Failure Rate,omitempty"`
+	// Error returned when the method fails
+	Error string `json:"Error,omitempty"`
+}
+
+// TargetOptions are the options of a fake provider target.
+// Latency and Failure Rate apply to all methods that are not configured in Methods
+type TargetOptions struct {
+	Latency     string  `json:"Latency,omitempty"`
+	FailureRate float64 `json:"Failure Rate,omitempty"`
+	// Method name, e.g. "CreateProject" -> behavior of the method
+	Methods map[string]MethodBehavior `json:"Methods,omitempty"`
+}
+
+func parseTargetOptions(options string) (*TargetOptions, error) {
+	var targetOptions TargetOptions
+	if options == "" {
+		return &targetOptions, nil
+	}
+
+	err := json.Unmarshal([]byte(options), &targetOptions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target options: %w", err)
+	}
+
+	return &targetOptions, nil
+}
+
+func (o *TargetOptions) getBehavior(method string) MethodBehavior {
+	behavior, ok := o.Methods[method]
+	if ok {
+		return behavior
+	}
+
+	return MethodBehavior{
+		Latency:     o.Latency,
+		FailureRate: o.FailureRate,
+	}
+}
+
+// apply waits for the configured latency and returns an error if the method should fail
+func (b MethodBehavior) apply(method string) error {
+	if b.Latency != "" {
+		latency, err := time.ParseDuration(b.Latency)
+		if err != nil {
+			return fmt.Errorf("invalid latency for %s: %w", method, err)
+		}
+		time.Sleep(latency)
+	}
+
+	if b.FailureRate > 0 && rand.Float64() < b.FailureRate {
+		if b.Error != "" {
+			return errors.New(b.Error)
+		}
+		return fmt.Errorf("%s failed: injected failure", method)
+	}
+
+	return nil
+}
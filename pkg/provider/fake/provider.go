@@ -0,0 +1,281 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+const (
+	ProviderName    = "fake"
+	ProviderVersion = "v0.0.1"
+)
+
+var providerLabel = "Fake (in-process)"
+
+type FakeProviderConfig struct {
+	// Path of the file the workspace and project state is persisted to. State is kept in memory if empty
+	StatePath string
+	// Declared capabilities of the provider. All capabilities are supported if nil
+	Capabilities *provider.ProviderCapabilities
+}
+
+// FakeProvider is an in-process provider that keeps workspaces and projects in memory or in a local state file
+// instead of creating any resources. It is meant for end-to-end tests on machines without Docker.
+// Latency and failures are injected per method through the target options
+type FakeProvider struct {
+	statePath    string
+	capabilities *provider.ProviderCapabilities
+	state        *state
+	mutex        sync.Mutex
+}
+
+func NewFakeProvider(config FakeProviderConfig) (*FakeProvider, error) {
+	s, err := loadState(config.StatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FakeProvider{
+		statePath:    config.StatePath,
+		capabilities: config.Capabilities,
+		state:        s,
+	}, nil
+}
+
+func (p *FakeProvider) Initialize(provider.InitializeProviderRequest) (*util.Empty, error) {
+	return new(util.Empty), nil
+}
+
+func (p *FakeProvider) GetInfo() (provider.ProviderInfo, error) {
+	return provider.ProviderInfo{
+		Name:         ProviderName,
+		Label:        &providerLabel,
+		Version:      ProviderVersion,
+		Capabilities: p.capabilities,
+	}, nil
+}
+
+func (p *FakeProvider) CheckRequirements() (*[]provider.RequirementStatus, error) {
+	return &[]provider.RequirementStatus{
+		{
+			Name:   "In-process",
+			Met:    true,
+			Reason: "The fake provider has no requirements",
+		},
+	}, nil
+}
+
+func (p *FakeProvider) GetTargetManifest() (*provider.ProviderTargetManifest, error) {
+	return &provider.ProviderTargetManifest{
+		"Latency": provider.ProviderTargetProperty{
+			Type:         provider.ProviderTargetPropertyTypeString,
+			DefaultValue: "0s",
+			Description:  "Latency added to every workspace and project operation, e.g. 500ms",
+		},
+		"Failure Rate": provider.ProviderTargetProperty{
+			Type:         provider.ProviderTargetPropertyTypeFloat,
+			DefaultValue: "0",
+			Description:  "Probability between 0 and 1 that a workspace or project operation fails",
+		},
+	}, nil
+}
+
+func (p *FakeProvider) GetPresetTargets() (*[]provider.ProviderTarget, error) {
+	return &[]provider.ProviderTarget{
+		{
+			Name: ProviderName,
+			ProviderInfo: provider.ProviderInfo{
+				Name:    ProviderName,
+				Version: ProviderVersion,
+			},
+			Options: "{}",
+		},
+	}, nil
+}
+
+func (p *FakeProvider) CreateWorkspace(req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.updateWorkspace("CreateWorkspace", req, func(s *state) error {
+		s.Workspaces[req.Workspace.Id] = &workspaceState{
+			Id:   req.Workspace.Id,
+			Name: req.Workspace.Name,
+		}
+		return nil
+	})
+}
+
+func (p *FakeProvider) StartWorkspace(req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.updateWorkspace("StartWorkspace", req, func(s *state) error {
+		w, ok := s.Workspaces[req.Workspace.Id]
+		if !ok {
+			return errors.New("workspace not found")
+		}
+		w.Running = true
+		return nil
+	})
+}
+
+func (p *FakeProvider) StopWorkspace(req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.updateWorkspace("StopWorkspace", req, func(s *state) error {
+		w, ok := s.Workspaces[req.Workspace.Id]
+		if !ok {
+			return errors.New("workspace not found")
+		}
+		w.Running = false
+		return nil
+	})
+}
+
+func (p *FakeProvider) DestroyWorkspace(req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.updateWorkspace("DestroyWorkspace", req, func(s *state) error {
+		delete(s.Workspaces, req.Workspace.Id)
+		return nil
+	})
+}
+
+func (p *FakeProvider) GetWorkspaceInfo(req *provider.WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	err := p.applyBehavior("GetWorkspaceInfo", req.TargetOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	_, ok := p.state.Workspaces[req.Workspace.Id]
+	if !ok {
+		return nil, errors.New("workspace not found")
+	}
+
+	projectInfos := []*project.ProjectInfo{}
+	for _, pr := range req.Workspace.Projects {
+		projectInfo, err := p.getProjectInfo(pr)
+		if err != nil {
+			return nil, err
+		}
+		projectInfos = append(projectInfos, projectInfo)
+	}
+
+	return &workspace.WorkspaceInfo{
+		Name:     req.Workspace.Name,
+		Projects: projectInfos,
+	}, nil
+}
+
+func (p *FakeProvider) CreateProject(req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.updateProject("CreateProject", req, func(s *state) error {
+		s.Projects[projectKey(req.Project.WorkspaceId, req.Project.Name)] = &projectState{
+			Name:        req.Project.Name,
+			WorkspaceId: req.Project.WorkspaceId,
+			Created:     time.Now().Format(time.RFC3339),
+		}
+		return nil
+	})
+}
+
+func (p *FakeProvider) StartProject(req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.updateProject("StartProject", req, func(s *state) error {
+		pr, ok := s.Projects[projectKey(req.Project.WorkspaceId, req.Project.Name)]
+		if !ok {
+			return errors.New("project not found")
+		}
+		pr.Running = true
+		return nil
+	})
+}
+
+func (p *FakeProvider) StopProject(req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.updateProject("StopProject", req, func(s *state) error {
+		pr, ok := s.Projects[projectKey(req.Project.WorkspaceId, req.Project.Name)]
+		if !ok {
+			return errors.New("project not found")
+		}
+		pr.Running = false
+		return nil
+	})
+}
+
+func (p *FakeProvider) DestroyProject(req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.updateProject("DestroyProject", req, func(s *state) error {
+		delete(s.Projects, projectKey(req.Project.WorkspaceId, req.Project.Name))
+		return nil
+	})
+}
+
+func (p *FakeProvider) GetProjectInfo(req *provider.ProjectRequest) (*project.ProjectInfo, error) {
+	err := p.applyBehavior("GetProjectInfo", req.TargetOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.getProjectInfo(req.Project)
+}
+
+func (p *FakeProvider) getProjectInfo(pr *project.Project) (*project.ProjectInfo, error) {
+	s, ok := p.state.Projects[projectKey(pr.WorkspaceId, pr.Name)]
+	if !ok {
+		return nil, errors.New("project not found")
+	}
+
+	return &project.ProjectInfo{
+		Name:        s.Name,
+		Created:     s.Created,
+		IsRunning:   s.Running,
+		WorkspaceId: s.WorkspaceId,
+	}, nil
+}
+
+func (p *FakeProvider) updateWorkspace(method string, req *provider.WorkspaceRequest, update func(*state) error) (*util.Empty, error) {
+	err := p.applyBehavior(method, req.TargetOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.updateState(update)
+}
+
+func (p *FakeProvider) updateProject(method string, req *provider.ProjectRequest, update func(*state) error) (*util.Empty, error) {
+	err := p.applyBehavior(method, req.TargetOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.updateState(update)
+}
+
+func (p *FakeProvider) updateState(update func(*state) error) (*util.Empty, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	err := update(p.state)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.state.save(p.statePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(util.Empty), nil
+}
+
+func (p *FakeProvider) applyBehavior(method string, targetOptions string) error {
+	options, err := parseTargetOptions(targetOptions)
+	if err != nil {
+		return err
+	}
+
+	return options.getBehavior(method).apply(method)
+}
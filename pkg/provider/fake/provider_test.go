@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/fake"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+var project1 = &project.Project{
+	Name:        "project1",
+	WorkspaceId: "123",
+	Target:      "fake",
+}
+
+var workspace1 = &workspace.Workspace{
+	Id:       "123",
+	Name:     "workspace1",
+	Target:   "fake",
+	Projects: []*project.Project{project1},
+}
+
+func TestFakeProvider(t *testing.T) {
+	t.Run("Projects can be created, started and stopped", func(t *testing.T) {
+		p, err := fake.NewFakeProvider(fake.FakeProviderConfig{})
+		require.NoError(t, err)
+
+		_, err = p.CreateWorkspace(&provider.WorkspaceRequest{Workspace: workspace1})
+		require.NoError(t, err)
+
+		_, err = p.CreateProject(&provider.ProjectRequest{Project: project1})
+		require.NoError(t, err)
+
+		_, err = p.StartProject(&provider.ProjectRequest{Project: project1})
+		require.NoError(t, err)
+
+		info, err := p.GetProjectInfo(&provider.ProjectRequest{Project: project1})
+		require.NoError(t, err)
+		require.True(t, info.IsRunning)
+
+		_, err = p.StopProject(&provider.ProjectRequest{Project: project1})
+		require.NoError(t, err)
+
+		workspaceInfo, err := p.GetWorkspaceInfo(&provider.WorkspaceRequest{Workspace: workspace1})
+		require.NoError(t, err)
+		require.Len(t, workspaceInfo.Projects, 1)
+		require.False(t, workspaceInfo.Projects[0].IsRunning)
+	})
+
+	t.Run("Projects that were not created can not be started", func(t *testing.T) {
+		p, err := fake.NewFakeProvider(fake.FakeProviderConfig{})
+		require.NoError(t, err)
+
+		_, err = p.StartProject(&provider.ProjectRequest{Project: project1})
+		require.EqualError(t, err, "project not found")
+	})
+
+	t.Run("Failures are injected per method", func(t *testing.T) {
+		p, err := fake.NewFakeProvider(fake.FakeProviderConfig{})
+		require.NoError(t, err)
+
+		targetOptions := `{"Methods": {"CreateProject": {"Failure Rate": 1, "Error": "out of capacity"}}}`
+
+		_, err = p.CreateWorkspace(&provider.WorkspaceRequest{Workspace: workspace1, TargetOptions: targetOptions})
+		require.NoError(t, err)
+
+		_, err = p.CreateProject(&provider.ProjectRequest{Project: project1, TargetOptions: targetOptions})
+		require.EqualError(t, err, "out of capacity")
+
+		_, err = p.GetProjectInfo(&provider.ProjectRequest{Project: project1, TargetOptions: targetOptions})
+		require.EqualError(t, err, "project not found")
+	})
+
+	t.Run("Invalid latency is reported", func(t *testing.T) {
+		p, err := fake.NewFakeProvider(fake.FakeProviderConfig{})
+		require.NoError(t, err)
+
+		_, err = p.CreateWorkspace(&provider.WorkspaceRequest{Workspace: workspace1, TargetOptions: `{"Latency": "soon"}`})
+		require.ErrorContains(t, err, "invalid latency for CreateWorkspace")
+	})
+
+	t.Run("State is persisted to the state file", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "state.json")
+
+		p, err := fake.NewFakeProvider(fake.FakeProviderConfig{StatePath: statePath})
+		require.NoError(t, err)
+
+		_, err = p.CreateWorkspace(&provider.WorkspaceRequest{Workspace: workspace1})
+		require.NoError(t, err)
+
+		_, err = p.CreateProject(&provider.ProjectRequest{Project: project1})
+		require.NoError(t, err)
+
+		reloaded, err := fake.NewFakeProvider(fake.FakeProviderConfig{StatePath: statePath})
+		require.NoError(t, err)
+
+		_, err = reloaded.GetProjectInfo(&provider.ProjectRequest{Project: project1})
+		require.NoError(t, err)
+	})
+}
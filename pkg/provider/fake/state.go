@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+type workspaceState struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Running bool   `json:"running"`
+}
+
+type projectState struct {
+	Name        string `json:"name"`
+	WorkspaceId string `json:"workspaceId"`
+	Created     string `json:"created"`
+	Running     bool   `json:"running"`
+}
+
+type state struct {
+	// Workspace ID -> workspace
+	Workspaces map[string]*workspaceState `json:"workspaces"`
+	// Workspace ID/project name -> project
+	Projects map[string]*projectState `json:"projects"`
+}
+
+func newState() *state {
+	return &state{
+		Workspaces: map[string]*workspaceState{},
+		Projects:   map[string]*projectState{},
+	}
+}
+
+func projectKey(workspaceId, projectName string) string {
+	return workspaceId + "/" + projectName
+}
+
+func loadState(path string) (*state, error) {
+	s := newState()
+	if path == "" {
+		return s, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+
+	err = json.Unmarshal(content, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *state) save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0600)
+}
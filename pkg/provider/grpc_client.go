@@ -0,0 +1,180 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type ProviderGRPCClient struct {
+	conn *grpc.ClientConn
+	// Calls are cancelled when the context is done. No calls are cancelled if nil
+	ctx context.Context
+}
+
+// WithContext returns a client for the same plugin whose calls are cancelled when the context is done
+func (m *ProviderGRPCClient) WithContext(ctx context.Context) Provider {
+	return &ProviderGRPCClient{
+		conn: m.conn,
+		ctx:  ctx,
+	}
+}
+
+func (m *ProviderGRPCClient) Initialize(req InitializeProviderRequest) (*util.Empty, error) {
+	err := m.invoke("Initialize", &req, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) GetInfo() (ProviderInfo, error) {
+	var resp ProviderInfo
+	err := m.invoke("GetInfo", new(util.Empty), &resp)
+	return resp, err
+}
+
+func (m *ProviderGRPCClient) CheckRequirements() (*[]RequirementStatus, error) {
+	var result []RequirementStatus
+	err := m.invoke("CheckRequirements", new(util.Empty), &result)
+	return &result, err
+}
+
+func (m *ProviderGRPCClient) GetTargetManifest() (*ProviderTargetManifest, error) {
+	var resp ProviderTargetManifest
+	err := m.invoke("GetTargetManifest", new(util.Empty), &resp)
+	return &resp, err
+}
+
+func (m *ProviderGRPCClient) GetPresetTargets() (*[]ProviderTarget, error) {
+	var resp []ProviderTarget
+	err := m.invoke("GetPresetTargets", new(util.Empty), &resp)
+	return &resp, err
+}
+
+func (m *ProviderGRPCClient) CreateWorkspace(workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.invoke("CreateWorkspace", workspaceReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) StartWorkspace(workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.invoke("StartWorkspace", workspaceReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) StopWorkspace(workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.invoke("StopWorkspace", workspaceReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) DestroyWorkspace(workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.invoke("DestroyWorkspace", workspaceReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) GetWorkspaceInfo(workspaceReq *WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	var response workspace.WorkspaceInfo
+	err := m.invoke("GetWorkspaceInfo", workspaceReq, &response)
+	return &response, err
+}
+
+func (m *ProviderGRPCClient) CreateProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	return m.StreamCreateProject(projectReq, func(ProviderMessage) {})
+}
+
+func (m *ProviderGRPCClient) StartProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	return m.StreamStartProject(projectReq, func(ProviderMessage) {})
+}
+
+func (m *ProviderGRPCClient) StreamCreateProject(projectReq *ProjectRequest, onMessage func(ProviderMessage)) (*util.Empty, error) {
+	err := m.stream("CreateProject", projectReq, onMessage)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) StreamStartProject(projectReq *ProjectRequest, onMessage func(ProviderMessage)) (*util.Empty, error) {
+	err := m.stream("StartProject", projectReq, onMessage)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) StopProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.invoke("StopProject", projectReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) DestroyProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.invoke("DestroyProject", projectReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCClient) GetProjectInfo(projectReq *ProjectRequest) (*project.ProjectInfo, error) {
+	var resp project.ProjectInfo
+	err := m.invoke("GetProjectInfo", projectReq, &resp)
+	return &resp, err
+}
+
+func (m *ProviderGRPCClient) callContext() context.Context {
+	if m.ctx == nil {
+		return context.Background()
+	}
+
+	return m.ctx
+}
+
+func (m *ProviderGRPCClient) invoke(method string, req, resp interface{}) error {
+	err := m.conn.Invoke(m.callContext(), providerMethod(method), req, resp, grpc.CallContentSubtype(gobCodecName))
+	return fromGRPCError(err)
+}
+
+// stream sends the request and calls onMessage for every message received until the provider call returns
+func (m *ProviderGRPCClient) stream(method string, req *ProjectRequest, onMessage func(ProviderMessage)) error {
+	ctx, cancel := context.WithCancel(m.callContext())
+	defer cancel()
+
+	stream, err := m.conn.NewStream(ctx, &grpc.StreamDesc{StreamName: method, ServerStreams: true}, providerMethod(method), grpc.CallContentSubtype(gobCodecName))
+	if err != nil {
+		return fromGRPCError(err)
+	}
+
+	err = stream.SendMsg(req)
+	if err != nil {
+		return fromGRPCError(err)
+	}
+
+	err = stream.CloseSend()
+	if err != nil {
+		return fromGRPCError(err)
+	}
+
+	for {
+		var msg ProviderMessage
+		err := stream.RecvMsg(&msg)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fromGRPCError(err)
+		}
+
+		onMessage(msg)
+	}
+}
+
+// fromGRPCError strips the gRPC status from provider errors so they read the same as net/rpc errors
+func fromGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	return errors.New(st.Message())
+}
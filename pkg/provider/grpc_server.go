@@ -0,0 +1,241 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"google.golang.org/grpc"
+)
+
+const providerServiceName = "daytona.provider.v2.Provider"
+
+// The service is described by hand since messages are encoded with the gob codec instead of protobuf
+var providerServiceDesc = grpc.ServiceDesc{
+	ServiceName: providerServiceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod("Initialize", (*ProviderGRPCServer).Initialize),
+		unaryMethod("GetInfo", (*ProviderGRPCServer).GetInfo),
+		unaryMethod("CheckRequirements", (*ProviderGRPCServer).CheckRequirements),
+		unaryMethod("GetTargetManifest", (*ProviderGRPCServer).GetTargetManifest),
+		unaryMethod("GetPresetTargets", (*ProviderGRPCServer).GetPresetTargets),
+		unaryMethod("CreateWorkspace", (*ProviderGRPCServer).CreateWorkspace),
+		unaryMethod("StartWorkspace", (*ProviderGRPCServer).StartWorkspace),
+		unaryMethod("StopWorkspace", (*ProviderGRPCServer).StopWorkspace),
+		unaryMethod("DestroyWorkspace", (*ProviderGRPCServer).DestroyWorkspace),
+		unaryMethod("GetWorkspaceInfo", (*ProviderGRPCServer).GetWorkspaceInfo),
+		unaryMethod("StopProject", (*ProviderGRPCServer).StopProject),
+		unaryMethod("DestroyProject", (*ProviderGRPCServer).DestroyProject),
+		unaryMethod("GetProjectInfo", (*ProviderGRPCServer).GetProjectInfo),
+	},
+	Streams: []grpc.StreamDesc{
+		streamMethod("CreateProject", (*ProviderGRPCServer).CreateProject),
+		streamMethod("StartProject", (*ProviderGRPCServer).StartProject),
+	},
+}
+
+type ProviderGRPCServer struct {
+	Impl Provider
+}
+
+func (m *ProviderGRPCServer) Initialize(req *InitializeProviderRequest) (*util.Empty, error) {
+	_, err := m.Impl.Initialize(*req)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCServer) GetInfo(*util.Empty) (*ProviderInfo, error) {
+	info, err := m.Impl.GetInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+func (m *ProviderGRPCServer) CheckRequirements(*util.Empty) (*[]RequirementStatus, error) {
+	result, err := m.Impl.CheckRequirements()
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		result = &[]RequirementStatus{}
+	}
+
+	return result, nil
+}
+
+func (m *ProviderGRPCServer) GetTargetManifest(*util.Empty) (*ProviderTargetManifest, error) {
+	targetManifest, err := m.Impl.GetTargetManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	if targetManifest == nil {
+		return nil, errors.New("provider returned no target manifest")
+	}
+
+	return targetManifest, nil
+}
+
+func (m *ProviderGRPCServer) GetPresetTargets(*util.Empty) (*[]ProviderTarget, error) {
+	targets, err := m.Impl.GetPresetTargets()
+	if err != nil {
+		return nil, err
+	}
+
+	if targets == nil {
+		targets = &[]ProviderTarget{}
+	}
+
+	return targets, nil
+}
+
+func (m *ProviderGRPCServer) CreateWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	_, err := m.Impl.CreateWorkspace(req)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCServer) StartWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	_, err := m.Impl.StartWorkspace(req)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCServer) StopWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	_, err := m.Impl.StopWorkspace(req)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCServer) DestroyWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	_, err := m.Impl.DestroyWorkspace(req)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCServer) GetWorkspaceInfo(req *WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	info, err := m.Impl.GetWorkspaceInfo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if info == nil {
+		return nil, errors.New("provider returned no workspace info")
+	}
+
+	return info, nil
+}
+
+// CreateProject streams messages back to the server if the provider supports it
+func (m *ProviderGRPCServer) CreateProject(req *ProjectRequest, send func(ProviderMessage)) error {
+	if streamingProvider, ok := m.Impl.(StreamingProvider); ok {
+		_, err := streamingProvider.StreamCreateProject(req, send)
+		return err
+	}
+
+	_, err := m.Impl.CreateProject(req)
+	return err
+}
+
+// StartProject streams messages back to the server if the provider supports it
+func (m *ProviderGRPCServer) StartProject(req *ProjectRequest, send func(ProviderMessage)) error {
+	if streamingProvider, ok := m.Impl.(StreamingProvider); ok {
+		_, err := streamingProvider.StreamStartProject(req, send)
+		return err
+	}
+
+	_, err := m.Impl.StartProject(req)
+	return err
+}
+
+func (m *ProviderGRPCServer) StopProject(req *ProjectRequest) (*util.Empty, error) {
+	_, err := m.Impl.StopProject(req)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCServer) DestroyProject(req *ProjectRequest) (*util.Empty, error) {
+	_, err := m.Impl.DestroyProject(req)
+	return new(util.Empty), err
+}
+
+func (m *ProviderGRPCServer) GetProjectInfo(req *ProjectRequest) (*project.ProjectInfo, error) {
+	info, err := m.Impl.GetProjectInfo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if info == nil {
+		return nil, errors.New("provider returned no project info")
+	}
+
+	return info, nil
+}
+
+func providerMethod(name string) string {
+	return fmt.Sprintf("/%s/%s", providerServiceName, name)
+}
+
+func unaryMethod[Req any, Resp any](name string, call func(*ProviderGRPCServer, *Req) (*Resp, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := new(Req)
+			err := dec(req)
+			if err != nil {
+				return nil, err
+			}
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv.(*ProviderGRPCServer), req.(*Req))
+			}
+
+			if interceptor == nil {
+				return handler(ctx, req)
+			}
+
+			return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: providerMethod(name)}, handler)
+		},
+	}
+}
+
+func streamMethod(name string, call func(*ProviderGRPCServer, *ProjectRequest, func(ProviderMessage)) error) grpc.StreamDesc {
+	return grpc.StreamDesc{
+		StreamName:    name,
+		ServerStreams: true,
+		Handler: func(srv interface{}, stream grpc.ServerStream) error {
+			req := new(ProjectRequest)
+			err := stream.RecvMsg(req)
+			if err != nil {
+				return err
+			}
+
+			// Providers may send messages from multiple goroutines and after the call has returned
+			var mutex sync.Mutex
+			done := false
+
+			defer func() {
+				mutex.Lock()
+				done = true
+				mutex.Unlock()
+			}()
+
+			return call(srv.(*ProviderGRPCServer), req, func(msg ProviderMessage) {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if done {
+					return
+				}
+
+				// Messages are best effort and must not fail the provider call
+				_ = stream.SendMsg(&msg)
+			})
+		},
+	}
+}
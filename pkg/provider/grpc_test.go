@@ -0,0 +1,143 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/fake"
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+)
+
+var project1 = &project.Project{
+	Name:        "project1",
+	WorkspaceId: "123",
+	Target:      "fake",
+}
+
+var workspace1 = &workspace.Workspace{
+	Id:       "123",
+	Name:     "workspace1",
+	Target:   "fake",
+	Projects: []*project.Project{project1},
+}
+
+// streamingProvider streams messages while a project is created and blocks starting and stopping projects until the test ends
+type streamingProvider struct {
+	*fake.FakeProvider
+	releaseStop chan struct{}
+}
+
+func (p *streamingProvider) StreamCreateProject(req *provider.ProjectRequest, send func(provider.ProviderMessage)) (*util.Empty, error) {
+	send(provider.ProviderMessage{Type: provider.ProviderMessageTypeLog, Message: "Creating project"})
+	send(provider.ProviderMessage{Type: provider.ProviderMessageTypeProgress, Message: "Pulling image"})
+
+	return p.FakeProvider.CreateProject(req)
+}
+
+func (p *streamingProvider) StreamStartProject(req *provider.ProjectRequest, send func(provider.ProviderMessage)) (*util.Empty, error) {
+	<-p.releaseStop
+	return p.FakeProvider.StartProject(req)
+}
+
+func (p *streamingProvider) StopProject(req *provider.ProjectRequest) (*util.Empty, error) {
+	<-p.releaseStop
+	return p.FakeProvider.StopProject(req)
+}
+
+func newGRPCProvider(t *testing.T) provider.Provider {
+	fakeProvider, err := fake.NewFakeProvider(fake.FakeProviderConfig{})
+	require.NoError(t, err)
+
+	impl := &streamingProvider{
+		FakeProvider: fakeProvider,
+		releaseStop:  make(chan struct{}),
+	}
+	t.Cleanup(func() {
+		close(impl.releaseStop)
+	})
+
+	client, _ := plugin.TestPluginGRPCConn(t, false, map[string]plugin.Plugin{
+		"provider": &provider.ProviderGRPCPlugin{Impl: impl},
+	})
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	raw, err := client.Dispense("provider")
+	require.NoError(t, err)
+
+	return raw.(provider.Provider)
+}
+
+func TestProviderGRPC(t *testing.T) {
+	t.Run("Unary calls round-trip requests, responses and errors", func(t *testing.T) {
+		p := newGRPCProvider(t)
+
+		info, err := p.GetInfo()
+		require.NoError(t, err)
+		require.Equal(t, fake.ProviderName, info.Name)
+
+		_, err = p.CreateWorkspace(&provider.WorkspaceRequest{Workspace: workspace1})
+		require.NoError(t, err)
+
+		workspaceInfo, err := p.GetWorkspaceInfo(&provider.WorkspaceRequest{Workspace: &workspace.Workspace{Id: workspace1.Id, Name: workspace1.Name}})
+		require.NoError(t, err)
+		require.Equal(t, workspace1.Name, workspaceInfo.Name)
+
+		// Errors read the same as the errors returned by the provider
+		_, err = p.GetProjectInfo(&provider.ProjectRequest{Project: project1})
+		require.EqualError(t, err, "project not found")
+	})
+
+	t.Run("Streaming calls deliver provider messages", func(t *testing.T) {
+		p := newGRPCProvider(t)
+
+		streamingClient, ok := p.(provider.StreamingProvider)
+		require.True(t, ok)
+
+		messages := []provider.ProviderMessage{}
+		_, err := streamingClient.StreamCreateProject(&provider.ProjectRequest{Project: project1}, func(msg provider.ProviderMessage) {
+			messages = append(messages, msg)
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, []provider.ProviderMessage{
+			{Type: provider.ProviderMessageTypeLog, Message: "Creating project"},
+			{Type: provider.ProviderMessageTypeProgress, Message: "Pulling image"},
+		}, messages)
+
+		_, err = p.GetProjectInfo(&provider.ProjectRequest{Project: project1})
+		require.NoError(t, err)
+	})
+
+	t.Run("Calls are cancelled with the context of the caller", func(t *testing.T) {
+		p := newGRPCProvider(t)
+
+		contextProvider, ok := p.(provider.ContextProvider)
+		require.True(t, ok)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		boundProvider := contextProvider.WithContext(ctx)
+
+		_, err := boundProvider.StopProject(&provider.ProjectRequest{Project: project1})
+		require.Error(t, err)
+
+		_, err = boundProvider.(provider.StreamingProvider).StreamStartProject(&provider.ProjectRequest{Project: project1}, func(provider.ProviderMessage) {})
+		require.Error(t, err)
+
+		// Calls of the original client are not bound to the context
+		_, err = p.GetInfo()
+		require.NoError(t, err)
+	})
+}
@@ -3,7 +3,31 @@
 
 package manager
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrChecksumMissing  = errors.New("the provider version has no checksum. Use `daytona provider install --allow-unsigned` to install it anyway")
+	ErrChecksumMismatch = errors.New("the checksum of the downloaded provider does not match")
+	ErrSignatureMissing = errors.New("the provider version is not signed. Use `daytona provider install --allow-unsigned` to install it anyway")
+	ErrSignatureInvalid = errors.New("the signature of the downloaded provider is not valid")
+)
+
+var ErrProviderRestartDelayed = errors.New("the provider is not responding and is waiting to be restarted")
+
+var ErrNoPreviousProviderVersion = errors.New("no previous version of the provider is installed")
+
+func IsNoPreviousProviderVersion(err error) bool {
+	return err.Error() == ErrNoPreviousProviderVersion.Error()
+}
+
+// IsProviderVerificationFailed returns true if the downloaded provider was rejected by checksum or signature verification
+func IsProviderVerificationFailed(err error) bool {
+	return errors.Is(err, ErrChecksumMissing) || errors.Is(err, ErrChecksumMismatch) ||
+		errors.Is(err, ErrSignatureMissing) || errors.Is(err, ErrSignatureInvalid)
+}
 
 func IsProviderAlreadyDownloaded(err error, name string) bool {
 	return err.Error() == providerAlreadyDownloadedError(name).Error()
@@ -0,0 +1,295 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Runs every 30 seconds
+const healthCheckInterval = "*/30 * * * * *"
+
+const (
+	restartBackoffMin = 30 * time.Second
+	restartBackoffMax = 10 * time.Minute
+)
+
+// A provider that does not answer the ping in time is considered unhealthy
+const pingTimeout = 10 * time.Second
+
+type ProviderHealth struct {
+	Healthy           bool      `json:"healthy" validate:"required"`
+	CrashCount        int       `json:"crashCount" validate:"required"`
+	LastError         *string   `json:"lastError,omitempty" validate:"optional"`
+	LastCheckedAt     time.Time `json:"lastCheckedAt" validate:"required"`
+	UnmetRequirements []string  `json:"unmetRequirements,omitempty" validate:"optional"`
+} // @name ProviderHealth
+
+type providerHealthState struct {
+	health          ProviderHealth
+	label           *string
+	version         string
+	restartAttempts int
+	nextRestartAt   time.Time
+	restarting      bool
+}
+
+// StartHealthChecks periodically pings the registered providers and restarts the ones that stopped responding.
+// Restarts of a provider that keeps failing are delayed with an exponential backoff
+func (m *ProviderManager) StartHealthChecks() error {
+	if m.scheduler == nil {
+		return errors.New("scheduler not configured")
+	}
+
+	err := m.scheduler.AddFunc(healthCheckInterval, m.CheckProvidersHealth)
+	if err != nil {
+		return err
+	}
+
+	m.scheduler.Start()
+	return nil
+}
+
+func (m *ProviderManager) CheckProvidersHealth() {
+	for _, name := range m.getPluginNames() {
+		m.checkProviderHealth(name)
+	}
+}
+
+// GetProvidersHealth returns the last known health of all providers checked by the supervisor,
+// including the ones that could not be restarted
+func (m *ProviderManager) GetProvidersHealth() map[string]ProviderHealth {
+	m.healthMutex.RLock()
+	defer m.healthMutex.RUnlock()
+
+	result := make(map[string]ProviderHealth)
+	for name, state := range m.healthStates {
+		result[name] = state.health
+	}
+
+	return result
+}
+
+// GetProviderLastKnownInfo returns the label and version of the provider at its last successful health check
+func (m *ProviderManager) GetProviderLastKnownInfo(name string) (*string, string) {
+	m.healthMutex.RLock()
+	defer m.healthMutex.RUnlock()
+
+	state, ok := m.healthStates[name]
+	if !ok {
+		return nil, ""
+	}
+
+	return state.label, state.version
+}
+
+func (m *ProviderManager) checkProviderHealth(name string) {
+	pluginRef, ok := m.getPluginRef(name)
+	if !ok {
+		return
+	}
+
+	m.healthMutex.Lock()
+	state := m.getHealthState(name)
+	now := time.Now()
+	if state.restarting || now.Before(state.nextRestartAt) {
+		m.healthMutex.Unlock()
+		return
+	}
+
+	isFirstCheck := state.health.LastCheckedAt.IsZero()
+	state.health.LastCheckedAt = now
+	m.healthMutex.Unlock()
+
+	// The provider is pinged and restarted without holding the lock so that a slow provider
+	// does not block reading the health of the other providers
+	err := pingProvider(pluginRef)
+	if err == nil {
+		m.updateHealthState(name, func(state *providerHealthState) {
+			state.health.Healthy = true
+		})
+		if isFirstCheck {
+			m.updateProviderDetails(name)
+		}
+		return
+	}
+
+	m.updateHealthState(name, func(state *providerHealthState) {
+		// Failed restarts are not counted as crashes
+		if state.health.Healthy || isFirstCheck {
+			state.health.CrashCount++
+		}
+		state.health.Healthy = false
+		state.health.LastError = errorString(err)
+	})
+	log.Warnf("Provider %s is not responding: %s. Restarting", name, err)
+
+	_, err = m.restartProvider(name, pluginRef)
+	if err != nil {
+		return
+	}
+
+	log.Infof("Provider %s restarted", name)
+
+	m.updateProviderDetails(name)
+}
+
+// restartProvider kills the provider process and starts it again.
+// Restarts of a provider that keeps failing are delayed with an exponential backoff
+func (m *ProviderManager) restartProvider(name string, pluginRef *pluginRef) (*pluginRef, error) {
+	m.healthMutex.Lock()
+	state := m.getHealthState(name)
+	if state.restarting || time.Now().Before(state.nextRestartAt) {
+		m.healthMutex.Unlock()
+		return nil, ErrProviderRestartDelayed
+	}
+	state.restarting = true
+	m.healthMutex.Unlock()
+
+	pluginRef.kill()
+	newPluginRef, err := m.initializeProvider(providerBinaryPath(pluginRef.path, name))
+
+	m.healthMutex.Lock()
+	defer m.healthMutex.Unlock()
+
+	// The provider may have been uninstalled or replaced while it was restarting
+	state, ok := m.healthStates[name]
+	if !ok {
+		if err == nil {
+			newPluginRef.kill()
+		}
+		return nil, errors.New("provider not found")
+	}
+	state.restarting = false
+
+	if err != nil {
+		backoff := restartBackoffMin << state.restartAttempts
+		if backoff > restartBackoffMax || backoff <= 0 {
+			backoff = restartBackoffMax
+		}
+		state.restartAttempts++
+		state.nextRestartAt = time.Now().Add(backoff)
+		state.health.Healthy = false
+		state.health.LastError = errorString(fmt.Errorf("failed to restart provider: %w", err))
+
+		log.Errorf("Failed to restart provider %s: %s. Retrying in %s", name, err, backoff)
+		return nil, err
+	}
+
+	if _, ok := m.getPluginRef(name); !ok {
+		newPluginRef.kill()
+		return nil, errors.New("provider not found")
+	}
+	m.setPluginRef(name, newPluginRef)
+
+	state.restartAttempts = 0
+	state.nextRestartAt = time.Time{}
+	state.health.Healthy = true
+
+	return newPluginRef, nil
+}
+
+// updateProviderDetails caches the provider info and checks its requirements
+func (m *ProviderManager) updateProviderDetails(name string) {
+	p, err := m.GetProvider(name)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	info, infoErr := (*p).GetInfo()
+	requirements, err := (*p).CheckRequirements()
+
+	m.updateHealthState(name, func(state *providerHealthState) {
+		if infoErr == nil {
+			state.label = info.Label
+			state.version = info.Version
+		}
+
+		if err != nil {
+			state.health.LastError = errorString(fmt.Errorf("failed to check requirements: %w", err))
+			return
+		}
+
+		state.health.UnmetRequirements = nil
+		for _, req := range *requirements {
+			if !req.Met {
+				log.Warnf("Provider %s requirement not met: %s", name, req.Reason)
+				state.health.UnmetRequirements = append(state.health.UnmetRequirements, req.Reason)
+			}
+		}
+	})
+}
+
+// getHealthState returns the health state of the provider, creating it if needed. The caller must hold healthMutex
+func (m *ProviderManager) getHealthState(name string) *providerHealthState {
+	state, ok := m.healthStates[name]
+	if !ok {
+		state = &providerHealthState{}
+		m.healthStates[name] = state
+	}
+
+	return state
+}
+
+// updateHealthState updates the health state of the provider unless it was uninstalled in the meantime
+func (m *ProviderManager) updateHealthState(name string, update func(state *providerHealthState)) {
+	m.healthMutex.Lock()
+	defer m.healthMutex.Unlock()
+
+	state, ok := m.healthStates[name]
+	if !ok {
+		return
+	}
+
+	update(state)
+}
+
+func (m *ProviderManager) deleteHealthState(name string) {
+	m.healthMutex.Lock()
+	defer m.healthMutex.Unlock()
+
+	delete(m.healthStates, name)
+}
+
+func pingProvider(pluginRef *pluginRef) error {
+	// In-process providers run as long as the server does
+	if pluginRef.impl != nil {
+		return nil
+	}
+
+	if pluginRef.client.Exited() {
+		return errors.New("provider process exited")
+	}
+
+	rpcClient, err := pluginRef.client.Client()
+	if err != nil {
+		return err
+	}
+
+	// The ping has no deadline of its own so it is abandoned if a hung provider does not answer it
+	result := make(chan error, 1)
+	go func() {
+		result <- rpcClient.Ping()
+	}()
+
+	timer := time.NewTimer(pingTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("provider did not respond to ping within %s", pingTimeout)
+	}
+}
+
+func errorString(err error) *string {
+	s := err.Error()
+	return &s
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+)
+
+// newCrashedProviderManager registers a provider whose process can not be started so that every restart fails
+func newCrashedProviderManager(t *testing.T) *ProviderManager {
+	m := newTestProviderManager(t)
+	providerDir := filepath.Join(m.baseDir, testProviderName)
+
+	m.setPluginRef(testProviderName, &pluginRef{
+		client: plugin.NewClient(&plugin.ClientConfig{
+			HandshakeConfig: ProviderHandshakeConfig,
+			Cmd:             exec.Command(providerBinaryPath(providerDir, testProviderName)),
+		}),
+		path: providerDir,
+		name: testProviderName,
+	})
+
+	return m
+}
+
+func TestCheckProvidersHealth(t *testing.T) {
+	t.Run("Delays restarts of a provider that fails to restart", func(t *testing.T) {
+		m := newCrashedProviderManager(t)
+
+		m.CheckProvidersHealth()
+
+		health := m.GetProvidersHealth()[testProviderName]
+		require.False(t, health.Healthy)
+		require.Equal(t, 1, health.CrashCount)
+		require.NotNil(t, health.LastError)
+		require.Contains(t, *health.LastError, "failed to restart provider")
+
+		m.healthMutex.RLock()
+		state := *m.healthStates[testProviderName]
+		m.healthMutex.RUnlock()
+
+		require.False(t, state.restarting)
+		require.Equal(t, 1, state.restartAttempts)
+		require.WithinDuration(t, time.Now().Add(restartBackoffMin), state.nextRestartAt, 5*time.Second)
+
+		// Checks within the backoff do not restart the provider again
+		m.CheckProvidersHealth()
+
+		m.healthMutex.Lock()
+		require.Equal(t, 1, m.healthStates[testProviderName].restartAttempts)
+		m.healthStates[testProviderName].nextRestartAt = time.Now()
+		m.healthMutex.Unlock()
+
+		// Failed restarts double the backoff and are not counted as crashes
+		m.CheckProvidersHealth()
+
+		health = m.GetProvidersHealth()[testProviderName]
+		require.Equal(t, 1, health.CrashCount)
+
+		m.healthMutex.RLock()
+		state = *m.healthStates[testProviderName]
+		m.healthMutex.RUnlock()
+
+		require.Equal(t, 2, state.restartAttempts)
+		require.WithinDuration(t, time.Now().Add(2*restartBackoffMin), state.nextRestartAt, 5*time.Second)
+	})
+
+	t.Run("Does not restart a provider that is already restarting", func(t *testing.T) {
+		m := newCrashedProviderManager(t)
+
+		m.healthMutex.Lock()
+		m.getHealthState(testProviderName).restarting = true
+		m.healthMutex.Unlock()
+
+		m.CheckProvidersHealth()
+
+		health := m.GetProvidersHealth()[testProviderName]
+		require.Zero(t, health.CrashCount)
+		require.True(t, health.LastCheckedAt.IsZero())
+	})
+}
+
+func TestGetProviderRestartBackoff(t *testing.T) {
+	m := newCrashedProviderManager(t)
+
+	_, err := m.GetProvider(testProviderName)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrProviderRestartDelayed)
+
+	m.healthMutex.RLock()
+	require.Equal(t, 1, m.healthStates[testProviderName].restartAttempts)
+	m.healthMutex.RUnlock()
+
+	// The provider is not reinitialized again until the backoff expires
+	_, err = m.GetProvider(testProviderName)
+	require.ErrorIs(t, err, ErrProviderRestartDelayed)
+
+	m.CheckProvidersHealth()
+
+	m.healthMutex.RLock()
+	require.Equal(t, 1, m.healthStates[testProviderName].restartAttempts)
+	m.healthMutex.RUnlock()
+}
@@ -41,11 +41,11 @@ func (m *ProviderManager) GetProvidersManifest() (*ProvidersManifest, error) {
 	return &manifest, nil
 }
 
-func (m *ProviderManager) DownloadProvider(ctx context.Context, downloadUrls map[os.OperatingSystem]string, providerName string) (string, error) {
-	downloadPath := filepath.Join(m.baseDir, providerName, providerName)
-	if runtime.GOOS == "windows" {
-		downloadPath += ".exe"
-	}
+// DownloadProvider downloads the provider binary for the current operating system and verifies it against
+// the checksum and signature of the version. Verification can only be skipped for binaries without a checksum
+// or signature by setting allowUnsigned - a checksum or signature that does not match is always rejected
+func (m *ProviderManager) DownloadProvider(ctx context.Context, version Version, providerName string, allowUnsigned bool) (string, error) {
+	downloadPath := providerBinaryPath(filepath.Join(m.baseDir, providerName), providerName)
 
 	if _, err := goos.Stat(downloadPath); err == nil {
 		return "", providerAlreadyDownloadedError(providerName)
@@ -58,10 +58,25 @@ func (m *ProviderManager) DownloadProvider(ctx context.Context, downloadUrls map
 		return "", err
 	}
 
-	err = os.DownloadFile(ctx, downloadUrls[*operatingSystem], downloadPath)
+	err = os.DownloadFile(ctx, version.DownloadUrls[*operatingSystem], downloadPath)
 	if err != nil {
 		return "", err
 	}
 
+	err = m.verifyProvider(downloadPath, version.Checksums[*operatingSystem], version.Signatures[*operatingSystem], allowUnsigned)
+	if err != nil {
+		goos.Remove(downloadPath)
+		return "", fmt.Errorf("failed to verify provider %s: %w", providerName, err)
+	}
+
 	return downloadPath, nil
 }
+
+func providerBinaryPath(dir, providerName string) string {
+	binaryPath := filepath.Join(dir, providerName)
+	if runtime.GOOS == "windows" {
+		binaryPath += ".exe"
+	}
+
+	return binaryPath
+}
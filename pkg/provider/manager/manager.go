@@ -12,10 +12,13 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/events"
 	os_util "github.com/daytonaio/daytona/pkg/os"
 	. "github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/scheduler"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
@@ -25,41 +28,70 @@ import (
 
 const INITIAL_SETUP_LOCK_FILE_NAME = "initial-setup.lock"
 
+// PREVIOUS_VERSION_DIR_NAME is the directory next to the provider binary that holds the previously installed binary
+const PREVIOUS_VERSION_DIR_NAME = "previous"
+
 type pluginRef struct {
 	client *plugin.Client
-	path   string
-	name   string
+	// impl is set for in-process providers which are not served by a plugin client
+	impl Provider
+	path string
+	name string
 }
 
+func (r *pluginRef) kill() {
+	if r.client != nil {
+		r.client.Kill()
+	}
+}
+
+const (
+	// ProviderProtocolVersionNetRPC is the original plugin protocol. Providers write their logs to files under LogsDir
+	ProviderProtocolVersionNetRPC = 1
+	// ProviderProtocolVersionGRPC streams project logs and progress back to the server
+	ProviderProtocolVersionGRPC = 2
+)
+
 var ProviderHandshakeConfig = plugin.HandshakeConfig{
-	ProtocolVersion:  1,
+	ProtocolVersion:  ProviderProtocolVersionNetRPC,
 	MagicCookieKey:   "DAYTONA_PROVIDER_PLUGIN",
 	MagicCookieValue: "daytona_provider",
 }
 
 type IProviderManager interface {
-	DownloadProvider(ctx context.Context, downloadUrls map[os_util.OperatingSystem]string, providerName string) (string, error)
+	DownloadProvider(ctx context.Context, version Version, providerName string, allowUnsigned bool) (string, error)
 	GetProvider(name string) (*Provider, error)
 	GetProviders() map[string]Provider
 	GetProvidersManifest() (*ProvidersManifest, error)
 	RegisterProvider(pluginPath string, manualInstall bool) error
+	RegisterInProcessProvider(name string, impl Provider) error
 	TerminateProviderProcesses(providersBasePath string) error
 	UninstallProvider(name string) error
+	BackupProvider(name string) error
+	DiscardProvider(name string) error
+	RollbackProvider(name string) error
+	StartHealthChecks() error
+	GetProvidersHealth() map[string]ProviderHealth
+	GetProviderLastKnownInfo(name string) (*string, string)
 	Purge() error
 }
 
 type ProviderManagerConfig struct {
-	DaytonaDownloadUrl       string
-	ServerUrl                string
-	ServerVersion            string
-	ApiUrl                   string
-	LogsDir                  string
-	ProviderTargetService    providertargets.IProviderTargetService
-	RegistryUrl              string
+	DaytonaDownloadUrl    string
+	ServerUrl             string
+	ServerVersion         string
+	ApiUrl                string
+	LogsDir               string
+	ProviderTargetService providertargets.IProviderTargetService
+	RegistryUrl           string
+	// Base64 encoded ed25519 public key used to verify provider signatures
+	ProviderPublicKey        string
 	BaseDir                  string
 	CreateProviderNetworkKey func(providerName string) (string, error)
 	ServerPort               uint32
 	ApiPort                  uint32
+	EventBus                 *events.EventBus
+	Scheduler                scheduler.IScheduler
 }
 
 func NewProviderManager(config ProviderManagerConfig) *ProviderManager {
@@ -72,15 +104,20 @@ func NewProviderManager(config ProviderManagerConfig) *ProviderManager {
 		logsDir:                  config.LogsDir,
 		providerTargetService:    config.ProviderTargetService,
 		registryUrl:              config.RegistryUrl,
+		providerPublicKey:        config.ProviderPublicKey,
 		baseDir:                  config.BaseDir,
 		createProviderNetworkKey: config.CreateProviderNetworkKey,
 		serverPort:               config.ServerPort,
 		apiPort:                  config.ApiPort,
+		eventBus:                 config.EventBus,
+		scheduler:                config.Scheduler,
+		healthStates:             make(map[string]*providerHealthState),
 	}
 }
 
 type ProviderManager struct {
 	pluginRefs               map[string]*pluginRef
+	pluginRefsMutex          sync.RWMutex
 	daytonaDownloadUrl       string
 	serverUrl                string
 	serverVersion            string
@@ -90,27 +127,34 @@ type ProviderManager struct {
 	logsDir                  string
 	providerTargetService    providertargets.IProviderTargetService
 	registryUrl              string
+	providerPublicKey        string
 	baseDir                  string
 	createProviderNetworkKey func(providerName string) (string, error)
+	eventBus                 *events.EventBus
+	scheduler                scheduler.IScheduler
+	healthStates             map[string]*providerHealthState
+	healthMutex              sync.RWMutex
 }
 
 func (m *ProviderManager) GetProvider(name string) (*Provider, error) {
-	pluginRef, ok := m.pluginRefs[name]
+	pluginRef, ok := m.getPluginRef(name)
 	if !ok {
 		return nil, errors.New("provider not found")
 	}
 
+	if pluginRef.impl != nil {
+		p := pluginRef.impl
+		return &p, nil
+	}
+
 	p, err := m.dispenseProvider(pluginRef.client, name)
 	if err != nil {
-		// Attempt to reinitialize the provider
-		pluginRef.client.Kill()
-		pluginRef, err := m.initializeProvider(filepath.Join(pluginRef.path, name))
+		// Attempt to reinitialize the provider. Failed attempts delay the next ones the same way the health checks do
+		pluginRef, err := m.restartProvider(name, pluginRef)
 		if err != nil {
 			return nil, err
 		}
 
-		m.pluginRefs[name] = pluginRef
-
 		return m.dispenseProvider(pluginRef.client, name)
 	}
 
@@ -119,7 +163,7 @@ func (m *ProviderManager) GetProvider(name string) (*Provider, error) {
 
 func (m *ProviderManager) GetProviders() map[string]Provider {
 	providers := make(map[string]Provider)
-	for name := range m.pluginRefs {
+	for _, name := range m.getPluginNames() {
 		provider, err := m.GetProvider(name)
 		if err != nil {
 			log.Printf("Error getting provider %s: %s", name, err)
@@ -138,10 +182,32 @@ func (m *ProviderManager) RegisterProvider(pluginPath string, manualInstall bool
 		return err
 	}
 
-	m.pluginRefs[pluginRef.name] = pluginRef
+	return m.registerPluginRef(pluginRef, manualInstall)
+}
+
+// RegisterInProcessProvider registers a provider that runs inside the server process instead of a plugin binary
+func (m *ProviderManager) RegisterInProcessProvider(name string, impl Provider) error {
+	basePath := filepath.Join(m.baseDir, name)
+
+	_, err := impl.Initialize(m.getInitializeProviderRequest(basePath, ""))
+	if err != nil {
+		return errors.New("failed to initialize provider: " + err.Error())
+	}
+
+	log.Infof("Provider %s registered in-process", name)
+
+	return m.registerPluginRef(&pluginRef{
+		impl: impl,
+		path: basePath,
+		name: name,
+	}, false)
+}
+
+func (m *ProviderManager) registerPluginRef(pluginRef *pluginRef, manualInstall bool) error {
+	m.setPluginRef(pluginRef.name, pluginRef)
 
 	lockFilePath := filepath.Join(pluginRef.path, INITIAL_SETUP_LOCK_FILE_NAME)
-	_, err = os.Stat(lockFilePath)
+	_, err := os.Stat(lockFilePath)
 	if os.IsNotExist(err) || manualInstall {
 		p, err := m.GetProvider(pluginRef.name)
 		if err != nil {
@@ -177,15 +243,19 @@ func (m *ProviderManager) RegisterProvider(pluginPath string, manualInstall bool
 
 	log.Infof("Provider %s initialized", pluginRef.name)
 
+	event := events.NewEvent(events.EventTypeProviderInstalled)
+	event.Provider = pluginRef.name
+	m.eventBus.Publish(event)
+
 	return nil
 }
 
 func (m *ProviderManager) UninstallProvider(name string) error {
-	pluginRef, ok := m.pluginRefs[name]
+	pluginRef, ok := m.getPluginRef(name)
 	if !ok {
 		return errors.New("provider not found")
 	}
-	pluginRef.client.Kill()
+	pluginRef.kill()
 
 	lockFileExisted := false
 	lockFilePath := filepath.Join(pluginRef.path, INITIAL_SETUP_LOCK_FILE_NAME)
@@ -216,7 +286,8 @@ func (m *ProviderManager) UninstallProvider(name string) error {
 		defer file.Close()
 	}
 
-	delete(m.pluginRefs, name)
+	m.deletePluginRef(name)
+	m.deleteHealthState(name)
 
 	return nil
 }
@@ -241,7 +312,7 @@ func (m *ProviderManager) TerminateProviderProcesses(providersBasePath string) e
 }
 
 func (m *ProviderManager) Purge() error {
-	for name := range m.pluginRefs {
+	for _, name := range m.getPluginNames() {
 		err := m.UninstallProvider(name)
 		if err != nil {
 			return err
@@ -272,15 +343,17 @@ func (m *ProviderManager) initializeProvider(pluginPath string) (*pluginRef, err
 		Level:  hclog.Debug,
 	})
 
-	pluginMap := map[string]plugin.Plugin{}
-	pluginMap[pluginName] = &ProviderPlugin{}
-
+	// The highest protocol version supported by both the server and the provider is negotiated on start
 	client := plugin.NewClient(&plugin.ClientConfig{
 		HandshakeConfig: ProviderHandshakeConfig,
-		Plugins:         pluginMap,
-		Cmd:             exec.Command(pluginPath),
-		Logger:          logger,
-		Managed:         true,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			ProviderProtocolVersionNetRPC: {pluginName: &ProviderPlugin{}},
+			ProviderProtocolVersionGRPC:   {pluginName: &ProviderGRPCPlugin{}},
+		},
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolNetRPC, plugin.ProtocolGRPC},
+		Cmd:              exec.Command(pluginPath),
+		Logger:           logger,
+		Managed:          true,
 	})
 
 	log.Infof("Provider %s registered", pluginName)
@@ -290,22 +363,14 @@ func (m *ProviderManager) initializeProvider(pluginPath string) (*pluginRef, err
 		return nil, errors.New("failed to initialize provider: " + err.Error())
 	}
 
+	log.Debugf("Provider %s uses plugin protocol version %d", pluginName, client.NegotiatedVersion())
+
 	networkKey, err := m.createProviderNetworkKey(pluginName)
 	if err != nil {
 		return nil, errors.New("failed to create network key: " + err.Error())
 	}
 
-	_, err = (*p).Initialize(InitializeProviderRequest{
-		BasePath:           pluginBasePath,
-		DaytonaDownloadUrl: m.daytonaDownloadUrl,
-		DaytonaVersion:     m.serverVersion,
-		ServerUrl:          m.serverUrl,
-		ApiUrl:             m.apiUrl,
-		LogsDir:            m.logsDir,
-		NetworkKey:         networkKey,
-		ServerPort:         m.serverPort,
-		ApiPort:            m.apiPort,
-	})
+	_, err = (*p).Initialize(m.getInitializeProviderRequest(pluginBasePath, networkKey))
 	if err != nil {
 		return nil, errors.New("failed to initialize provider: " + err.Error())
 	}
@@ -317,6 +382,54 @@ func (m *ProviderManager) initializeProvider(pluginPath string) (*pluginRef, err
 	}, nil
 }
 
+func (m *ProviderManager) getPluginRef(name string) (*pluginRef, bool) {
+	m.pluginRefsMutex.RLock()
+	defer m.pluginRefsMutex.RUnlock()
+
+	pluginRef, ok := m.pluginRefs[name]
+	return pluginRef, ok
+}
+
+func (m *ProviderManager) setPluginRef(name string, pluginRef *pluginRef) {
+	m.pluginRefsMutex.Lock()
+	defer m.pluginRefsMutex.Unlock()
+
+	m.pluginRefs[name] = pluginRef
+}
+
+func (m *ProviderManager) deletePluginRef(name string) {
+	m.pluginRefsMutex.Lock()
+	defer m.pluginRefsMutex.Unlock()
+
+	delete(m.pluginRefs, name)
+}
+
+func (m *ProviderManager) getPluginNames() []string {
+	m.pluginRefsMutex.RLock()
+	defer m.pluginRefsMutex.RUnlock()
+
+	names := []string{}
+	for name := range m.pluginRefs {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func (m *ProviderManager) getInitializeProviderRequest(basePath, networkKey string) InitializeProviderRequest {
+	return InitializeProviderRequest{
+		BasePath:           basePath,
+		DaytonaDownloadUrl: m.daytonaDownloadUrl,
+		DaytonaVersion:     m.serverVersion,
+		ServerUrl:          m.serverUrl,
+		ApiUrl:             m.apiUrl,
+		LogsDir:            m.logsDir,
+		NetworkKey:         networkKey,
+		ServerPort:         m.serverPort,
+		ApiPort:            m.apiPort,
+	}
+}
+
 func (m *ProviderManager) dispenseProvider(client *plugin.Client, name string) (*Provider, error) {
 	rpcClient, err := client.Client()
 	if err != nil {
@@ -335,3 +448,17 @@ func (m *ProviderManager) dispenseProvider(client *plugin.Client, name string) (
 
 	return &provider, nil
 }
+
+// NewProviderServeConfig returns the plugin serve config for a provider binary.
+// The provider is served over both the net/rpc and the gRPC protocol so it can be loaded by older servers
+func NewProviderServeConfig(pluginName string, impl Provider, logger hclog.Logger) *plugin.ServeConfig {
+	return &plugin.ServeConfig{
+		HandshakeConfig: ProviderHandshakeConfig,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			ProviderProtocolVersionNetRPC: {pluginName: &ProviderPlugin{Impl: impl}},
+			ProviderProtocolVersionGRPC:   {pluginName: &ProviderGRPCPlugin{Impl: impl}},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+		Logger:     logger,
+	}
+}
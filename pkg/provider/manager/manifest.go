@@ -10,6 +10,10 @@ import (
 
 type Version struct {
 	DownloadUrls map[os.OperatingSystem]string `json:"downloadUrls"`
+	// Hex encoded SHA-256 checksums of the provider binaries
+	Checksums map[os.OperatingSystem]string `json:"checksums,omitempty"`
+	// Base64 encoded ed25519 signatures of the provider binaries
+	Signatures map[os.OperatingSystem]string `json:"signatures,omitempty"`
 }
 
 type ProvidersManifest map[string]ProviderManifest
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// verifyProvider checks the SHA-256 checksum of the provider binary and, if a provider public key is configured,
+// its ed25519 signature
+func (m *ProviderManager) verifyProvider(path, checksum, signature string, allowUnsigned bool) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if checksum == "" {
+		if !allowUnsigned {
+			return ErrChecksumMissing
+		}
+		log.Warnf("Skipping checksum verification of %s", path)
+	} else {
+		sum := sha256.Sum256(content)
+		if !strings.EqualFold(hex.EncodeToString(sum[:]), checksum) {
+			return ErrChecksumMismatch
+		}
+	}
+
+	if m.providerPublicKey == "" {
+		log.Warnf("Skipping signature verification of %s because no provider public key is configured", path)
+		return nil
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(m.providerPublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return errors.New("provider public key is not a valid base64 encoded ed25519 public key")
+	}
+
+	if signature == "" {
+		if !allowUnsigned {
+			return ErrSignatureMissing
+		}
+		log.Warnf("Skipping signature verification of %s", path)
+		return nil
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || !ed25519.Verify(ed25519.PublicKey(publicKey), content, sig) {
+		return ErrSignatureInvalid
+	}
+
+	return nil
+}
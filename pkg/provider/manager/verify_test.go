@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/os"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/stretchr/testify/require"
+)
+
+var providerBinary = []byte("provider binary")
+
+func TestDownloadProviderVerification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(providerBinary) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	operatingSystem, err := os.GetOperatingSystem()
+	require.NoError(t, err)
+
+	sum := sha256.Sum256(providerBinary)
+	checksum := hex.EncodeToString(sum[:])
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, providerBinary))
+
+	newVersion := func(checksum, signature string) manager.Version {
+		return manager.Version{
+			DownloadUrls: map[os.OperatingSystem]string{*operatingSystem: server.URL},
+			Checksums:    map[os.OperatingSystem]string{*operatingSystem: checksum},
+			Signatures:   map[os.OperatingSystem]string{*operatingSystem: signature},
+		}
+	}
+
+	newManager := func() *manager.ProviderManager {
+		return manager.NewProviderManager(manager.ProviderManagerConfig{
+			BaseDir:           t.TempDir(),
+			ProviderPublicKey: base64.StdEncoding.EncodeToString(publicKey),
+		})
+	}
+
+	t.Run("Providers with a valid checksum and signature are downloaded", func(t *testing.T) {
+		downloadPath, err := newManager().DownloadProvider(context.Background(), newVersion(checksum, signature), "test-provider", false)
+		require.NoError(t, err)
+		require.FileExists(t, downloadPath)
+	})
+
+	t.Run("Checksum mismatch is rejected", func(t *testing.T) {
+		otherSum := sha256.Sum256([]byte("other binary"))
+
+		_, err := newManager().DownloadProvider(context.Background(), newVersion(hex.EncodeToString(otherSum[:]), signature), "test-provider", true)
+		require.ErrorIs(t, err, manager.ErrChecksumMismatch)
+		require.True(t, manager.IsProviderVerificationFailed(err))
+	})
+
+	t.Run("Invalid signature is rejected", func(t *testing.T) {
+		otherSignature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("other binary")))
+
+		_, err := newManager().DownloadProvider(context.Background(), newVersion(checksum, otherSignature), "test-provider", true)
+		require.ErrorIs(t, err, manager.ErrSignatureInvalid)
+		require.True(t, manager.IsProviderVerificationFailed(err))
+	})
+
+	t.Run("Unsigned providers are rejected unless allowed", func(t *testing.T) {
+		_, err := newManager().DownloadProvider(context.Background(), newVersion("", ""), "test-provider", false)
+		require.ErrorIs(t, err, manager.ErrChecksumMissing)
+		require.True(t, manager.IsProviderVerificationFailed(err))
+
+		_, err = newManager().DownloadProvider(context.Background(), newVersion(checksum, ""), "test-provider", false)
+		require.ErrorIs(t, err, manager.ErrSignatureMissing)
+
+		downloadPath, err := newManager().DownloadProvider(context.Background(), newVersion("", ""), "test-provider", true)
+		require.NoError(t, err)
+		require.FileExists(t, downloadPath)
+	})
+}
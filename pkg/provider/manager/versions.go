@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// BackupProvider stops the provider and moves its binary to the previous version directory
+// so that a new version can be downloaded. Only the last backup is kept
+func (m *ProviderManager) BackupProvider(name string) error {
+	pluginRef, ok := m.getPluginRef(name)
+	if !ok {
+		return errors.New("provider not found")
+	}
+	pluginRef.kill()
+	m.deletePluginRef(name)
+	m.deleteHealthState(name)
+
+	previousDir := filepath.Join(m.baseDir, name, PREVIOUS_VERSION_DIR_NAME)
+
+	err := os.RemoveAll(previousDir)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(previousDir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(providerBinaryPath(filepath.Join(m.baseDir, name), name), providerBinaryPath(previousDir, name))
+}
+
+// DiscardProvider stops the provider and removes its binary so that a failed install can be retried.
+// The previous version directory is kept
+func (m *ProviderManager) DiscardProvider(name string) error {
+	if pluginRef, ok := m.getPluginRef(name); ok {
+		pluginRef.kill()
+		m.deletePluginRef(name)
+		m.deleteHealthState(name)
+	}
+
+	err := os.Remove(providerBinaryPath(filepath.Join(m.baseDir, name), name))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// RollbackProvider swaps the provider binary with the previously installed one and registers the provider again.
+// The replaced binary becomes the previous version so the rollback can be undone
+func (m *ProviderManager) RollbackProvider(name string) error {
+	currentPath := providerBinaryPath(filepath.Join(m.baseDir, name), name)
+	previousPath := providerBinaryPath(filepath.Join(m.baseDir, name, PREVIOUS_VERSION_DIR_NAME), name)
+
+	_, err := os.Stat(previousPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrNoPreviousProviderVersion
+		}
+		return err
+	}
+
+	if pluginRef, ok := m.getPluginRef(name); ok {
+		pluginRef.kill()
+		m.deletePluginRef(name)
+		m.deleteHealthState(name)
+	}
+
+	swapPath := previousPath + ".swap"
+
+	_, err = os.Stat(currentPath)
+	if err == nil {
+		err = os.Rename(currentPath, swapPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = os.Rename(previousPath, currentPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stat(swapPath)
+	if err == nil {
+		err = os.Rename(swapPath, previousPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.RegisterProvider(currentPath, false)
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testProviderName = "test-provider"
+
+func newTestProviderManager(t *testing.T) *ProviderManager {
+	m := NewProviderManager(ProviderManagerConfig{
+		BaseDir: t.TempDir(),
+	})
+
+	m.setPluginRef(testProviderName, &pluginRef{
+		path: filepath.Join(m.baseDir, testProviderName),
+		name: testProviderName,
+	})
+
+	writeProviderBinary(t, filepath.Join(m.baseDir, testProviderName), "v1")
+
+	return m
+}
+
+// writeProviderBinary writes a provider binary that fails to start so that registering it fails
+func writeProviderBinary(t *testing.T, dir, content string) {
+	err := os.MkdirAll(dir, os.ModePerm)
+	require.NoError(t, err)
+
+	err = os.WriteFile(providerBinaryPath(dir, testProviderName), []byte(content), 0644)
+	require.NoError(t, err)
+}
+
+func requireProviderBinary(t *testing.T, dir, content string) {
+	binary, err := os.ReadFile(providerBinaryPath(dir, testProviderName))
+	require.NoError(t, err)
+	require.Equal(t, content, string(binary))
+}
+
+func TestBackupProvider(t *testing.T) {
+	m := newTestProviderManager(t)
+	providerDir := filepath.Join(m.baseDir, testProviderName)
+	previousDir := filepath.Join(providerDir, PREVIOUS_VERSION_DIR_NAME)
+
+	err := m.BackupProvider(testProviderName)
+	require.NoError(t, err)
+
+	require.NoFileExists(t, providerBinaryPath(providerDir, testProviderName))
+	requireProviderBinary(t, previousDir, "v1")
+
+	_, ok := m.getPluginRef(testProviderName)
+	require.False(t, ok)
+
+	err = m.BackupProvider(testProviderName)
+	require.EqualError(t, err, "provider not found")
+}
+
+func TestRollbackProvider(t *testing.T) {
+	t.Run("Fails without a previous version", func(t *testing.T) {
+		m := newTestProviderManager(t)
+
+		err := m.RollbackProvider(testProviderName)
+		require.ErrorIs(t, err, ErrNoPreviousProviderVersion)
+	})
+
+	t.Run("Swaps the current and previous versions", func(t *testing.T) {
+		m := newTestProviderManager(t)
+		providerDir := filepath.Join(m.baseDir, testProviderName)
+		previousDir := filepath.Join(providerDir, PREVIOUS_VERSION_DIR_NAME)
+
+		err := m.BackupProvider(testProviderName)
+		require.NoError(t, err)
+		writeProviderBinary(t, providerDir, "v2")
+
+		// The test binaries can not be started so only the swap is checked
+		err = m.RollbackProvider(testProviderName)
+		require.Error(t, err)
+
+		requireProviderBinary(t, providerDir, "v1")
+		requireProviderBinary(t, previousDir, "v2")
+		require.NoFileExists(t, providerBinaryPath(previousDir, testProviderName)+".swap")
+	})
+
+	t.Run("Restores the previous version after a discarded install", func(t *testing.T) {
+		m := newTestProviderManager(t)
+		providerDir := filepath.Join(m.baseDir, testProviderName)
+		previousDir := filepath.Join(providerDir, PREVIOUS_VERSION_DIR_NAME)
+
+		err := m.BackupProvider(testProviderName)
+		require.NoError(t, err)
+		writeProviderBinary(t, providerDir, "v2")
+
+		err = m.DiscardProvider(testProviderName)
+		require.NoError(t, err)
+		require.NoFileExists(t, providerBinaryPath(providerDir, testProviderName))
+		requireProviderBinary(t, previousDir, "v1")
+
+		err = m.RollbackProvider(testProviderName)
+		require.Error(t, err)
+
+		requireProviderBinary(t, providerDir, "v1")
+		require.NoFileExists(t, providerBinaryPath(previousDir, testProviderName))
+	})
+}
@@ -4,12 +4,14 @@
 package provider
 
 import (
+	"context"
 	"net/rpc"
 
 	"github.com/daytonaio/daytona/pkg/provider/util"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
 )
 
 type Provider interface {
@@ -33,6 +35,20 @@ type Provider interface {
 	GetProjectInfo(*ProjectRequest) (*project.ProjectInfo, error)
 }
 
+// StreamingProvider is implemented by providers that stream logs and progress back to the server
+// while a project is created or started. Streaming is only available over the gRPC plugin protocol
+type StreamingProvider interface {
+	StreamCreateProject(*ProjectRequest, func(ProviderMessage)) (*util.Empty, error)
+	StreamStartProject(*ProjectRequest, func(ProviderMessage)) (*util.Empty, error)
+}
+
+// ContextProvider is implemented by providers whose calls can be cancelled with the context of the caller.
+// Only calls over the gRPC plugin protocol can be cancelled
+type ContextProvider interface {
+	WithContext(ctx context.Context) Provider
+}
+
+// ProviderPlugin serves providers over the net/rpc plugin protocol (version 1)
 type ProviderPlugin struct {
 	Impl Provider
 }
@@ -44,3 +60,18 @@ func (p *ProviderPlugin) Server(*plugin.MuxBroker) (interface{}, error) {
 func (p *ProviderPlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
 	return &ProviderRPCClient{client: c}, nil
 }
+
+// ProviderGRPCPlugin serves providers over the gRPC plugin protocol (version 2)
+type ProviderGRPCPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	Impl Provider
+}
+
+func (p *ProviderGRPCPlugin) GRPCServer(b *plugin.GRPCBroker, s *grpc.Server) error {
+	s.RegisterService(&providerServiceDesc, &ProviderGRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (p *ProviderGRPCPlugin) GRPCClient(ctx context.Context, b *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &ProviderGRPCClient{conn: c}, nil
+}
@@ -6,8 +6,9 @@ package provider
 import "errors"
 
 type TargetFilter struct {
-	Name    *string
-	Default *bool
+	Name     *string
+	Default  *bool
+	Template *bool
 }
 
 type TargetStore interface {
@@ -18,9 +19,24 @@ type TargetStore interface {
 }
 
 var (
-	ErrTargetNotFound = errors.New("target not found")
+	ErrTargetNotFound      = errors.New("target not found")
+	ErrTargetAlreadyExists = errors.New("target already exists")
+	ErrTargetIsTemplate    = errors.New("target is a template. Instantiate the template to use it")
+	ErrTargetIsNotTemplate = errors.New("target is not a template")
 )
 
 func IsTargetNotFound(err error) bool {
 	return err.Error() == ErrTargetNotFound.Error()
 }
+
+func IsTargetAlreadyExists(err error) bool {
+	return err.Error() == ErrTargetAlreadyExists.Error()
+}
+
+func IsTargetIsTemplate(err error) bool {
+	return err.Error() == ErrTargetIsTemplate.Error()
+}
+
+func IsTargetIsNotTemplate(err error) bool {
+	return err.Error() == ErrTargetIsNotTemplate.Error()
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// TargetOptionsError is returned when target options do not match the provider target manifest.
+// Option name -> validation error
+type TargetOptionsError map[string]string
+
+func (e TargetOptionsError) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	messages := []string{}
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("%s: %s", name, e[name]))
+	}
+
+	return "invalid target options: " + strings.Join(messages, "; ")
+}
+
+// FieldErrors returns the validation error of each invalid option
+func (e TargetOptionsError) FieldErrors() map[string]string {
+	return e
+}
+
+func IsTargetOptionsError(err error) bool {
+	var targetOptionsErr TargetOptionsError
+	return errors.As(err, &targetOptionsErr)
+}
+
+// ValidateOptions checks the JSON encoded target options against the manifest.
+// Properties disabled for the target are neither required nor validated
+func (m ProviderTargetManifest) ValidateOptions(targetName string, options string) error {
+	return m.validateOptions(targetName, options, false)
+}
+
+// ValidateTemplateOptions checks the JSON encoded options of a target template against the manifest.
+// Values that reference template variables are validated when the template is instantiated
+func (m ProviderTargetManifest) ValidateTemplateOptions(targetName string, options string) error {
+	return m.validateOptions(targetName, options, true)
+}
+
+func (m ProviderTargetManifest) validateOptions(targetName string, options string, isTemplate bool) error {
+	values, err := parseOptions(options)
+	if err != nil {
+		return err
+	}
+
+	errs := TargetOptionsError{}
+
+	for name, property := range m {
+		if property.IsDisabled(targetName) {
+			continue
+		}
+
+		value, ok := values[name]
+		if !ok || value == nil || value == "" {
+			if property.Required {
+				errs[name] = "is required"
+			}
+			continue
+		}
+
+		if isTemplate && hasTemplateVariables(value) {
+			continue
+		}
+
+		err := property.validateValue(value)
+		if err != nil {
+			errs[name] = err.Error()
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// IsDisabled returns true if the DisabledPredicate of the property matches the target name
+func (p ProviderTargetProperty) IsDisabled(targetName string) bool {
+	if p.DisabledPredicate == "" {
+		return false
+	}
+
+	matched, err := regexp.MatchString(p.DisabledPredicate, targetName)
+	return err == nil && matched
+}
+
+func (p ProviderTargetProperty) validateValue(value interface{}) error {
+	switch p.Type {
+	case ProviderTargetPropertyTypeString:
+		if _, ok := value.(string); !ok {
+			return errors.New("must be a string")
+		}
+	case ProviderTargetPropertyTypeInt:
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return errors.New("must be an integer")
+		}
+	case ProviderTargetPropertyTypeFloat:
+		if _, ok := value.(float64); !ok {
+			return errors.New("must be a number")
+		}
+	case ProviderTargetPropertyTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return errors.New("must be a boolean")
+		}
+	case ProviderTargetPropertyTypeOption:
+		option, ok := value.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		if len(p.Options) > 0 && !slices.Contains(p.Options, option) {
+			return fmt.Errorf("must be one of: %s", strings.Join(p.Options, ", "))
+		}
+	case ProviderTargetPropertyTypeFilePath:
+		path, ok := value.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("file %s does not exist", path)
+			}
+			return err
+		}
+		if info.IsDir() {
+			return fmt.Errorf("%s is a directory", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Matches template variable references, e.g. ${region}
+var templateVariableRegex = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+// InterpolateOptions replaces the variable references in the JSON encoded template options with the variable values.
+// A value that consists of a single reference to a variable is converted to the type of the property in the manifest
+func (m ProviderTargetManifest) InterpolateOptions(options string, variables map[string]string) (string, error) {
+	values, err := parseOptions(options)
+	if err != nil {
+		return "", err
+	}
+
+	errs := TargetOptionsError{}
+
+	for name, value := range values {
+		s, ok := value.(string)
+		if !ok || !hasTemplateVariables(s) {
+			continue
+		}
+
+		undefined := []string{}
+		interpolated := templateVariableRegex.ReplaceAllStringFunc(s, func(reference string) string {
+			variable := templateVariableRegex.FindStringSubmatch(reference)[1]
+			v, ok := variables[variable]
+			if !ok {
+				undefined = append(undefined, variable)
+			}
+			return v
+		})
+
+		if len(undefined) > 0 {
+			errs[name] = fmt.Sprintf("references undefined variables: %s", strings.Join(undefined, ", "))
+			continue
+		}
+
+		if templateVariableRegex.FindString(s) != s {
+			values[name] = interpolated
+			continue
+		}
+
+		converted, err := m[name].convertValue(interpolated)
+		if err != nil {
+			errs[name] = err.Error()
+			continue
+		}
+		values[name] = converted
+	}
+
+	if len(errs) > 0 {
+		return "", errs
+	}
+
+	content, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// convertValue converts the string value of a variable to the type of the property
+func (p ProviderTargetProperty) convertValue(value string) (interface{}, error) {
+	switch p.Type {
+	case ProviderTargetPropertyTypeInt:
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not an integer", value)
+		}
+		return v, nil
+	case ProviderTargetPropertyTypeFloat:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a number", value)
+		}
+		return v, nil
+	case ProviderTargetPropertyTypeBoolean:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a boolean", value)
+		}
+		return v, nil
+	}
+
+	return value, nil
+}
+
+func hasTemplateVariables(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && templateVariableRegex.MatchString(s)
+}
+
+func parseOptions(options string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if options == "" {
+		return values, nil
+	}
+
+	err := json.Unmarshal([]byte(options), &values)
+	if err != nil {
+		return nil, TargetOptionsError{"Options": "must be a JSON object"}
+	}
+
+	return values, nil
+}
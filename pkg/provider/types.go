@@ -11,9 +11,39 @@ import (
 )
 
 type ProviderInfo struct {
-	Name    string  `json:"name" validate:"required"`
-	Label   *string `json:"label" validate:"optional"`
-	Version string  `json:"version" validate:"required"`
+	Name         string                `json:"name" validate:"required"`
+	Label        *string               `json:"label" validate:"optional"`
+	Version      string                `json:"version" validate:"required"`
+	Capabilities *ProviderCapabilities `json:"capabilities,omitempty" validate:"optional"`
+}
+
+// GetCapabilities returns the declared capabilities of the provider or DefaultProviderCapabilities if none are declared
+func (i ProviderInfo) GetCapabilities() ProviderCapabilities {
+	if i.Capabilities == nil {
+		return DefaultProviderCapabilities
+	}
+
+	return *i.Capabilities
+}
+
+// ProviderCapabilities declares the features supported by a provider.
+// Providers that do not declare their capabilities are assumed to support everything
+type ProviderCapabilities struct {
+	// The project container can be committed to a snapshot image
+	Snapshots bool `json:"snapshots" validate:"required"`
+	// Project ports can be exposed outside of the Daytona network
+	PortExposure bool `json:"portExposure" validate:"required"`
+	// Workspaces and projects can be stopped and started again
+	StopStart bool `json:"stopStart" validate:"required"`
+	// Workspaces can have more than one project
+	MultiProject bool `json:"multiProject" validate:"required"`
+} // @name ProviderCapabilities
+
+var DefaultProviderCapabilities = ProviderCapabilities{
+	Snapshots:    true,
+	PortExposure: true,
+	StopStart:    true,
+	MultiProject: true,
 }
 
 type InitializeProviderRequest struct {
@@ -51,6 +81,14 @@ type ProviderTarget struct {
 	// JSON encoded map of options
 	Options   string `json:"options" validate:"required"`
 	IsDefault bool   `json:"isDefault" validate:"required"`
+	// Minutes without activity after which projects on the target are stopped
+	IdleTimeout *int `json:"idleTimeout,omitempty" validate:"optional"`
+	// Maximum number of workspace and project operations that run on the target at the same time.
+	// Operations over the limit are queued. Not limited if not set
+	MaxConcurrentOperations *int `json:"maxConcurrentOperations,omitempty" validate:"optional"`
+	// Option values of a template can reference variables, e.g. ${region}, that are set when the template is instantiated.
+	// Templates can not be used to create workspaces
+	IsTemplate bool `json:"isTemplate" validate:"optional"`
 } // @name ProviderTarget
 
 type ProviderTargetManifest map[string]ProviderTargetProperty // @name ProviderTargetManifest
@@ -73,6 +111,8 @@ type ProviderTargetProperty struct {
 	// If the regex matches the target name, the property will be disabled
 	// E.g. "^local$" will disable the property for the local target
 	DisabledPredicate string
+	// Required properties must be set unless they are disabled for the target
+	Required bool
 	// DefaultValue is converted into the appropriate type based on the Type
 	// If the property is a FilePath, the DefaultValue is a path to a directory
 	DefaultValue string
@@ -89,3 +129,16 @@ type RequirementStatus struct {
 	Met    bool
 	Reason string
 } // @name RequirementStatus
+
+type ProviderMessageType string
+
+const (
+	ProviderMessageTypeLog      ProviderMessageType = "log"
+	ProviderMessageTypeProgress ProviderMessageType = "progress"
+)
+
+// ProviderMessage is streamed from the provider to the server while a project is created or started
+type ProviderMessage struct {
+	Type    ProviderMessageType
+	Message string
+}
@@ -4,38 +4,50 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
-	if err != nil {
-		return err
-	}
+func (p *Provisioner) CreateWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return runWithContext(ctx, func() error {
+		targetProvider, err := p.getProvider(ctx, target)
+		if err != nil {
+			return err
+		}
 
-	_, err = (*targetProvider).CreateWorkspace(&provider.WorkspaceRequest{
-		TargetOptions: target.Options,
-		Workspace:     workspace,
-	})
+		_, err = (*targetProvider).CreateWorkspace(&provider.WorkspaceRequest{
+			TargetOptions: target.Options,
+			Workspace:     workspace,
+		})
 
-	return err
+		return err
+	})
 }
 
-func (p *Provisioner) CreateProject(params ProjectParams) error {
-	targetProvider, err := p.providerManager.GetProvider(params.Target.ProviderInfo.Name)
-	if err != nil {
+func (p *Provisioner) CreateProject(ctx context.Context, params ProjectParams) error {
+	return runWithContext(ctx, func() error {
+		targetProvider, err := p.getProvider(ctx, params.Target)
+		if err != nil {
+			return err
+		}
+
+		projectReq := &provider.ProjectRequest{
+			TargetOptions:            params.Target.Options,
+			Project:                  params.Project,
+			ContainerRegistry:        params.ContainerRegistry,
+			GitProviderConfig:        params.GitProviderConfig,
+			BuilderImage:             params.BuilderImage,
+			BuilderContainerRegistry: params.BuilderImageContainerRegistry,
+		}
+
+		if streamingProvider, ok := (*targetProvider).(provider.StreamingProvider); ok {
+			_, err = streamingProvider.StreamCreateProject(projectReq, handleProviderMessages(ctx))
+			return err
+		}
+
+		_, err = (*targetProvider).CreateProject(projectReq)
 		return err
-	}
-
-	_, err = (*targetProvider).CreateProject(&provider.ProjectRequest{
-		TargetOptions:            params.Target.Options,
-		Project:                  params.Project,
-		ContainerRegistry:        params.ContainerRegistry,
-		GitProviderConfig:        params.GitProviderConfig,
-		BuilderImage:             params.BuilderImage,
-		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
 	})
-
-	return err
 }
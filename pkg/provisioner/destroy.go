@@ -4,35 +4,41 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
-func (p *Provisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
-	if err != nil {
-		return err
-	}
+func (p *Provisioner) DestroyWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return runWithContext(ctx, func() error {
+		targetProvider, err := p.getProvider(ctx, target)
+		if err != nil {
+			return err
+		}
 
-	_, err = (*targetProvider).DestroyWorkspace(&provider.WorkspaceRequest{
-		TargetOptions: target.Options,
-		Workspace:     workspace,
-	})
+		_, err = (*targetProvider).DestroyWorkspace(&provider.WorkspaceRequest{
+			TargetOptions: target.Options,
+			Workspace:     workspace,
+		})
 
-	return err
+		return err
+	})
 }
 
-func (p *Provisioner) DestroyProject(proj *project.Project, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
-	if err != nil {
-		return err
-	}
+func (p *Provisioner) DestroyProject(ctx context.Context, proj *project.Project, target *provider.ProviderTarget) error {
+	return runWithContext(ctx, func() error {
+		targetProvider, err := p.getProvider(ctx, target)
+		if err != nil {
+			return err
+		}
 
-	_, err = (*targetProvider).DestroyProject(&provider.ProjectRequest{
-		TargetOptions: target.Options,
-		Project:       proj,
-	})
+		_, err = (*targetProvider).DestroyProject(&provider.ProjectRequest{
+			TargetOptions: target.Options,
+			Project:       proj,
+		})
 
-	return err
+		return err
+	})
 }
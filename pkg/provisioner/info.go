@@ -20,7 +20,7 @@ func (p *Provisioner) GetWorkspaceInfo(ctx context.Context, ws *workspace.Worksp
 	ch := make(chan InfoResult, 1)
 
 	go func() {
-		targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+		targetProvider, err := p.getProvider(ctx, target)
 		if err != nil {
 			ch <- InfoResult{nil, err}
 			return
@@ -41,3 +41,38 @@ func (p *Provisioner) GetWorkspaceInfo(ctx context.Context, ws *workspace.Worksp
 		return data.Info, data.Err
 	}
 }
+
+// Gets the capabilities declared by the target provider. The call does not change anything on the provider
+// so it is abandoned if the context is done first
+func (p *Provisioner) GetCapabilities(ctx context.Context, target *provider.ProviderTarget) (*provider.ProviderCapabilities, error) {
+	type capabilitiesResult struct {
+		capabilities *provider.ProviderCapabilities
+		err          error
+	}
+
+	ch := make(chan capabilitiesResult, 1)
+
+	go func() {
+		targetProvider, err := p.getProvider(ctx, target)
+		if err != nil {
+			ch <- capabilitiesResult{nil, err}
+			return
+		}
+
+		info, err := (*targetProvider).GetInfo()
+		if err != nil {
+			ch <- capabilitiesResult{nil, err}
+			return
+		}
+
+		capabilities := info.GetCapabilities()
+		ch <- capabilitiesResult{&capabilities, nil}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-ch:
+		return result.capabilities, result.err
+	}
+}
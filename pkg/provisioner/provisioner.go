@@ -5,9 +5,12 @@ package provisioner
 
 import (
 	"context"
+	"io"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/operation"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -24,15 +27,16 @@ type ProjectParams struct {
 }
 
 type IProvisioner interface {
-	CreateProject(params ProjectParams) error
-	CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
-	DestroyProject(project *project.Project, target *provider.ProviderTarget) error
-	DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	CreateProject(ctx context.Context, params ProjectParams) error
+	CreateWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	DestroyProject(ctx context.Context, project *project.Project, target *provider.ProviderTarget) error
+	DestroyWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	GetCapabilities(ctx context.Context, target *provider.ProviderTarget) (*provider.ProviderCapabilities, error)
 	GetWorkspaceInfo(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error)
-	StartProject(params ProjectParams) error
-	StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
-	StopProject(project *project.Project, target *provider.ProviderTarget) error
-	StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	StartProject(ctx context.Context, params ProjectParams) error
+	StartWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	StopProject(ctx context.Context, project *project.Project, target *provider.ProviderTarget) error
+	StopWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
 }
 
 type ProvisionerConfig struct {
@@ -48,3 +52,66 @@ func NewProvisioner(config ProvisionerConfig) IProvisioner {
 type Provisioner struct {
 	providerManager manager.IProviderManager
 }
+
+// getProvider returns the provider of the target. Calls to providers that support it are cancelled with the context
+func (p *Provisioner) getProvider(ctx context.Context, target *provider.ProviderTarget) (*provider.Provider, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if contextProvider, ok := (*targetProvider).(provider.ContextProvider); ok {
+		boundProvider := contextProvider.WithContext(ctx)
+		return &boundProvider, nil
+	}
+
+	return targetProvider, nil
+}
+
+// Runs the provider call unless the context is already done and waits for it to return.
+// Calls to providers that support it are cancelled with the context. Other providers can not be interrupted,
+// so their calls are waited for instead of leaving them to create resources after the operation is cancelled
+func runWithContext(ctx context.Context, fn func() error) error {
+	err := ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
+
+type logWriterContextKey struct{}
+
+// WithLogWriter returns a context that forwards project logs streamed by providers to the given writer
+func WithLogWriter(ctx context.Context, logWriter io.Writer) context.Context {
+	return context.WithValue(ctx, logWriterContextKey{}, logWriter)
+}
+
+// Returns a handler that writes log messages streamed by the provider to the log writer of the context
+// and reports progress messages to the operation running under the context
+func handleProviderMessages(ctx context.Context) func(provider.ProviderMessage) {
+	logWriter, _ := ctx.Value(logWriterContextKey{}).(io.Writer)
+
+	return func(msg provider.ProviderMessage) {
+		switch msg.Type {
+		case provider.ProviderMessageTypeLog:
+			if logWriter == nil {
+				return
+			}
+
+			line := msg.Message
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n"
+			}
+
+			logWriter.Write([]byte(line))
+		case provider.ProviderMessageTypeProgress:
+			operation.ReportProgress(ctx, msg.Message)
+		}
+	}
+}
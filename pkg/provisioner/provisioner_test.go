@@ -0,0 +1,117 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/fake"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingProvider blocks creating workspaces until it is released and can not be cancelled, like net/rpc providers
+type blockingProvider struct {
+	*fake.FakeProvider
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingProvider) CreateWorkspace(req *provider.WorkspaceRequest) (*util.Empty, error) {
+	close(p.started)
+	<-p.release
+	return p.FakeProvider.CreateWorkspace(req)
+}
+
+func newBlockingProvisioner(t *testing.T) (provisioner.IProvisioner, *blockingProvider) {
+	fakeProvider, err := fake.NewFakeProvider(fake.FakeProviderConfig{})
+	require.NoError(t, err)
+
+	impl := &blockingProvider{
+		FakeProvider: fakeProvider,
+		started:      make(chan struct{}),
+		release:      make(chan struct{}),
+	}
+
+	baseDir := t.TempDir()
+
+	// The lock file skips the initial setup which saves the preset targets
+	err = os.MkdirAll(filepath.Join(baseDir, fake.ProviderName), os.ModePerm)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(baseDir, fake.ProviderName, manager.INITIAL_SETUP_LOCK_FILE_NAME), []byte{}, 0644)
+	require.NoError(t, err)
+
+	providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{
+		BaseDir: baseDir,
+	})
+
+	err = providerManager.RegisterInProcessProvider(fake.ProviderName, impl)
+	require.NoError(t, err)
+
+	return provisioner.NewProvisioner(provisioner.ProvisionerConfig{
+		ProviderManager: providerManager,
+	}), impl
+}
+
+func TestProvisionerCancellation(t *testing.T) {
+	target := &provider.ProviderTarget{
+		Name:         "test-target",
+		ProviderInfo: provider.ProviderInfo{Name: fake.ProviderName},
+	}
+
+	t.Run("Waits for provider calls that can not be cancelled", func(t *testing.T) {
+		p, impl := newBlockingProvisioner(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result := make(chan error, 1)
+		go func() {
+			result <- p.CreateWorkspace(ctx, &workspace.Workspace{Id: "123", Name: "workspace1"}, target)
+		}()
+
+		<-impl.started
+		cancel()
+
+		select {
+		case err := <-result:
+			require.FailNow(t, "the call returned while the provider was still creating the workspace", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(impl.release)
+
+		// The workspace was created by the provider so the call succeeds
+		select {
+		case err := <-result:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "the call did not return")
+		}
+	})
+
+	t.Run("Does not call the provider if the context is done", func(t *testing.T) {
+		p, impl := newBlockingProvisioner(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := p.CreateWorkspace(ctx, &workspace.Workspace{Id: "123", Name: "workspace1"}, target)
+		require.ErrorIs(t, err, context.Canceled)
+
+		select {
+		case <-impl.started:
+			require.FailNow(t, "the provider was called")
+		default:
+		}
+	})
+}
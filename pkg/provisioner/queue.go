@@ -0,0 +1,349 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+type TargetOperation struct {
+	// Provisioner method, e.g. CreateProject
+	Name        string     `json:"name" validate:"required"`
+	WorkspaceId string     `json:"workspaceId" validate:"required"`
+	ProjectName *string    `json:"projectName,omitempty" validate:"optional"`
+	QueuedAt    time.Time  `json:"queuedAt" validate:"required"`
+	StartedAt   *time.Time `json:"startedAt,omitempty" validate:"optional"`
+} // @name TargetOperation
+
+type TargetQueueStatus struct {
+	Target string `json:"target" validate:"required"`
+	// Zero if the number of concurrent operations is not limited
+	MaxConcurrentOperations int               `json:"maxConcurrentOperations" validate:"required"`
+	Running                 []TargetOperation `json:"running" validate:"required"`
+	// Operations waiting for a free slot, in the order they will be started
+	Queued []TargetOperation `json:"queued" validate:"required"`
+} // @name TargetQueueStatus
+
+type IProvisioningQueue interface {
+	GetQueueStatus() []TargetQueueStatus
+	SetTargetLimit(target *provider.ProviderTarget)
+}
+
+type QueuedProvisionerConfig struct {
+	Provisioner IProvisioner
+}
+
+// QueuedProvisioner limits the number of create, start, stop and destroy operations that run on a target
+// at the same time to the MaxConcurrentOperations of the target.
+// Operations over the limit wait in a FIFO queue in front of the provisioner
+type QueuedProvisioner struct {
+	provisioner IProvisioner
+	mutex       sync.Mutex
+	// Target name -> operations running on and waiting for the target
+	queues map[string]*targetQueue
+}
+
+type targetQueue struct {
+	limit   int
+	running []*queuedOperation
+	waiting []*queuedOperation
+}
+
+type queuedOperation struct {
+	TargetOperation
+	// Closed when the operation can start
+	ready chan struct{}
+	// Signalled when the operation moves up in the queue
+	moved chan struct{}
+}
+
+func NewQueuedProvisioner(config QueuedProvisionerConfig) *QueuedProvisioner {
+	return &QueuedProvisioner{
+		provisioner: config.Provisioner,
+		queues:      map[string]*targetQueue{},
+	}
+}
+
+func (p *QueuedProvisioner) CreateProject(ctx context.Context, params ProjectParams) error {
+	return p.run(ctx, params.Target, "CreateProject", params.Project.WorkspaceId, &params.Project.Name, func() error {
+		return p.provisioner.CreateProject(ctx, params)
+	})
+}
+
+func (p *QueuedProvisioner) CreateWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.run(ctx, target, "CreateWorkspace", workspace.Id, nil, func() error {
+		return p.provisioner.CreateWorkspace(ctx, workspace, target)
+	})
+}
+
+func (p *QueuedProvisioner) DestroyProject(ctx context.Context, project *project.Project, target *provider.ProviderTarget) error {
+	return p.run(ctx, target, "DestroyProject", project.WorkspaceId, &project.Name, func() error {
+		return p.provisioner.DestroyProject(ctx, project, target)
+	})
+}
+
+func (p *QueuedProvisioner) DestroyWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.run(ctx, target, "DestroyWorkspace", workspace.Id, nil, func() error {
+		return p.provisioner.DestroyWorkspace(ctx, workspace, target)
+	})
+}
+
+func (p *QueuedProvisioner) GetCapabilities(ctx context.Context, target *provider.ProviderTarget) (*provider.ProviderCapabilities, error) {
+	return p.provisioner.GetCapabilities(ctx, target)
+}
+
+func (p *QueuedProvisioner) GetWorkspaceInfo(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
+	return p.provisioner.GetWorkspaceInfo(ctx, workspace, target)
+}
+
+func (p *QueuedProvisioner) StartProject(ctx context.Context, params ProjectParams) error {
+	return p.run(ctx, params.Target, "StartProject", params.Project.WorkspaceId, &params.Project.Name, func() error {
+		return p.provisioner.StartProject(ctx, params)
+	})
+}
+
+func (p *QueuedProvisioner) StartWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.run(ctx, target, "StartWorkspace", workspace.Id, nil, func() error {
+		return p.provisioner.StartWorkspace(ctx, workspace, target)
+	})
+}
+
+func (p *QueuedProvisioner) StopProject(ctx context.Context, project *project.Project, target *provider.ProviderTarget) error {
+	return p.run(ctx, target, "StopProject", project.WorkspaceId, &project.Name, func() error {
+		return p.provisioner.StopProject(ctx, project, target)
+	})
+}
+
+func (p *QueuedProvisioner) StopWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.run(ctx, target, "StopWorkspace", workspace.Id, nil, func() error {
+		return p.provisioner.StopWorkspace(ctx, workspace, target)
+	})
+}
+
+// SetTargetLimit applies the MaxConcurrentOperations of a target that was changed and starts the queued
+// operations that fit into a raised limit
+func (p *QueuedProvisioner) SetTargetLimit(target *provider.ProviderTarget) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	q, ok := p.queues[target.Name]
+	if !ok {
+		return
+	}
+
+	q.setLimit(target)
+	q.startWaiting()
+}
+
+// GetQueueStatus returns the running and queued operations of all targets with pending operations
+func (p *QueuedProvisioner) GetQueueStatus() []TargetQueueStatus {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	result := []TargetQueueStatus{}
+	for targetName, q := range p.queues {
+		status := TargetQueueStatus{
+			Target:                  targetName,
+			MaxConcurrentOperations: q.limit,
+			Running:                 []TargetOperation{},
+			Queued:                  []TargetOperation{},
+		}
+		for _, op := range q.running {
+			status.Running = append(status.Running, op.TargetOperation)
+		}
+		for _, op := range q.waiting {
+			status.Queued = append(status.Queued, op.TargetOperation)
+		}
+		result = append(result, status)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Target < result[j].Target
+	})
+
+	return result
+}
+
+func (p *QueuedProvisioner) run(ctx context.Context, target *provider.ProviderTarget, name string, workspaceId string, projectName *string, fn func() error) error {
+	op := &queuedOperation{
+		TargetOperation: TargetOperation{
+			Name:        name,
+			WorkspaceId: workspaceId,
+			ProjectName: projectName,
+			QueuedAt:    time.Now(),
+		},
+		ready: make(chan struct{}),
+		moved: make(chan struct{}, 1),
+	}
+
+	err := p.acquire(ctx, target, op)
+	if err != nil {
+		return err
+	}
+
+	// The provisioner waits for provider calls to return even if the context is cancelled,
+	// so the slot is only released once the provider is no longer working on the operation
+	defer p.release(target.Name, op)
+
+	return fn()
+}
+
+// acquire waits until the operation can run on the target or the context is done
+func (p *QueuedProvisioner) acquire(ctx context.Context, target *provider.ProviderTarget, op *queuedOperation) error {
+	p.mutex.Lock()
+
+	q, ok := p.queues[target.Name]
+	if !ok {
+		q = &targetQueue{}
+		p.queues[target.Name] = q
+	}
+
+	// The limit of the most recent operation applies so limit changes take effect without a restart
+	q.setLimit(target)
+	q.startWaiting()
+
+	if len(q.waiting) == 0 && q.hasFreeSlot() {
+		q.start(op)
+		p.mutex.Unlock()
+		return nil
+	}
+
+	q.waiting = append(q.waiting, op)
+	position := len(q.waiting)
+	p.mutex.Unlock()
+
+	reportQueuePosition(ctx, target.Name, position)
+
+	for {
+		select {
+		case <-op.ready:
+			return nil
+		case <-op.moved:
+			p.mutex.Lock()
+			position := q.position(op)
+			p.mutex.Unlock()
+
+			if position > 0 {
+				reportQueuePosition(ctx, target.Name, position)
+			}
+		case <-ctx.Done():
+			p.mutex.Lock()
+			removed := q.remove(op)
+			p.mutex.Unlock()
+
+			// The operation was started while the context was being cancelled
+			if !removed {
+				p.release(target.Name, op)
+			}
+
+			return ctx.Err()
+		}
+	}
+}
+
+// release frees the slot of the operation and starts the next queued operations
+func (p *QueuedProvisioner) release(targetName string, op *queuedOperation) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	q, ok := p.queues[targetName]
+	if !ok {
+		return
+	}
+
+	for i, runningOp := range q.running {
+		if runningOp == op {
+			q.running = append(q.running[:i], q.running[i+1:]...)
+			break
+		}
+	}
+
+	q.startWaiting()
+
+	if len(q.running) == 0 && len(q.waiting) == 0 {
+		delete(p.queues, targetName)
+	}
+}
+
+func (q *targetQueue) setLimit(target *provider.ProviderTarget) {
+	q.limit = 0
+	if target.MaxConcurrentOperations != nil && *target.MaxConcurrentOperations > 0 {
+		q.limit = *target.MaxConcurrentOperations
+	}
+}
+
+// startWaiting starts the queued operations while there are free slots and notifies the remaining ones of their new position
+func (q *targetQueue) startWaiting() {
+	if len(q.waiting) == 0 || !q.hasFreeSlot() {
+		return
+	}
+
+	for len(q.waiting) > 0 && q.hasFreeSlot() {
+		next := q.waiting[0]
+		q.waiting = q.waiting[1:]
+		q.start(next)
+	}
+
+	for _, waitingOp := range q.waiting {
+		select {
+		case waitingOp.moved <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (q *targetQueue) hasFreeSlot() bool {
+	return q.limit == 0 || len(q.running) < q.limit
+}
+
+func (q *targetQueue) start(op *queuedOperation) {
+	now := time.Now()
+	op.StartedAt = &now
+	q.running = append(q.running, op)
+	close(op.ready)
+}
+
+// position returns the 1-based position of the operation in the queue or 0 if it is not queued
+func (q *targetQueue) position(op *queuedOperation) int {
+	for i, waitingOp := range q.waiting {
+		if waitingOp == op {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// remove removes the operation from the queue and returns false if it is not queued
+func (q *targetQueue) remove(op *queuedOperation) bool {
+	for i, waitingOp := range q.waiting {
+		if waitingOp == op {
+			q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Writes the queue position to the log writer of the context and reports it to the operation running under the context
+func reportQueuePosition(ctx context.Context, targetName string, position int) {
+	message := fmt.Sprintf("Waiting for a free slot on target %s. Position in queue: %d", targetName, position)
+
+	if logWriter, ok := ctx.Value(logWriterContextKey{}).(io.Writer); ok {
+		logWriter.Write([]byte(message + "\n"))
+	}
+
+	operation.ReportProgress(ctx, message)
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// queueTest runs operations on a target with a limit of one concurrent operation.
+// Started operations block until they are finished by the test
+type queueTest struct {
+	t           *testing.T
+	target      *provider.ProviderTarget
+	provisioner *provisioner.QueuedProvisioner
+	started     chan string
+	finish      map[string]chan struct{}
+	results     map[string]chan error
+}
+
+func newQueueTest(t *testing.T, workspaceIds ...string) *queueTest {
+	qt := &queueTest{
+		t: t,
+		target: &provider.ProviderTarget{
+			Name:                    "test-target",
+			MaxConcurrentOperations: util.Pointer(1),
+		},
+		started: make(chan string, len(workspaceIds)),
+		finish:  map[string]chan struct{}{},
+		results: map[string]chan error{},
+	}
+
+	mockProvisioner := mocks.NewMockProvisioner()
+	for _, id := range workspaceIds {
+		finish := make(chan struct{})
+		qt.finish[id] = finish
+		qt.results[id] = make(chan error, 1)
+
+		mockProvisioner.On("CreateWorkspace", mock.Anything, mock.MatchedBy(func(w *workspace.Workspace) bool {
+			return w.Id == id
+		}), qt.target).Run(func(args mock.Arguments) {
+			qt.started <- id
+			<-finish
+		}).Return(nil)
+	}
+
+	qt.provisioner = provisioner.NewQueuedProvisioner(provisioner.QueuedProvisionerConfig{
+		Provisioner: mockProvisioner,
+	})
+
+	return qt
+}
+
+func (qt *queueTest) run(ctx context.Context, workspaceId string) {
+	go func() {
+		qt.results[workspaceId] <- qt.provisioner.CreateWorkspace(ctx, &workspace.Workspace{Id: workspaceId}, qt.target)
+	}()
+}
+
+func (qt *queueTest) requireStarted(workspaceId string) {
+	select {
+	case id := <-qt.started:
+		require.Equal(qt.t, workspaceId, id)
+	case <-time.After(5 * time.Second):
+		require.FailNow(qt.t, "operation was not started", workspaceId)
+	}
+}
+
+func (qt *queueTest) requireNotStarted() {
+	select {
+	case id := <-qt.started:
+		require.FailNow(qt.t, "operation was started over the limit", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (qt *queueTest) requireQueued(count int) {
+	require.Eventually(qt.t, func() bool {
+		status := qt.provisioner.GetQueueStatus()
+		return len(status) == 1 && len(status[0].Queued) == count
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (qt *queueTest) requireResult(workspaceId string, expected error) {
+	select {
+	case err := <-qt.results[workspaceId]:
+		require.Equal(qt.t, expected, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(qt.t, "operation did not return", workspaceId)
+	}
+}
+
+func TestQueuedProvisioner(t *testing.T) {
+	t.Run("Runs operations over the limit in FIFO order", func(t *testing.T) {
+		qt := newQueueTest(t, "a", "b", "c")
+
+		qt.run(context.Background(), "a")
+		qt.requireStarted("a")
+
+		qt.run(context.Background(), "b")
+		qt.requireQueued(1)
+		qt.run(context.Background(), "c")
+		qt.requireQueued(2)
+
+		status := qt.provisioner.GetQueueStatus()
+		require.Equal(t, 1, status[0].MaxConcurrentOperations)
+		require.Len(t, status[0].Running, 1)
+		require.Equal(t, "b", status[0].Queued[0].WorkspaceId)
+		require.Equal(t, "c", status[0].Queued[1].WorkspaceId)
+
+		qt.requireNotStarted()
+
+		close(qt.finish["a"])
+		qt.requireResult("a", nil)
+		qt.requireStarted("b")
+		qt.requireNotStarted()
+
+		close(qt.finish["b"])
+		qt.requireResult("b", nil)
+		qt.requireStarted("c")
+
+		close(qt.finish["c"])
+		qt.requireResult("c", nil)
+
+		require.Empty(t, qt.provisioner.GetQueueStatus())
+	})
+
+	t.Run("Removes operations from the queue when the context is cancelled", func(t *testing.T) {
+		qt := newQueueTest(t, "a", "b")
+
+		qt.run(context.Background(), "a")
+		qt.requireStarted("a")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		qt.run(ctx, "b")
+		qt.requireQueued(1)
+
+		cancel()
+		qt.requireResult("b", context.Canceled)
+		qt.requireQueued(0)
+
+		close(qt.finish["a"])
+		qt.requireResult("a", nil)
+		qt.requireNotStarted()
+
+		require.Empty(t, qt.provisioner.GetQueueStatus())
+	})
+
+	t.Run("Keeps the slot of a cancelled operation until it returns", func(t *testing.T) {
+		qt := newQueueTest(t, "a", "b")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		qt.run(ctx, "a")
+		qt.requireStarted("a")
+
+		qt.run(context.Background(), "b")
+		qt.requireQueued(1)
+
+		cancel()
+		qt.requireNotStarted()
+
+		close(qt.finish["a"])
+		qt.requireResult("a", nil)
+		qt.requireStarted("b")
+
+		close(qt.finish["b"])
+		qt.requireResult("b", nil)
+	})
+
+	t.Run("Starts queued operations when the limit is raised", func(t *testing.T) {
+		qt := newQueueTest(t, "a", "b", "c")
+
+		qt.run(context.Background(), "a")
+		qt.requireStarted("a")
+
+		qt.run(context.Background(), "b")
+		qt.requireQueued(1)
+		qt.run(context.Background(), "c")
+		qt.requireQueued(2)
+
+		qt.provisioner.SetTargetLimit(&provider.ProviderTarget{
+			Name:                    qt.target.Name,
+			MaxConcurrentOperations: util.Pointer(2),
+		})
+
+		qt.requireStarted("b")
+		qt.requireQueued(1)
+		qt.requireNotStarted()
+
+		// Removing the limit starts all queued operations
+		qt.provisioner.SetTargetLimit(&provider.ProviderTarget{
+			Name: qt.target.Name,
+		})
+
+		qt.requireStarted("c")
+		qt.requireQueued(0)
+
+		for _, id := range []string{"a", "b", "c"} {
+			close(qt.finish[id])
+			qt.requireResult(id, nil)
+		}
+	})
+}
@@ -4,38 +4,50 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
-	if err != nil {
-		return err
-	}
+func (p *Provisioner) StartWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return runWithContext(ctx, func() error {
+		targetProvider, err := p.getProvider(ctx, target)
+		if err != nil {
+			return err
+		}
 
-	_, err = (*targetProvider).StartWorkspace(&provider.WorkspaceRequest{
-		TargetOptions: target.Options,
-		Workspace:     workspace,
-	})
+		_, err = (*targetProvider).StartWorkspace(&provider.WorkspaceRequest{
+			TargetOptions: target.Options,
+			Workspace:     workspace,
+		})
 
-	return err
+		return err
+	})
 }
 
-func (p *Provisioner) StartProject(params ProjectParams) error {
-	targetProvider, err := p.providerManager.GetProvider(params.Target.ProviderInfo.Name)
-	if err != nil {
+func (p *Provisioner) StartProject(ctx context.Context, params ProjectParams) error {
+	return runWithContext(ctx, func() error {
+		targetProvider, err := p.getProvider(ctx, params.Target)
+		if err != nil {
+			return err
+		}
+
+		projectReq := &provider.ProjectRequest{
+			TargetOptions:            params.Target.Options,
+			Project:                  params.Project,
+			ContainerRegistry:        params.ContainerRegistry,
+			GitProviderConfig:        params.GitProviderConfig,
+			BuilderImage:             params.BuilderImage,
+			BuilderContainerRegistry: params.BuilderImageContainerRegistry,
+		}
+
+		if streamingProvider, ok := (*targetProvider).(provider.StreamingProvider); ok {
+			_, err = streamingProvider.StreamStartProject(projectReq, handleProviderMessages(ctx))
+			return err
+		}
+
+		_, err = (*targetProvider).StartProject(projectReq)
 		return err
-	}
-
-	_, err = (*targetProvider).StartProject(&provider.ProjectRequest{
-		TargetOptions:            params.Target.Options,
-		Project:                  params.Project,
-		ContainerRegistry:        params.ContainerRegistry,
-		GitProviderConfig:        params.GitProviderConfig,
-		BuilderImage:             params.BuilderImage,
-		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
 	})
-
-	return err
 }
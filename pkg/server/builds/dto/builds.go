@@ -4,6 +4,7 @@
 package dto
 
 import (
+	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
@@ -15,4 +16,5 @@ type BuildCreationData struct {
 	Repository  *gitprovider.GitRepository `json:"repository" validate:"optional"`
 	EnvVars     map[string]string          `json:"envVars" validate:"required"`
 	PrebuildId  string                     `json:"prebuildId" validate:"required"`
+	Priority    build.BuildPriority        `json:"priority" validate:"required"`
 } // @name BuildCreationData
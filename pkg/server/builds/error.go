@@ -0,0 +1,14 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds
+
+import "errors"
+
+var (
+	ErrBuildNotCancellable = errors.New("only builds that are pending to run, running or being published can be cancelled")
+)
+
+func IsBuildNotCancellable(err error) bool {
+	return err.Error() == ErrBuildNotCancellable.Error()
+}
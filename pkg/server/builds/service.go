@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/containerconfig"
@@ -19,6 +20,7 @@ type IBuildService interface {
 	Create(dto.BuildCreationData) (string, error)
 	Find(filter *build.Filter) (*build.Build, error)
 	List(filter *build.Filter) ([]*build.Build, error)
+	Cancel(id string) error
 	MarkForDeletion(filter *build.Filter, force bool) []error
 	Delete(id string) error
 	AwaitEmptyList(time.Duration) error
@@ -28,17 +30,20 @@ type IBuildService interface {
 type BuildServiceConfig struct {
 	BuildStore    build.Store
 	LoggerFactory logs.LoggerFactory
+	EventBus      *events.EventBus
 }
 
 type BuildService struct {
 	buildStore    build.Store
 	loggerFactory logs.LoggerFactory
+	eventBus      *events.EventBus
 }
 
 func NewBuildService(config BuildServiceConfig) IBuildService {
 	return &BuildService{
 		buildStore:    config.BuildStore,
 		loggerFactory: config.LoggerFactory,
+		eventBus:      config.EventBus,
 	}
 }
 
@@ -58,21 +63,116 @@ func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 	newBuild.Repository = b.Repository
 	newBuild.EnvVars = b.EnvVars
 	newBuild.PrebuildId = b.PrebuildId
+	newBuild.Priority = b.Priority
+	if newBuild.Priority == "" {
+		newBuild.Priority = build.BuildPriorityNormal
+	}
 
 	err := s.buildStore.Save(&newBuild)
 	if err != nil {
 		return "", err
 	}
 
+	if newBuild.PrebuildId != "" {
+		event := events.NewEvent(events.EventTypePrebuildTriggered)
+		event.BuildId = newBuild.Id
+		event.PrebuildId = newBuild.PrebuildId
+		s.eventBus.Publish(event)
+	}
+
+	s.eventBus.Publish(build.NewBuildStateEvent(&newBuild))
+
 	return id, nil
 }
 
 func (s *BuildService) Find(filter *build.Filter) (*build.Build, error) {
-	return s.buildStore.Find(filter)
+	b, err := s.buildStore.Find(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setQueuePositions([]*build.Build{b})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
 }
 
 func (s *BuildService) List(filter *build.Filter) ([]*build.Build, error) {
-	return s.buildStore.List(filter)
+	builds, err := s.buildStore.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setQueuePositions(builds)
+	if err != nil {
+		return nil, err
+	}
+
+	return builds, nil
+}
+
+// setQueuePositions sets the position in the run queue of the builds that are pending to run
+func (s *BuildService) setQueuePositions(builds []*build.Build) error {
+	hasPendingBuilds := false
+	for _, b := range builds {
+		if b.State == build.BuildStatePendingRun {
+			hasPendingBuilds = true
+			break
+		}
+	}
+
+	if !hasPendingBuilds {
+		return nil
+	}
+
+	pendingBuilds, err := s.buildStore.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStatePendingRun},
+	})
+	if err != nil {
+		return err
+	}
+
+	positions := build.GetQueuePositions(pendingBuilds)
+
+	for _, b := range builds {
+		position, ok := positions[b.Id]
+		if ok {
+			b.Queued = &position
+		}
+	}
+
+	return nil
+}
+
+// Cancel stops a build that is pending to run, running or being published.
+// Running builds are stopped by the build runner once it receives the state change.
+// Builds are in the success state while their image is published
+func (s *BuildService) Cancel(id string) error {
+	b, err := s.buildStore.Find(&build.Filter{
+		Id: &id,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch b.State {
+	case build.BuildStatePendingRun, build.BuildStateRunning, build.BuildStateSuccess:
+	default:
+		return ErrBuildNotCancellable
+	}
+
+	b.State = build.BuildStateCancelled
+
+	err = s.buildStore.Save(b)
+	if err != nil {
+		return err
+	}
+
+	s.eventBus.Publish(build.NewBuildStateEvent(b))
+
+	return nil
 }
 
 func (s *BuildService) MarkForDeletion(filter *build.Filter, force bool) []error {
@@ -93,7 +193,10 @@ func (s *BuildService) MarkForDeletion(filter *build.Filter, force bool) []error
 		err = s.buildStore.Save(b)
 		if err != nil {
 			errors = append(errors, err)
+			continue
 		}
+
+		s.eventBus.Publish(build.NewBuildStateEvent(b))
 	}
 
 	return errors
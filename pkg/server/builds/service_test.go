@@ -5,6 +5,7 @@ package builds_test
 
 import (
 	"testing"
+	"time"
 
 	build_internal "github.com/daytonaio/daytona/internal/testing/build"
 	"github.com/daytonaio/daytona/pkg/build"
@@ -161,6 +162,45 @@ func (s *BuildServiceTestSuite) TestSave() {
 	require.Contains(expectedBuilds, build4)
 }
 
+func (s *BuildServiceTestSuite) TestQueuePositions() {
+	require := s.Require()
+
+	highPriorityBuild := &build.Build{
+		Id: "id5",
+		ContainerConfig: containerconfig.ContainerConfig{
+			Image: "image5",
+			User:  "user5",
+		},
+		Repository: &gitprovider.GitRepository{
+			Sha: "sha5",
+		},
+		State:     build.BuildStatePendingRun,
+		Priority:  build.BuildPriorityHigh,
+		CreatedAt: time.Now(),
+	}
+
+	err := s.buildStore.Save(highPriorityBuild)
+	require.Nil(err)
+
+	b, err := s.buildService.Find(&build.Filter{
+		Id: &highPriorityBuild.Id,
+	})
+	require.Nil(err)
+	require.Equal(1, *b.Queued)
+
+	b, err = s.buildService.Find(&build.Filter{
+		Id: &build3.Id,
+	})
+	require.Nil(err)
+	require.Equal(2, *b.Queued)
+
+	b, err = s.buildService.Find(&build.Filter{
+		Id: &build1.Id,
+	})
+	require.Nil(err)
+	require.Nil(b.Queued)
+}
+
 func (s *BuildServiceTestSuite) TestMarkForDeletion() {
 	expectedBuilds = append(expectedBuilds, build3)
 
@@ -190,3 +230,62 @@ func (s *BuildServiceTestSuite) TestDelete() {
 	require.Nil(err)
 	require.ElementsMatch(expectedBuilds, builds)
 }
+
+func (s *BuildServiceTestSuite) TestCancel() {
+	require := s.Require()
+
+	pendingBuild := &build.Build{
+		Id: "id6",
+		ContainerConfig: containerconfig.ContainerConfig{
+			Image: "image6",
+			User:  "user6",
+		},
+		Repository: &gitprovider.GitRepository{
+			Sha: "sha6",
+		},
+		State: build.BuildStatePendingRun,
+	}
+
+	err := s.buildStore.Save(pendingBuild)
+	require.Nil(err)
+
+	err = s.buildService.Cancel(pendingBuild.Id)
+	require.Nil(err)
+
+	b, err := s.buildService.Find(&build.Filter{
+		Id: &pendingBuild.Id,
+	})
+	require.Nil(err)
+	require.Equal(build.BuildStateCancelled, b.State)
+
+	err = s.buildService.Cancel(pendingBuild.Id)
+	require.True(builds.IsBuildNotCancellable(err))
+
+	err = s.buildService.Cancel(build1.Id)
+	require.True(builds.IsBuildNotCancellable(err))
+
+	// Builds that are being published can still be cancelled
+	publishingBuild := &build.Build{
+		Id: "id7",
+		ContainerConfig: containerconfig.ContainerConfig{
+			Image: "image7",
+			User:  "user7",
+		},
+		Repository: &gitprovider.GitRepository{
+			Sha: "sha7",
+		},
+		State: build.BuildStateSuccess,
+	}
+
+	err = s.buildStore.Save(publishingBuild)
+	require.Nil(err)
+
+	err = s.buildService.Cancel(publishingBuild.Id)
+	require.Nil(err)
+
+	b, err = s.buildService.Find(&build.Filter{
+		Id: &publishingBuild.Id,
+	})
+	require.Nil(err)
+	require.Equal(build.BuildStateCancelled, b.State)
+}
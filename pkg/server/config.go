@@ -114,6 +114,19 @@ func GetConfigDir() (string, error) {
 	return filepath.Join(configDir, "server"), nil
 }
 
+func GetMasterKeyFilePath(c *Config) (string, error) {
+	if c.MasterKeyFile != "" {
+		return c.MasterKeyFile, nil
+	}
+
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "master.key"), nil
+}
+
 func GetWorkspaceLogsDir(configDir string) (string, error) {
 	return filepath.Join(configDir, "logs"), nil
 }
@@ -0,0 +1,19 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import "errors"
+
+var (
+	ErrOperationNotFound = errors.New("operation not found")
+	ErrOperationFinished = errors.New("operation has already finished")
+)
+
+func IsOperationNotFound(err error) bool {
+	return err.Error() == ErrOperationNotFound.Error()
+}
+
+func IsOperationFinished(err error) bool {
+	return err.Error() == ErrOperationFinished.Error()
+}
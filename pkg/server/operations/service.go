@@ -0,0 +1,165 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/google/uuid"
+)
+
+// Finished operations are kept around for this long so that clients can fetch their result
+const defaultRetention = time.Hour
+
+// OperationFunc performs the long-running action. Its result is stored on the operation once it succeeds.
+type OperationFunc func(ctx context.Context) (interface{}, error)
+
+type IOperationService interface {
+	Run(ctx context.Context, operationType operation.OperationType, resourceId string, fn OperationFunc) *operation.Operation
+	Find(id string) (*operation.Operation, error)
+	Cancel(id string) error
+}
+
+type OperationServiceConfig struct {
+	Retention time.Duration
+}
+
+func NewOperationService(config OperationServiceConfig) IOperationService {
+	retention := config.Retention
+	if retention == 0 {
+		retention = defaultRetention
+	}
+
+	return &OperationService{
+		retention:  retention,
+		operations: map[string]*runningOperation{},
+	}
+}
+
+type OperationService struct {
+	retention  time.Duration
+	mutex      sync.RWMutex
+	operations map[string]*runningOperation
+}
+
+type runningOperation struct {
+	operation *operation.Operation
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// Run starts the action in the background and returns the pending operation immediately.
+// The action keeps the values of the given context but is not cancelled together with it.
+func (s *OperationService) Run(ctx context.Context, operationType operation.OperationType, resourceId string, fn OperationFunc) *operation.Operation {
+	s.removeExpired()
+
+	opCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+	now := time.Now()
+	op := &runningOperation{
+		operation: &operation.Operation{
+			Id:         uuid.NewString(),
+			Type:       operationType,
+			ResourceId: resourceId,
+			State:      operation.OperationStatePending,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		},
+		cancel: cancel,
+	}
+
+	s.mutex.Lock()
+	s.operations[op.operation.Id] = op
+	result := *op.operation
+	s.mutex.Unlock()
+
+	opCtx = operation.WithProgressReporter(opCtx, func(progress string) {
+		s.update(op, func(o *operation.Operation) {
+			o.Progress = progress
+		})
+	})
+
+	go func() {
+		defer cancel()
+
+		s.update(op, func(o *operation.Operation) {
+			o.State = operation.OperationStateRunning
+		})
+
+		res, err := fn(opCtx)
+
+		s.update(op, func(o *operation.Operation) {
+			if err == nil {
+				o.State = operation.OperationStateSuccess
+				o.Result = res
+				return
+			}
+
+			o.State = operation.OperationStateError
+			if op.cancelled && errors.Is(err, context.Canceled) {
+				o.State = operation.OperationStateCancelled
+			}
+
+			errMsg := err.Error()
+			o.Error = &errMsg
+		})
+	}()
+
+	return &result
+}
+
+func (s *OperationService) Find(id string) (*operation.Operation, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	op, ok := s.operations[id]
+	if !ok {
+		return nil, ErrOperationNotFound
+	}
+
+	result := *op.operation
+	return &result, nil
+}
+
+func (s *OperationService) Cancel(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	op, ok := s.operations[id]
+	if !ok {
+		return ErrOperationNotFound
+	}
+
+	if op.operation.IsDone() {
+		return ErrOperationFinished
+	}
+
+	op.cancelled = true
+	op.cancel()
+
+	return nil
+}
+
+func (s *OperationService) update(op *runningOperation, fn func(o *operation.Operation)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	fn(op.operation)
+	op.operation.UpdatedAt = time.Now()
+}
+
+func (s *OperationService) removeExpired() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, op := range s.operations {
+		if op.operation.IsDone() && time.Since(op.operation.UpdatedAt) > s.retention {
+			delete(s.operations, id)
+		}
+	}
+}
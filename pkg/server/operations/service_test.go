@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package operations_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/daytonaio/daytona/pkg/server/operations"
+	"github.com/stretchr/testify/require"
+)
+
+func waitForOperation(t *testing.T, service operations.IOperationService, id string) *operation.Operation {
+	var op *operation.Operation
+
+	require.Eventually(t, func() bool {
+		var err error
+		op, err = service.Find(id)
+		require.Nil(t, err)
+		return op.IsDone()
+	}, time.Second, 10*time.Millisecond)
+
+	return op
+}
+
+func TestOperationService(t *testing.T) {
+	service := operations.NewOperationService(operations.OperationServiceConfig{})
+
+	t.Run("Run stores the result of a successful operation", func(t *testing.T) {
+		op := service.Run(context.Background(), operation.OperationTypeStartWorkspace, "workspace1", func(ctx context.Context) (interface{}, error) {
+			operation.ReportProgress(ctx, "Starting project p1")
+			return "done", nil
+		})
+
+		require.Equal(t, operation.OperationTypeStartWorkspace, op.Type)
+		require.Equal(t, "workspace1", op.ResourceId)
+
+		op = waitForOperation(t, service, op.Id)
+
+		require.Equal(t, operation.OperationStateSuccess, op.State)
+		require.Equal(t, "Starting project p1", op.Progress)
+		require.Equal(t, "done", op.Result)
+		require.Nil(t, op.Error)
+	})
+
+	t.Run("Run records the error of a failed operation", func(t *testing.T) {
+		op := service.Run(context.Background(), operation.OperationTypeStopWorkspace, "workspace1", func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("provider failed")
+		})
+
+		op = waitForOperation(t, service, op.Id)
+
+		require.Equal(t, operation.OperationStateError, op.State)
+		require.NotNil(t, op.Error)
+		require.Equal(t, "provider failed", *op.Error)
+	})
+
+	t.Run("Run is not cancelled with the request context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		op := service.Run(ctx, operation.OperationTypeStartWorkspace, "workspace1", func(ctx context.Context) (interface{}, error) {
+			time.Sleep(50 * time.Millisecond)
+			return nil, ctx.Err()
+		})
+		cancel()
+
+		op = waitForOperation(t, service, op.Id)
+
+		require.Equal(t, operation.OperationStateSuccess, op.State)
+	})
+
+	t.Run("Cancel stops a running operation", func(t *testing.T) {
+		op := service.Run(context.Background(), operation.OperationTypeCreateWorkspace, "workspace1", func(ctx context.Context) (interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		err := service.Cancel(op.Id)
+		require.Nil(t, err)
+
+		op = waitForOperation(t, service, op.Id)
+
+		require.Equal(t, operation.OperationStateCancelled, op.State)
+	})
+
+	t.Run("Cancel fails for a finished operation", func(t *testing.T) {
+		op := service.Run(context.Background(), operation.OperationTypeStartWorkspace, "workspace1", func(ctx context.Context) (interface{}, error) {
+			return nil, nil
+		})
+
+		waitForOperation(t, service, op.Id)
+
+		err := service.Cancel(op.Id)
+		require.True(t, operations.IsOperationFinished(err))
+	})
+
+	t.Run("Find fails for an unknown operation", func(t *testing.T) {
+		_, err := service.Find("unknown")
+		require.True(t, operations.IsOperationNotFound(err))
+	})
+}
@@ -15,6 +15,7 @@ type CreateProjectConfigDTO struct {
 	RepositoryUrl       string                   `json:"repositoryUrl" validate:"required"`
 	EnvVars             map[string]string        `json:"envVars" validate:"required"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	IdleTimeout         *int                     `json:"idleTimeout,omitempty" validate:"optional"`
 } // @name CreateProjectConfigDTO
 
 type PrebuildDTO struct {
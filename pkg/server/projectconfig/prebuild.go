@@ -313,14 +313,15 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 		}
 	}
 
-	for _, build := range buildsToTrigger {
+	for _, b := range buildsToTrigger {
 		createBuildDto := build_dto.BuildCreationData{
-			Image:       build.ContainerConfig.Image,
-			User:        build.ContainerConfig.User,
-			BuildConfig: build.BuildConfig,
-			Repository:  build.Repository,
-			EnvVars:     build.EnvVars,
-			PrebuildId:  build.PrebuildId,
+			Image:       b.ContainerConfig.Image,
+			User:        b.ContainerConfig.User,
+			BuildConfig: b.BuildConfig,
+			Repository:  b.Repository,
+			EnvVars:     b.EnvVars,
+			PrebuildId:  b.PrebuildId,
+			Priority:    build.BuildPriorityNormal,
 		}
 
 		_, err = s.buildService.Create(createBuildDto)
@@ -140,6 +140,7 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventCommitInterval() {
 		Repository: repository1,
 		User:       projectConfig1.User,
 		Image:      projectConfig1.Image,
+		Priority:   build.BuildPriorityNormal,
 	}).Return("", nil)
 
 	s.buildService.On("Find", &build.Filter{
@@ -178,6 +179,7 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventTriggerFiles() {
 		Repository: repository1,
 		User:       projectConfig1.User,
 		Image:      projectConfig1.Image,
+		Priority:   build.BuildPriorityNormal,
 	}).Return("", nil)
 
 	data := gitprovider.GitEventData{
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/daytonaio/daytona/pkg/provider/fake"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	log "github.com/sirupsen/logrus"
 )
@@ -23,6 +24,15 @@ func (s *Server) downloadDefaultProviders() error {
 
 	log.Info("Downloading default providers")
 	for providerName, provider := range defaultProviders {
+		if pinnedVersion, ok := s.config.PinnedProviderVersions[providerName]; ok {
+			version, ok := (*manifest)[providerName].Versions[pinnedVersion]
+			if !ok {
+				log.Errorf("Pinned version %s of provider %s not found in manifest", pinnedVersion, providerName)
+				continue
+			}
+			provider = &version
+		}
+
 		lockFilePath := filepath.Join(s.config.ProvidersDir, providerName, manager.INITIAL_SETUP_LOCK_FILE_NAME)
 
 		_, err := os.Stat(lockFilePath)
@@ -30,7 +40,7 @@ func (s *Server) downloadDefaultProviders() error {
 			continue
 		}
 
-		_, err = s.ProviderManager.DownloadProvider(context.Background(), provider.DownloadUrls, providerName)
+		_, err = s.ProviderManager.DownloadProvider(context.Background(), *provider, providerName, false)
 		if err != nil {
 			if !manager.IsProviderAlreadyDownloaded(err, providerName) {
 				log.Error(err)
@@ -47,6 +57,13 @@ func (s *Server) downloadDefaultProviders() error {
 func (s *Server) registerProviders() error {
 	log.Info("Registering providers")
 
+	if os.Getenv("DAYTONA_FAKE_PROVIDER") == "true" {
+		err := s.registerFakeProvider()
+		if err != nil {
+			return err
+		}
+	}
+
 	manifest, err := s.ProviderManager.GetProvidersManifest()
 	if err != nil {
 		return err
@@ -115,7 +132,9 @@ func (s *Server) registerProviders() error {
 				}
 			}
 
-			if manifest.HasUpdateAvailable(info.Name, info.Version) {
+			if pinnedVersion, ok := s.config.PinnedProviderVersions[info.Name]; ok {
+				log.Infof("Provider %s is pinned to %s", info.Name, pinnedVersion)
+			} else if manifest.HasUpdateAvailable(info.Name, info.Version) {
 				log.Infof("Update available for %s. Update with `daytona provider update`.", info.Name)
 			}
 		}
@@ -126,6 +145,24 @@ func (s *Server) registerProviders() error {
 	return nil
 }
 
+// registerFakeProvider registers the in-process fake provider which lets the server run end-to-end tests without Docker
+func (s *Server) registerFakeProvider() error {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return err
+	}
+
+	// The state is kept outside of the providers directory so it is not mistaken for a provider binary
+	fakeProvider, err := fake.NewFakeProvider(fake.FakeProviderConfig{
+		StatePath: filepath.Join(configDir, "fake-provider-state.json"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.ProviderManager.RegisterInProcessProvider(fake.ProviderName, fakeProvider)
+}
+
 func (s *Server) getPluginPath(dir string) (string, error) {
 	files, err := os.ReadDir(dir)
 	if err != nil {
@@ -140,3 +177,30 @@ func (s *Server) getPluginPath(dir string) (string, error) {
 
 	return "", errors.New("no plugin found in " + dir)
 }
+
+// PinProviderVersion records the provider version in the server config so that it is kept on restart.
+// An empty version removes the pin
+func (s *Server) PinProviderVersion(providerName, version string) error {
+	c, err := GetConfig()
+	if err != nil {
+		return err
+	}
+
+	if version == "" {
+		delete(c.PinnedProviderVersions, providerName)
+	} else {
+		if c.PinnedProviderVersions == nil {
+			c.PinnedProviderVersions = map[string]string{}
+		}
+		c.PinnedProviderVersions[providerName] = version
+	}
+
+	err = Save(*c)
+	if err != nil {
+		return err
+	}
+
+	s.config.PinnedProviderVersions = c.PinnedProviderVersions
+
+	return nil
+}
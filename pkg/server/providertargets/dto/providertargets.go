@@ -6,7 +6,17 @@ package dto
 import "github.com/daytonaio/daytona/pkg/provider"
 
 type CreateProviderTargetDTO struct {
-	Name         string                `json:"name" validate:"required"`
-	ProviderInfo provider.ProviderInfo `json:"providerInfo" validate:"required"`
-	Options      string                `json:"options" validate:"required"`
+	Name                    string                `json:"name" validate:"required"`
+	ProviderInfo            provider.ProviderInfo `json:"providerInfo" validate:"required"`
+	Options                 string                `json:"options" validate:"required"`
+	IdleTimeout             *int                  `json:"idleTimeout,omitempty" validate:"optional"`
+	MaxConcurrentOperations *int                  `json:"maxConcurrentOperations,omitempty" validate:"optional"`
+	IsTemplate              bool                  `json:"isTemplate" validate:"optional"`
 } // @name CreateProviderTargetDTO
+
+type InstantiateTargetTemplateDTO struct {
+	// Name of the new target
+	Name string `json:"name" validate:"required"`
+	// Variable name -> value
+	Variables map[string]string `json:"variables" validate:"optional"`
+} // @name InstantiateTargetTemplateDTO
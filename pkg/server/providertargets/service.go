@@ -4,13 +4,17 @@
 package providertargets
 
 import (
+	"fmt"
+
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/provider"
 )
 
 type IProviderTargetService interface {
 	Delete(target *provider.ProviderTarget) error
 	Find(filter *provider.TargetFilter) (*provider.ProviderTarget, error)
+	Instantiate(templateName string, targetName string, variables map[string]string) (*provider.ProviderTarget, error)
 	List(filter *provider.TargetFilter) ([]*provider.ProviderTarget, error)
 	Map() (map[string]*provider.ProviderTarget, error)
 	Save(target *provider.ProviderTarget) error
@@ -19,15 +23,22 @@ type IProviderTargetService interface {
 
 type ProviderTargetServiceConfig struct {
 	TargetStore provider.TargetStore
+	EventBus    *events.EventBus
+	// Used to validate target options. Options are not validated if not set
+	GetTargetManifest func(providerName string) (*provider.ProviderTargetManifest, error)
 }
 
 type ProviderTargetService struct {
-	targetStore provider.TargetStore
+	targetStore       provider.TargetStore
+	eventBus          *events.EventBus
+	getTargetManifest func(providerName string) (*provider.ProviderTargetManifest, error)
 }
 
 func NewProviderTargetService(config ProviderTargetServiceConfig) IProviderTargetService {
 	return &ProviderTargetService{
-		targetStore: config.TargetStore,
+		targetStore:       config.TargetStore,
+		eventBus:          config.EventBus,
+		getTargetManifest: config.GetTargetManifest,
 	}
 }
 
@@ -54,16 +65,38 @@ func (s *ProviderTargetService) Find(filter *provider.TargetFilter) (*provider.P
 }
 
 func (s *ProviderTargetService) Save(target *provider.ProviderTarget) error {
-	err := s.targetStore.Save(target)
+	err := s.validateOptions(target)
 	if err != nil {
 		return err
 	}
 
+	// Templates are never used by default
+	if target.IsTemplate {
+		target.IsDefault = false
+	}
+
+	err = s.targetStore.Save(target)
+	if err != nil {
+		return err
+	}
+
+	if target.IsTemplate {
+		s.publishTargetChanged(target)
+		return nil
+	}
+
 	return s.SetDefault(target)
 }
 
 func (s *ProviderTargetService) Delete(target *provider.ProviderTarget) error {
-	return s.targetStore.Delete(target)
+	err := s.targetStore.Delete(target)
+	if err != nil {
+		return err
+	}
+
+	s.publishTargetChanged(target)
+
+	return nil
 }
 
 func (s *ProviderTargetService) SetDefault(target *provider.ProviderTarget) error {
@@ -74,6 +107,10 @@ func (s *ProviderTargetService) SetDefault(target *provider.ProviderTarget) erro
 		return err
 	}
 
+	if currentTarget.IsTemplate {
+		return provider.ErrTargetIsTemplate
+	}
+
 	defaultTarget, err := s.Find(&provider.TargetFilter{
 		Default: util.Pointer(true),
 	})
@@ -90,5 +127,37 @@ func (s *ProviderTargetService) SetDefault(target *provider.ProviderTarget) erro
 	}
 
 	currentTarget.IsDefault = true
-	return s.targetStore.Save(currentTarget)
+	err = s.targetStore.Save(currentTarget)
+	if err != nil {
+		return err
+	}
+
+	s.publishTargetChanged(currentTarget)
+
+	return nil
+}
+
+func (s *ProviderTargetService) publishTargetChanged(target *provider.ProviderTarget) {
+	event := events.NewEvent(events.EventTypeTargetChanged)
+	event.Target = target.Name
+	event.Provider = target.ProviderInfo.Name
+
+	s.eventBus.Publish(event)
+}
+
+func (s *ProviderTargetService) validateOptions(target *provider.ProviderTarget) error {
+	if s.getTargetManifest == nil {
+		return nil
+	}
+
+	manifest, err := s.getTargetManifest(target.ProviderInfo.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get target manifest: %w", err)
+	}
+
+	if target.IsTemplate {
+		return manifest.ValidateTemplateOptions(target.Name, target.Options)
+	}
+
+	return manifest.ValidateOptions(target.Name, target.Options)
 }
@@ -4,11 +4,16 @@
 package providertargets_test
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/daytonaio/daytona/internal/testing/provider/targets"
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -151,3 +156,150 @@ func (s *ProviderTargetServiceTestSuite) TestDelete() {
 	require.Nil(err)
 	require.ElementsMatch(expectedProviderTargets, providerTargets)
 }
+
+func TestProviderTargetServiceValidation(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "key")
+	err := os.WriteFile(filePath, []byte("key"), 0600)
+	require.NoError(t, err)
+
+	targetManifest := &provider.ProviderTargetManifest{
+		"Token": provider.ProviderTargetProperty{
+			Type:     provider.ProviderTargetPropertyTypeString,
+			Required: true,
+		},
+		"Port": provider.ProviderTargetProperty{
+			Type: provider.ProviderTargetPropertyTypeInt,
+		},
+		"Region": provider.ProviderTargetProperty{
+			Type:    provider.ProviderTargetPropertyTypeOption,
+			Options: []string{"eu", "us"},
+		},
+		"Key Path": provider.ProviderTargetProperty{
+			Type: provider.ProviderTargetPropertyTypeFilePath,
+		},
+		"Remote Host": provider.ProviderTargetProperty{
+			Type:              provider.ProviderTargetPropertyTypeString,
+			Required:          true,
+			DisabledPredicate: "^local$",
+		},
+	}
+
+	service := providertargets.NewProviderTargetService(providertargets.ProviderTargetServiceConfig{
+		TargetStore: targets.NewInMemoryTargetStore(),
+		GetTargetManifest: func(providerName string) (*provider.ProviderTargetManifest, error) {
+			return targetManifest, nil
+		},
+	})
+
+	newTarget := func(name, options string) *provider.ProviderTarget {
+		return &provider.ProviderTarget{
+			Name: name,
+			ProviderInfo: provider.ProviderInfo{
+				Name:    "provider1",
+				Version: "v1",
+			},
+			Options: options,
+		}
+	}
+
+	t.Run("Invalid options are rejected", func(t *testing.T) {
+		err := service.Save(newTarget("remote", fmt.Sprintf(`{"Port": 22.5, "Region": "asia", "Key Path": "%s"}`, filepath.Join(t.TempDir(), "missing"))))
+		require.True(t, provider.IsTargetOptionsError(err))
+
+		var targetOptionsErr provider.TargetOptionsError
+		require.ErrorAs(t, err, &targetOptionsErr)
+		fieldErrors := targetOptionsErr.FieldErrors()
+		require.Len(t, fieldErrors, 5)
+		for _, name := range []string{"Token", "Port", "Region", "Key Path", "Remote Host"} {
+			require.Contains(t, fieldErrors, name)
+		}
+
+		_, err = service.Find(&provider.TargetFilter{Name: util.Pointer("remote")})
+		require.Error(t, err)
+	})
+
+	t.Run("Disabled properties are not validated", func(t *testing.T) {
+		err := service.Save(newTarget("local", `{"Token": "secret"}`))
+		require.NoError(t, err)
+	})
+
+	t.Run("Valid options are saved", func(t *testing.T) {
+		err := service.Save(newTarget("remote", fmt.Sprintf(`{"Token": "secret", "Port": 22, "Region": "eu", "Key Path": "%s", "Remote Host": "example.com"}`, filePath)))
+		require.NoError(t, err)
+	})
+}
+
+func TestProviderTargetServiceTemplates(t *testing.T) {
+	targetManifest := &provider.ProviderTargetManifest{
+		"Region": provider.ProviderTargetProperty{
+			Type:     provider.ProviderTargetPropertyTypeOption,
+			Options:  []string{"eu", "us"},
+			Required: true,
+		},
+		"Host": provider.ProviderTargetProperty{
+			Type: provider.ProviderTargetPropertyTypeString,
+		},
+		"Port": provider.ProviderTargetProperty{
+			Type: provider.ProviderTargetPropertyTypeInt,
+		},
+	}
+
+	service := providertargets.NewProviderTargetService(providertargets.ProviderTargetServiceConfig{
+		TargetStore: targets.NewInMemoryTargetStore(),
+		GetTargetManifest: func(providerName string) (*provider.ProviderTargetManifest, error) {
+			return targetManifest, nil
+		},
+	})
+
+	template := &provider.ProviderTarget{
+		Name: "template",
+		ProviderInfo: provider.ProviderInfo{
+			Name:    "provider1",
+			Version: "v1",
+		},
+		Options:    `{"Region": "${region}", "Host": "${region}.example.com", "Port": "${port}"}`,
+		IsTemplate: true,
+	}
+
+	err := service.Save(template)
+	require.NoError(t, err)
+
+	t.Run("Templates are not used by default", func(t *testing.T) {
+		_, err := service.Find(&provider.TargetFilter{Default: util.Pointer(true)})
+		require.Error(t, err)
+
+		err = service.SetDefault(template)
+		require.True(t, provider.IsTargetIsTemplate(err))
+	})
+
+	t.Run("Templates are instantiated with the variable values", func(t *testing.T) {
+		target, err := service.Instantiate("template", "target-eu", map[string]string{"region": "eu", "port": "22"})
+		require.NoError(t, err)
+		require.False(t, target.IsTemplate)
+		require.JSONEq(t, `{"Region": "eu", "Host": "eu.example.com", "Port": 22}`, target.Options)
+
+		savedTarget, err := service.Find(&provider.TargetFilter{Name: util.Pointer("target-eu")})
+		require.NoError(t, err)
+		require.Equal(t, target, savedTarget)
+	})
+
+	t.Run("Undefined variables are rejected", func(t *testing.T) {
+		_, err := service.Instantiate("template", "target-us", map[string]string{"region": "us"})
+		require.True(t, provider.IsTargetOptionsError(err))
+	})
+
+	t.Run("Instantiated targets are validated", func(t *testing.T) {
+		_, err := service.Instantiate("template", "target-asia", map[string]string{"region": "asia", "port": "22"})
+		require.True(t, provider.IsTargetOptionsError(err))
+	})
+
+	t.Run("Existing targets are not overwritten", func(t *testing.T) {
+		_, err := service.Instantiate("template", "target-eu", map[string]string{"region": "us", "port": "22"})
+		require.True(t, provider.IsTargetAlreadyExists(err))
+	})
+
+	t.Run("Only templates can be instantiated", func(t *testing.T) {
+		_, err := service.Instantiate("target-eu", "target-us", map[string]string{})
+		require.True(t, provider.IsTargetIsNotTemplate(err))
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package providertargets
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+// Instantiate creates a target from the template by replacing the variable references in its options.
+// The new target is validated and saved like any other target
+func (s *ProviderTargetService) Instantiate(templateName string, targetName string, variables map[string]string) (*provider.ProviderTarget, error) {
+	template, err := s.Find(&provider.TargetFilter{
+		Name: &templateName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !template.IsTemplate {
+		return nil, provider.ErrTargetIsNotTemplate
+	}
+
+	_, err = s.Find(&provider.TargetFilter{
+		Name: &targetName,
+	})
+	if err == nil {
+		return nil, provider.ErrTargetAlreadyExists
+	}
+	if !provider.IsTargetNotFound(err) {
+		return nil, err
+	}
+
+	manifest := &provider.ProviderTargetManifest{}
+	if s.getTargetManifest != nil {
+		manifest, err = s.getTargetManifest(template.ProviderInfo.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get target manifest: %w", err)
+		}
+	}
+
+	options, err := manifest.InterpolateOptions(template.Options, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	target := &provider.ProviderTarget{
+		Name:                    targetName,
+		ProviderInfo:            template.ProviderInfo,
+		Options:                 options,
+		IdleTimeout:             template.IdleTimeout,
+		MaxConcurrentOperations: template.MaxConcurrentOperations,
+	}
+
+	err = s.Save(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
@@ -7,14 +7,18 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/operations"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/hashicorp/go-plugin"
@@ -32,11 +36,15 @@ type ServerInstanceConfig struct {
 	ProjectConfigService     projectconfig.IProjectConfigService
 	LocalContainerRegistry   ILocalContainerRegistry
 	WorkspaceService         workspaces.IWorkspaceService
+	OperationService         operations.IOperationService
+	EventBus                 *events.EventBus
+	WebhookService           webhooks.IWebhookService
 	ApiKeyService            apikeys.IApiKeyService
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
 	TelemetryService         telemetry.TelemetryService
+	ProvisioningQueue        provisioner.IProvisioningQueue
 }
 
 var server *Server
@@ -61,11 +69,15 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			ProjectConfigService:     serverConfig.ProjectConfigService,
 			LocalContainerRegistry:   serverConfig.LocalContainerRegistry,
 			WorkspaceService:         serverConfig.WorkspaceService,
+			OperationService:         serverConfig.OperationService,
+			EventBus:                 serverConfig.EventBus,
+			WebhookService:           serverConfig.WebhookService,
 			ApiKeyService:            serverConfig.ApiKeyService,
 			GitProviderService:       serverConfig.GitProviderService,
 			ProviderManager:          serverConfig.ProviderManager,
 			ProfileDataService:       serverConfig.ProfileDataService,
 			TelemetryService:         serverConfig.TelemetryService,
+			ProvisioningQueue:        serverConfig.ProvisioningQueue,
 		}
 	}
 
@@ -83,11 +95,15 @@ type Server struct {
 	ProjectConfigService     projectconfig.IProjectConfigService
 	LocalContainerRegistry   ILocalContainerRegistry
 	WorkspaceService         workspaces.IWorkspaceService
+	OperationService         operations.IOperationService
+	EventBus                 *events.EventBus
+	WebhookService           webhooks.IWebhookService
 	ApiKeyService            apikeys.IApiKeyService
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
 	TelemetryService         telemetry.TelemetryService
+	ProvisioningQueue        provisioner.IProvisioningQueue
 }
 
 func (s *Server) Initialize() error {
@@ -112,10 +128,29 @@ func (s *Server) Start() error {
 		log.Errorf("Failed to terminate orphaned provider processes: %s", err)
 	}
 
+	if s.config.ProviderPublicKey == "" {
+		log.Warn("No provider public key is configured so the signatures of downloaded providers are not verified. Set one with `daytona server configure`")
+	}
+
 	err = s.downloadDefaultProviders()
 	if err != nil {
 		return err
 	}
 
-	return s.registerProviders()
+	err = s.registerProviders()
+	if err != nil {
+		return err
+	}
+
+	err = s.ProviderManager.StartHealthChecks()
+	if err != nil {
+		return err
+	}
+
+	err = s.WebhookService.Start()
+	if err != nil {
+		return err
+	}
+
+	return s.WorkspaceService.Start()
 }
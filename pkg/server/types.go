@@ -34,8 +34,10 @@ type NetworkKey struct {
 } // @name NetworkKey
 
 type Config struct {
-	ProvidersDir              string         `json:"providersDir" validate:"required"`
-	RegistryUrl               string         `json:"registryUrl" validate:"required"`
+	ProvidersDir string `json:"providersDir" validate:"required"`
+	RegistryUrl  string `json:"registryUrl" validate:"required"`
+	// Base64 encoded ed25519 public key used to verify provider signatures
+	ProviderPublicKey         string         `json:"providerPublicKey,omitempty" validate:"optional"`
 	Id                        string         `json:"id" validate:"required"`
 	ServerDownloadUrl         string         `json:"serverDownloadUrl" validate:"required"`
 	Frps                      *FRPSConfig    `json:"frps,omitempty" validate:"optional"`
@@ -51,6 +53,11 @@ type Config struct {
 	BuilderRegistryServer     string         `json:"builderRegistryServer" validate:"required"`
 	BuildImageNamespace       string         `json:"buildImageNamespace" validate:"optional"`
 	SamplesIndexUrl           string         `json:"samplesIndexUrl" validate:"optional"`
+	ProjectIdleTimeout        int            `json:"projectIdleTimeout" validate:"optional"`
+	// Provider versions that are kept on restart and skipped by provider updates
+	PinnedProviderVersions map[string]string `json:"pinnedProviderVersions,omitempty" validate:"optional"`
+	// Path of the file holding the master key used to encrypt secrets at rest. Defaults to master.key in the server config dir
+	MasterKeyFile string `json:"masterKeyFile,omitempty" validate:"optional"`
 } // @name ServerConfig
 
 type LogFileConfig struct {
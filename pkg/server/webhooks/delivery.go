@@ -0,0 +1,298 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/google/uuid"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SignatureHeader = "X-Daytona-Signature"
+	EventHeader     = "X-Daytona-Event"
+	DeliveryHeader  = "X-Daytona-Delivery"
+)
+
+// payload is the body sent to webhooks. The text field makes the payload
+// directly usable by chat incoming webhooks such as Slack's
+type payload struct {
+	events.Event
+	Text string `json:"text"`
+}
+
+func (s *WebhookService) Start() error {
+	err := s.resumePendingDeliveries()
+	if err != nil {
+		return err
+	}
+
+	if s.eventBus == nil {
+		return nil
+	}
+
+	// Subscriptions drop events when they can not keep up, so webhooks queue every event with a handler instead
+	s.eventBus.Handle(s.enqueue)
+	go s.dispatch()
+
+	return nil
+}
+
+// enqueue is called by the event bus for every published event and must not block the publisher
+func (s *WebhookService) enqueue(event events.Event) {
+	s.queueMutex.Lock()
+	s.queue = append(s.queue, event)
+	s.queueMutex.Unlock()
+
+	select {
+	case s.queueSignal <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch delivers queued events to the matching webhooks. The webhooks are listed once for all events
+// queued at the time, so bursts of events do not query the store for every event
+func (s *WebhookService) dispatch() {
+	for range s.queueSignal {
+		s.queueMutex.Lock()
+		queued := s.queue
+		s.queue = nil
+		s.queueMutex.Unlock()
+
+		webhooks, err := s.webhookStore.List()
+		for err != nil {
+			log.Errorf("failed to list webhooks: %s", err)
+			time.Sleep(s.initialBackoff)
+			webhooks, err = s.webhookStore.List()
+		}
+
+		for _, event := range queued {
+			for _, w := range webhooks {
+				if w.Matches(event) {
+					go s.deliver(w, event)
+				}
+			}
+		}
+	}
+}
+
+// resumePendingDeliveries continues the attempts of deliveries that were pending when the server stopped
+func (s *WebhookService) resumePendingDeliveries() error {
+	deliveries, err := s.webhookStore.ListPendingDeliveries()
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		w, err := s.webhookStore.Find(delivery.WebhookId)
+		if err != nil {
+			if webhook.IsWebhookNotFound(err) {
+				err = s.webhookStore.DeleteDeliveries(delivery.WebhookId)
+				if err != nil {
+					log.Error(err)
+				}
+				continue
+			}
+			log.Errorf("failed to resume delivery %s: %s", delivery.Id, err)
+			continue
+		}
+
+		go s.attempt(w, delivery)
+	}
+
+	return nil
+}
+
+// deliver stores a pending delivery of the event, so that it is resumed if the server stops, and attempts it
+func (s *WebhookService) deliver(w *webhook.Webhook, event events.Event) {
+	body, err := json.Marshal(payload{
+		Event: event,
+		Text:  describeEvent(event),
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	delivery := &webhook.Delivery{
+		Id:        uuid.NewString(),
+		WebhookId: w.Id,
+		EventId:   event.Id,
+		EventType: event.Type,
+		Status:    webhook.DeliveryStatusPending,
+		Payload:   string(body),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if !s.saveDelivery(w, delivery) {
+		return
+	}
+
+	s.attempt(w, delivery)
+}
+
+// attempt sends the delivery to the webhook, retrying with exponential backoff
+// until the webhook responds with a 2xx status or the attempts run out
+func (s *WebhookService) attempt(w *webhook.Webhook, delivery *webhook.Delivery) {
+	for {
+		if delivery.NextAttemptAt != nil {
+			time.Sleep(time.Until(*delivery.NextAttemptAt))
+		}
+
+		if !s.webhookExists(w.Id) {
+			return
+		}
+
+		statusCode, err := s.send(w, delivery, []byte(delivery.Payload))
+
+		delivery.Attempts++
+		delivery.UpdatedAt = time.Now()
+		delivery.StatusCode = statusCode
+		delivery.NextAttemptAt = nil
+		delivery.Error = nil
+		if err != nil {
+			errMessage := err.Error()
+			delivery.Error = &errMessage
+		}
+
+		if err == nil {
+			delivery.Status = webhook.DeliveryStatusSuccess
+		} else if delivery.Attempts >= s.maxAttempts {
+			delivery.Status = webhook.DeliveryStatusFailed
+		} else {
+			nextAttemptAt := time.Now().Add(s.initialBackoff << (delivery.Attempts - 1))
+			delivery.NextAttemptAt = &nextAttemptAt
+		}
+
+		if !s.saveDelivery(w, delivery) {
+			return
+		}
+
+		if delivery.Status != webhook.DeliveryStatusPending {
+			err = s.webhookStore.PruneDeliveries(w.Id, s.maxDeliveries)
+			if err != nil {
+				log.Error(err)
+			}
+			return
+		}
+	}
+}
+
+// saveDelivery saves the delivery and returns false if the webhook was deleted in the meantime.
+// The webhook is checked after saving, so a delivery saved while the webhook is deleted is removed again
+func (s *WebhookService) saveDelivery(w *webhook.Webhook, delivery *webhook.Delivery) bool {
+	err := s.webhookStore.SaveDelivery(delivery)
+	if err != nil {
+		log.Error(err)
+	}
+
+	if s.webhookExists(w.Id) {
+		return true
+	}
+
+	err = s.webhookStore.DeleteDeliveries(w.Id)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return false
+}
+
+// webhookExists reports whether the webhook was not deleted. Deliveries are continued if the store can not be read
+func (s *WebhookService) webhookExists(webhookId string) bool {
+	_, err := s.webhookStore.Find(webhookId)
+	if err == nil {
+		return true
+	}
+
+	if webhook.IsWebhookNotFound(err) {
+		return false
+	}
+
+	log.Error(err)
+	return true
+}
+
+func (s *WebhookService) send(w *webhook.Webhook, delivery *webhook.Delivery, body []byte) (*int, error) {
+	req, err := http.NewRequest(http.MethodPost, w.Url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(DeliveryHeader, delivery.Id)
+	req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return &res.StatusCode, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return &res.StatusCode, nil
+}
+
+// Sign returns the value of the signature header for the payload,
+// the hex encoded HMAC-SHA256 of the payload keyed with the webhook secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func describeEvent(event events.Event) string {
+	var text string
+
+	switch event.Type {
+	case events.EventTypeWorkspaceCreated:
+		text = fmt.Sprintf("Workspace %s created", event.WorkspaceId)
+	case events.EventTypeWorkspaceStarted:
+		text = fmt.Sprintf("Workspace %s started", event.WorkspaceId)
+	case events.EventTypeWorkspaceStopped:
+		text = fmt.Sprintf("Workspace %s stopped", event.WorkspaceId)
+	case events.EventTypeWorkspaceError:
+		text = fmt.Sprintf("Workspace %s failed", event.WorkspaceId)
+	case events.EventTypeProjectStatusChanged:
+		text = fmt.Sprintf("Project %s in workspace %s is %s", event.ProjectName, event.WorkspaceId, event.State)
+	case events.EventTypeBuildStateChanged:
+		text = fmt.Sprintf("Build %s is %s", event.BuildId, event.State)
+	case events.EventTypeBuildFailed:
+		text = fmt.Sprintf("Build %s failed", event.BuildId)
+		if event.PrebuildId != "" {
+			text = fmt.Sprintf("Build %s of prebuild %s failed", event.BuildId, event.PrebuildId)
+		}
+	case events.EventTypePrebuildTriggered:
+		text = fmt.Sprintf("Prebuild %s triggered build %s", event.PrebuildId, event.BuildId)
+	case events.EventTypeProviderInstalled:
+		text = fmt.Sprintf("Provider %s installed", event.Provider)
+	case events.EventTypeTargetChanged:
+		text = fmt.Sprintf("Target %s changed", event.Target)
+	default:
+		text = string(event.Type)
+	}
+
+	if event.Error != "" {
+		text = fmt.Sprintf("%s: %s", text, event.Error)
+	}
+
+	return "[Daytona] " + text
+}
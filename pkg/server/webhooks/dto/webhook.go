@@ -0,0 +1,13 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/events"
+
+type CreateWebhookDTO struct {
+	Url    string `json:"url" validate:"required"`
+	Secret string `json:"secret" validate:"required"`
+	// Event types delivered to the webhook, all events are delivered if empty
+	Events []events.EventType `json:"events" validate:"optional"`
+} // @name CreateWebhookDTO
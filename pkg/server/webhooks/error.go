@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import "errors"
+
+var (
+	ErrInvalidWebhookUrl = errors.New("webhook url must be an absolute http or https url")
+	ErrMissingSecret     = errors.New("webhook secret is required")
+	ErrUnknownEventType  = errors.New("unknown event type")
+)
+
+func IsInvalidWebhookUrl(err error) bool {
+	return err.Error() == ErrInvalidWebhookUrl.Error()
+}
+
+func IsMissingSecret(err error) bool {
+	return err.Error() == ErrMissingSecret.Error()
+}
+
+func IsUnknownEventType(err error) bool {
+	return err.Error() == ErrUnknownEventType.Error()
+}
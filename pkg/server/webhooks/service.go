@@ -0,0 +1,148 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/server/webhooks/dto"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+const (
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = 2 * time.Second
+	defaultMaxDeliveries  = 100
+	deliveryTimeout       = 10 * time.Second
+)
+
+type IWebhookService interface {
+	Create(req dto.CreateWebhookDTO) (*webhook.Webhook, error)
+	List() ([]*webhook.Webhook, error)
+	Delete(id string) error
+	ListDeliveries(webhookId string) ([]*webhook.Delivery, error)
+	// Start resumes pending deliveries and delivers events published on the event bus to the matching webhooks
+	Start() error
+}
+
+type WebhookServiceConfig struct {
+	WebhookStore webhook.Store
+	EventBus     *events.EventBus
+	// Number of attempts made for each delivery, defaults to 5
+	MaxAttempts int
+	// Delay before the first retry, doubled after every failed attempt, defaults to 2s
+	InitialBackoff time.Duration
+	// Number of deliveries kept in the history of each webhook, defaults to 100
+	MaxDeliveries int
+}
+
+func NewWebhookService(config WebhookServiceConfig) IWebhookService {
+	maxAttempts := config.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	initialBackoff := config.InitialBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = defaultInitialBackoff
+	}
+
+	maxDeliveries := config.MaxDeliveries
+	if maxDeliveries <= 0 {
+		maxDeliveries = defaultMaxDeliveries
+	}
+
+	return &WebhookService{
+		webhookStore:   config.WebhookStore,
+		eventBus:       config.EventBus,
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+		maxDeliveries:  maxDeliveries,
+		httpClient:     &http.Client{Timeout: deliveryTimeout},
+		queueSignal:    make(chan struct{}, 1),
+	}
+}
+
+type WebhookService struct {
+	webhookStore   webhook.Store
+	eventBus       *events.EventBus
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxDeliveries  int
+	httpClient     *http.Client
+
+	// Events waiting to be dispatched to the webhooks
+	queue       []events.Event
+	queueMutex  sync.Mutex
+	queueSignal chan struct{}
+}
+
+func (s *WebhookService) Create(req dto.CreateWebhookDTO) (*webhook.Webhook, error) {
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidWebhookUrl
+	}
+
+	if req.Secret == "" {
+		return nil, ErrMissingSecret
+	}
+
+	eventTypes := []events.EventType{}
+	for _, eventType := range req.Events {
+		if !eventType.IsValid() {
+			return nil, ErrUnknownEventType
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	id := stringid.GenerateRandomID()
+	id = stringid.TruncateID(id)
+
+	w := &webhook.Webhook{
+		Id:        id,
+		Url:       req.Url,
+		Secret:    req.Secret,
+		Events:    eventTypes,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.webhookStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (s *WebhookService) List() ([]*webhook.Webhook, error) {
+	return s.webhookStore.List()
+}
+
+func (s *WebhookService) Delete(id string) error {
+	w, err := s.webhookStore.Find(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.webhookStore.Delete(w)
+	if err != nil {
+		return err
+	}
+
+	return s.webhookStore.DeleteDeliveries(w.Id)
+}
+
+func (s *WebhookService) ListDeliveries(webhookId string) ([]*webhook.Delivery, error) {
+	_, err := s.webhookStore.Find(webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.webhookStore.ListDeliveries(webhookId)
+}
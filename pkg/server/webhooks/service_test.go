@@ -0,0 +1,347 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	t_webhooks "github.com/daytonaio/daytona/internal/testing/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/server/webhooks/dto"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+type receiver struct {
+	server   *httptest.Server
+	mutex    sync.Mutex
+	requests []receivedRequest
+	// Number of requests answered with an error before succeeding
+	failures int
+}
+
+func newReceiver(failures int) *receiver {
+	r := &receiver{failures: failures}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		r.requests = append(r.requests, receivedRequest{header: req.Header, body: body})
+		if len(r.requests) <= r.failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	return r
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]receivedRequest{}, r.requests...)
+}
+
+func waitForDelivery(t *testing.T, service webhooks.IWebhookService, webhookId string) *webhook.Delivery {
+	var delivery *webhook.Delivery
+
+	require.Eventually(t, func() bool {
+		deliveries, err := service.ListDeliveries(webhookId)
+		require.Nil(t, err)
+		if len(deliveries) == 0 {
+			return false
+		}
+		delivery = deliveries[0]
+		return delivery.Status != webhook.DeliveryStatusPending
+	}, 2*time.Second, 10*time.Millisecond)
+
+	return delivery
+}
+
+func TestWebhookService(t *testing.T) {
+	eventBus := events.NewEventBus()
+
+	service := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore:   t_webhooks.NewInMemoryWebhookStore(),
+		EventBus:       eventBus,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	})
+
+	err := service.Start()
+	require.Nil(t, err)
+
+	t.Run("Create validates the request", func(t *testing.T) {
+		_, err := service.Create(dto.CreateWebhookDTO{Url: "ftp://example.com", Secret: "secret"})
+		require.True(t, webhooks.IsInvalidWebhookUrl(err))
+
+		_, err = service.Create(dto.CreateWebhookDTO{Url: "https://example.com"})
+		require.True(t, webhooks.IsMissingSecret(err))
+
+		_, err = service.Create(dto.CreateWebhookDTO{Url: "https://example.com", Secret: "secret", Events: []events.EventType{"unknown"}})
+		require.True(t, webhooks.IsUnknownEventType(err))
+	})
+
+	t.Run("Delivers matching events with a signature", func(t *testing.T) {
+		r := newReceiver(0)
+		defer r.server.Close()
+
+		w, err := service.Create(dto.CreateWebhookDTO{
+			Url:    r.server.URL,
+			Secret: "secret",
+			Events: []events.EventType{events.EventTypeBuildFailed},
+		})
+		require.Nil(t, err)
+
+		eventBus.Publish(events.NewEvent(events.EventTypeBuildStateChanged))
+
+		event := events.NewEvent(events.EventTypeBuildFailed)
+		event.BuildId = "build1"
+		event.PrebuildId = "prebuild1"
+		eventBus.Publish(event)
+
+		delivery := waitForDelivery(t, service, w.Id)
+
+		require.Equal(t, webhook.DeliveryStatusSuccess, delivery.Status)
+		require.Equal(t, event.Id, delivery.EventId)
+		require.Equal(t, 1, delivery.Attempts)
+
+		requests := r.received()
+		require.Len(t, requests, 1)
+		require.Equal(t, string(events.EventTypeBuildFailed), requests[0].header.Get(webhooks.EventHeader))
+		require.Equal(t, delivery.Id, requests[0].header.Get(webhooks.DeliveryHeader))
+		require.Equal(t, webhooks.Sign("secret", requests[0].body), requests[0].header.Get(webhooks.SignatureHeader))
+
+		var body map[string]interface{}
+		err = json.Unmarshal(requests[0].body, &body)
+		require.Nil(t, err)
+		require.Equal(t, "build1", body["buildId"])
+		require.Equal(t, "[Daytona] Build build1 of prebuild prebuild1 failed", body["text"])
+
+		require.Nil(t, service.Delete(w.Id))
+	})
+
+	t.Run("Retries failed deliveries", func(t *testing.T) {
+		r := newReceiver(2)
+		defer r.server.Close()
+
+		w, err := service.Create(dto.CreateWebhookDTO{Url: r.server.URL, Secret: "secret"})
+		require.Nil(t, err)
+
+		eventBus.Publish(events.NewEvent(events.EventTypeWorkspaceError))
+
+		delivery := waitForDelivery(t, service, w.Id)
+
+		require.Equal(t, webhook.DeliveryStatusSuccess, delivery.Status)
+		require.Equal(t, 3, delivery.Attempts)
+		require.Len(t, r.received(), 3)
+
+		require.Nil(t, service.Delete(w.Id))
+	})
+
+	t.Run("Marks the delivery as failed after the last attempt", func(t *testing.T) {
+		r := newReceiver(3)
+		defer r.server.Close()
+
+		w, err := service.Create(dto.CreateWebhookDTO{Url: r.server.URL, Secret: "secret"})
+		require.Nil(t, err)
+
+		eventBus.Publish(events.NewEvent(events.EventTypeWorkspaceError))
+
+		delivery := waitForDelivery(t, service, w.Id)
+
+		require.Equal(t, webhook.DeliveryStatusFailed, delivery.Status)
+		require.Equal(t, 3, delivery.Attempts)
+		require.NotNil(t, delivery.StatusCode)
+		require.Equal(t, http.StatusInternalServerError, *delivery.StatusCode)
+		require.NotNil(t, delivery.Error)
+
+		require.Nil(t, service.Delete(w.Id))
+	})
+
+	t.Run("Delete removes the webhook and its deliveries", func(t *testing.T) {
+		w, err := service.Create(dto.CreateWebhookDTO{Url: "https://example.com", Secret: "secret"})
+		require.Nil(t, err)
+
+		err = service.Delete(w.Id)
+		require.Nil(t, err)
+
+		_, err = service.ListDeliveries(w.Id)
+		require.True(t, webhook.IsWebhookNotFound(err))
+
+		err = service.Delete(w.Id)
+		require.True(t, webhook.IsWebhookNotFound(err))
+	})
+
+	t.Run("Delivers every event of a burst", func(t *testing.T) {
+		r := newReceiver(0)
+		defer r.server.Close()
+
+		eventBus := events.NewEventBus()
+
+		service := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+			WebhookStore: t_webhooks.NewInMemoryWebhookStore(),
+			EventBus:     eventBus,
+		})
+
+		_, err := service.Create(dto.CreateWebhookDTO{Url: r.server.URL, Secret: "secret"})
+		require.Nil(t, err)
+
+		err = service.Start()
+		require.Nil(t, err)
+
+		// More events than an event bus subscription buffers
+		for i := 0; i < 150; i++ {
+			eventBus.Publish(events.NewEvent(events.EventTypeProjectStatusChanged))
+		}
+
+		require.Eventually(t, func() bool {
+			return len(r.received()) == 150
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("Stops retrying deliveries of deleted webhooks", func(t *testing.T) {
+		r := newReceiver(100)
+		defer r.server.Close()
+
+		eventBus := events.NewEventBus()
+		webhookStore := t_webhooks.NewInMemoryWebhookStore()
+
+		service := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+			WebhookStore:   webhookStore,
+			EventBus:       eventBus,
+			MaxAttempts:    5,
+			InitialBackoff: 20 * time.Millisecond,
+		})
+
+		w, err := service.Create(dto.CreateWebhookDTO{Url: r.server.URL, Secret: "secret"})
+		require.Nil(t, err)
+
+		err = service.Start()
+		require.Nil(t, err)
+
+		eventBus.Publish(events.NewEvent(events.EventTypeWorkspaceError))
+
+		require.Eventually(t, func() bool {
+			return len(r.received()) > 0
+		}, 2*time.Second, 10*time.Millisecond)
+
+		require.Nil(t, service.Delete(w.Id))
+
+		// Wait for the remaining attempts of the delivery
+		time.Sleep(400 * time.Millisecond)
+
+		received := len(r.received())
+		require.LessOrEqual(t, received, 2)
+
+		deliveries, err := webhookStore.ListPendingDeliveries()
+		require.Nil(t, err)
+		require.Empty(t, deliveries)
+
+		deliveries, err = webhookStore.ListDeliveries(w.Id)
+		require.Nil(t, err)
+		require.Empty(t, deliveries)
+	})
+
+	t.Run("Prunes the delivery history", func(t *testing.T) {
+		r := newReceiver(0)
+		defer r.server.Close()
+
+		service := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+			WebhookStore:   t_webhooks.NewInMemoryWebhookStore(),
+			EventBus:       eventBus,
+			MaxDeliveries:  2,
+			InitialBackoff: time.Millisecond,
+		})
+
+		w, err := service.Create(dto.CreateWebhookDTO{Url: r.server.URL, Secret: "secret"})
+		require.Nil(t, err)
+
+		err = service.Start()
+		require.Nil(t, err)
+
+		for i := 0; i < 3; i++ {
+			eventBus.Publish(events.NewEvent(events.EventTypeWorkspaceStarted))
+		}
+
+		require.Eventually(t, func() bool {
+			deliveries, err := service.ListDeliveries(w.Id)
+			require.Nil(t, err)
+			for _, delivery := range deliveries {
+				if delivery.Status != webhook.DeliveryStatusSuccess {
+					return false
+				}
+			}
+			return len(r.received()) == 3 && len(deliveries) == 2
+		}, 2*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestWebhookServiceResumesPendingDeliveries(t *testing.T) {
+	r := newReceiver(0)
+	defer r.server.Close()
+
+	webhookStore := t_webhooks.NewInMemoryWebhookStore()
+
+	w := &webhook.Webhook{
+		Id:        "webhook1",
+		Url:       r.server.URL,
+		Secret:    "secret",
+		CreatedAt: time.Now(),
+	}
+	require.Nil(t, webhookStore.Save(w))
+
+	nextAttemptAt := time.Now()
+	err := webhookStore.SaveDelivery(&webhook.Delivery{
+		Id:            "delivery1",
+		WebhookId:     w.Id,
+		EventId:       "event1",
+		EventType:     events.EventTypeWorkspaceError,
+		Status:        webhook.DeliveryStatusPending,
+		Attempts:      1,
+		NextAttemptAt: &nextAttemptAt,
+		Payload:       `{"id":"event1"}`,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	})
+	require.Nil(t, err)
+
+	service := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore:   webhookStore,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	})
+
+	err = service.Start()
+	require.Nil(t, err)
+
+	delivery := waitForDelivery(t, service, w.Id)
+
+	require.Equal(t, webhook.DeliveryStatusSuccess, delivery.Status)
+	require.Equal(t, 2, delivery.Attempts)
+	require.Nil(t, delivery.NextAttemptAt)
+
+	// The stored payload is sent and signed as it was on the first attempt
+	requests := r.received()
+	require.Len(t, requests, 1)
+	require.Equal(t, `{"id":"event1"}`, string(requests[0].body))
+	require.Equal(t, "delivery1", requests[0].header.Get(webhooks.DeliveryHeader))
+	require.Equal(t, webhooks.Sign("secret", requests[0].body), requests[0].header.Get(webhooks.SignatureHeader))
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+// requireCapability returns unsupportedErr if the provider of the target does not declare the capability
+func (s *WorkspaceService) requireCapability(ctx context.Context, target *provider.ProviderTarget, supported func(provider.ProviderCapabilities) bool, unsupportedErr error) error {
+	capabilities, err := s.provisioner.GetCapabilities(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	if !supported(*capabilities) {
+		return unsupportedErr
+	}
+
+	return nil
+}
+
+func supportsMultiProject(c provider.ProviderCapabilities) bool {
+	return c.MultiProject
+}
+
+func supportsStopStart(c provider.ProviderCapabilities) bool {
+	return c.StopStart
+}
+
+func supportsSnapshots(c provider.ProviderCapabilities) bool {
+	return c.Snapshots
+}
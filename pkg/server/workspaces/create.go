@@ -15,8 +15,10 @@ import (
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/operation"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
@@ -56,75 +58,49 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		return nil, ErrInvalidWorkspaceName
 	}
 
-	w := &workspace.Workspace{
-		Id:     req.Id,
-		Name:   req.Name,
-		Target: req.Target,
+	expiresAt, err := getWorkspaceExpiry(req)
+	if err != nil {
+		return nil, err
 	}
 
-	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &req.Target})
 	if err != nil {
 		return nil, err
 	}
-	w.ApiKey = apiKey
-
-	w.Projects = []*project.Project{}
-
-	for _, projectDto := range req.Projects {
-		p := conversion.CreateDtoToProject(projectDto)
-
-		isValidProjectName := regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString
-		if !isValidProjectName(p.Name) {
-			return nil, ErrInvalidProjectName
-		}
 
-		p.Repository.Url = util.CleanUpRepositoryUrl(p.Repository.Url)
-		if p.GitProviderConfigId == nil || *p.GitProviderConfigId == "" {
-			configs, err := s.gitProviderService.ListConfigsForUrl(p.Repository.Url)
-			if err != nil {
-				return nil, err
-			}
-
-			if len(configs) > 1 {
-				return nil, errors.New("multiple git provider configs found for the repository url")
-			}
-
-			if len(configs) == 1 {
-				p.GitProviderConfigId = &configs[0].Id
-			}
-		}
+	if target.IsTemplate {
+		return nil, provider.ErrTargetIsTemplate
+	}
 
-		if p.Repository.Sha == "" {
-			sha, err := s.gitProviderService.GetLastCommitSha(p.Repository)
-			if err != nil {
-				return nil, err
-			}
-			p.Repository.Sha = sha
+	if len(req.Projects) > 1 {
+		err = s.requireCapability(ctx, target, supportsMultiProject, ErrMultiProjectNotSupported)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		if p.BuildConfig != nil {
-			cachedBuild, err := s.getCachedBuildForProject(p)
-			if err == nil {
-				p.BuildConfig.CachedBuild = cachedBuild
-			}
-		}
+	w := &workspace.Workspace{
+		Id:        req.Id,
+		Name:      req.Name,
+		Target:    req.Target,
+		ExpiresAt: expiresAt,
+		Status:    workspace.WorkspaceStatusCreating,
+	}
 
-		if p.Image == "" {
-			p.Image = s.defaultProjectImage
-		}
+	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
+	if err != nil {
+		return nil, err
+	}
+	w.ApiKey = apiKey
 
-		if p.User == "" {
-			p.User = s.defaultProjectUser
-		}
+	w.Projects = []*project.Project{}
 
-		apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
+	for _, projectDto := range req.Projects {
+		p, err := s.newProject(w, projectDto)
 		if err != nil {
 			return nil, err
 		}
 
-		p.WorkspaceId = w.Id
-		p.ApiKey = apiKey
-		p.Target = w.Target
 		w.Projects = append(w.Projects, p)
 	}
 
@@ -133,12 +109,10 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		return nil, err
 	}
 
-	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
-	if err != nil {
-		return w, err
-	}
-
 	w, err = s.createWorkspace(ctx, w, target)
+	if err == nil {
+		s.publishWorkspaceEvent(events.EventTypeWorkspaceCreated, w)
+	}
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return w, err
@@ -160,17 +134,79 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	return w, err
 }
 
-func (s *WorkspaceService) createProject(p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+// newProject creates a project from the given DTO, ready to be added to the workspace
+func (s *WorkspaceService) newProject(w *workspace.Workspace, projectDto dto.CreateProjectDTO) (*project.Project, error) {
+	p := conversion.CreateDtoToProject(projectDto)
+
+	isValidProjectName := regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString
+	if !isValidProjectName(p.Name) {
+		return nil, ErrInvalidProjectName
+	}
+
+	p.Repository.Url = util.CleanUpRepositoryUrl(p.Repository.Url)
+	if p.GitProviderConfigId == nil || *p.GitProviderConfigId == "" {
+		configs, err := s.gitProviderService.ListConfigsForUrl(p.Repository.Url)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(configs) > 1 {
+			return nil, errors.New("multiple git provider configs found for the repository url")
+		}
+
+		if len(configs) == 1 {
+			p.GitProviderConfigId = &configs[0].Id
+		}
+	}
+
+	if p.Repository.Sha == "" {
+		sha, err := s.gitProviderService.GetLastCommitSha(p.Repository)
+		if err != nil {
+			return nil, err
+		}
+		p.Repository.Sha = sha
+	}
+
+	if p.BuildConfig != nil {
+		cachedBuild, err := s.getCachedBuildForProject(p)
+		if err == nil {
+			p.BuildConfig.CachedBuild = cachedBuild
+		}
+	}
+
+	if p.Image == "" {
+		p.Image = s.defaultProjectImage
+	}
+
+	if p.User == "" {
+		p.User = s.defaultProjectUser
+	}
+
+	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	p.WorkspaceId = w.Id
+	p.ApiKey = apiKey
+	p.Target = w.Target
+	p.Status = project.ProjectStatusCreating
+
+	return p, nil
+}
+
+func (s *WorkspaceService) createProject(ctx context.Context, w *workspace.Workspace, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
 	logWriter.Write([]byte(fmt.Sprintf("Creating project %s\n", p.Name)))
+	operation.ReportProgress(ctx, fmt.Sprintf("Creating project %s", p.Name))
 
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return s.setProjectError(w, p, err)
 	}
 
 	builderCr, err := s.containerRegistryService.FindByImageName(s.builderImage)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return s.setProjectError(w, p, err)
 	}
 
 	var gc *gitprovider.GitProviderConfig
@@ -178,11 +214,11 @@ func (s *WorkspaceService) createProject(p *project.Project, target *provider.Pr
 	if p.GitProviderConfigId != nil {
 		gc, err = s.gitProviderService.GetConfig(*p.GitProviderConfigId)
 		if err != nil && !gitprovider.IsGitProviderNotFound(err) {
-			return err
+			return s.setProjectError(w, p, err)
 		}
 	}
 
-	err = s.provisioner.CreateProject(provisioner.ProjectParams{
+	err = s.provisioner.CreateProject(provisioner.WithLogWriter(ctx, logWriter), provisioner.ProjectParams{
 		Project:                       p,
 		Target:                        target,
 		ContainerRegistry:             cr,
@@ -191,7 +227,7 @@ func (s *WorkspaceService) createProject(p *project.Project, target *provider.Pr
 		BuilderImageContainerRegistry: builderCr,
 	})
 	if err != nil {
-		return err
+		return s.setProjectError(w, p, err)
 	}
 
 	logWriter.Write([]byte(fmt.Sprintf("Project %s created\n", p.Name)))
@@ -204,6 +240,7 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 	defer wsLogger.Close()
 
 	wsLogger.Write([]byte(fmt.Sprintf("Creating workspace %s (%s)\n", ws.Name, ws.Id)))
+	operation.ReportProgress(ctx, fmt.Sprintf("Creating workspace %s", ws.Name))
 
 	ws.EnvVars = workspace.GetWorkspaceEnvVars(ws, workspace.WorkspaceEnvVarParams{
 		ApiUrl:        s.serverApiUrl,
@@ -212,30 +249,18 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 		ClientId:      telemetry.ClientId(ctx),
 	}, telemetry.TelemetryEnabled(ctx))
 
-	err := s.provisioner.CreateWorkspace(ws, target)
+	err := s.provisioner.CreateWorkspace(provisioner.WithLogWriter(ctx, wsLogger), ws, target)
 	if err != nil {
-		return nil, err
+		return nil, s.setWorkspaceError(ws, err)
 	}
 
 	for i, p := range ws.Projects {
 		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, p.Name, logs.LogSourceServer)
 		defer projectLogger.Close()
 
-		projectWithEnv := *p
-		projectWithEnv.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
-			ApiUrl:        s.serverApiUrl,
-			ServerUrl:     s.serverUrl,
-			ServerVersion: s.serverVersion,
-			ClientId:      telemetry.ClientId(ctx),
-		}, telemetry.TelemetryEnabled(ctx))
-
-		for k, v := range p.EnvVars {
-			projectWithEnv.EnvVars[k] = v
-		}
-
 		var err error
 
-		p = &projectWithEnv
+		p = s.withProjectEnvVars(ctx, p)
 
 		ws.Projects[i] = p
 		err = s.workspaceStore.Save(ws)
@@ -243,9 +268,9 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 			return nil, err
 		}
 
-		err = s.createProject(p, target, projectLogger)
+		err = s.createProject(ctx, ws, p, target, projectLogger)
 		if err != nil {
-			return nil, err
+			return nil, s.setWorkspaceError(ws, err)
 		}
 	}
 
@@ -259,6 +284,23 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 	return ws, nil
 }
 
+// withProjectEnvVars returns a copy of the project with the server-provided env vars merged with the project's own
+func (s *WorkspaceService) withProjectEnvVars(ctx context.Context, p *project.Project) *project.Project {
+	projectWithEnv := *p
+	projectWithEnv.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
+		ApiUrl:        s.serverApiUrl,
+		ServerUrl:     s.serverUrl,
+		ServerVersion: s.serverVersion,
+		ClientId:      telemetry.ClientId(ctx),
+	}, telemetry.TelemetryEnabled(ctx))
+
+	for k, v := range p.EnvVars {
+		projectWithEnv.EnvVars[k] = v
+	}
+
+	return &projectWithEnv
+}
+
 func (s *WorkspaceService) getCachedBuildForProject(p *project.Project) (*buildconfig.CachedBuild, error) {
 	validStates := &[]build.BuildState{
 		build.BuildState(build.BuildStatePublished),
@@ -4,6 +4,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -25,8 +27,42 @@ type CreateWorkspaceDTO struct {
 	Name     string             `json:"name" validate:"required"`
 	Target   string             `json:"target" validate:"required"`
 	Projects []CreateProjectDTO `json:"projects" validate:"required,gt=0,dive"`
+	// Duration (e.g. "24h") after which the workspace is removed. Mutually exclusive with ExpiresAt
+	TTL       *string    `json:"ttl,omitempty" validate:"optional"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" validate:"optional"`
 } //	@name	CreateWorkspaceDTO
 
+type ExtendWorkspaceDTO struct {
+	// Duration (e.g. "2h") by which the workspace expiry is pushed back
+	Duration string `json:"duration" validate:"required"`
+} //	@name	ExtendWorkspaceDTO
+
+type DuplicateWorkspaceDTO struct {
+	Id   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+	// Commits to check out in the duplicated projects, keyed by project name
+	Commits map[string]string `json:"commits,omitempty" validate:"optional"`
+} //	@name	DuplicateWorkspaceDTO
+
+type MigrateWorkspaceDTO struct {
+	Target string `json:"target" validate:"required"`
+	// Commits to check out in the migrated projects, keyed by project name
+	Commits map[string]string `json:"commits,omitempty" validate:"optional"`
+	// Carry the project containers over to the new target as snapshot images
+	UseSnapshots bool `json:"useSnapshots" validate:"optional"`
+} //	@name	MigrateWorkspaceDTO
+
+type CreateSnapshotDTO struct {
+	// Copy the project directory into the snapshot in addition to the container filesystem
+	IncludeVolume bool `json:"includeVolume" validate:"optional"`
+} //	@name	CreateSnapshotDTO
+
+type RestoreSnapshotDTO struct {
+	SnapshotId string `json:"snapshotId" validate:"required"`
+	// Name of the project created from the snapshot
+	ProjectName string `json:"projectName" validate:"required"`
+} //	@name	RestoreSnapshotDTO
+
 type CreateProjectDTO struct {
 	Name                string                   `json:"name" validate:"required"`
 	Image               *string                  `json:"image,omitempty" validate:"optional"`
@@ -35,6 +71,7 @@ type CreateProjectDTO struct {
 	Source              CreateProjectSourceDTO   `json:"source" validate:"required"`
 	EnvVars             map[string]string        `json:"envVars" validate:"required"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	IdleTimeout         *int                     `json:"idleTimeout,omitempty" validate:"optional"`
 } //	@name	CreateProjectDTO
 
 type CreateProjectSourceDTO struct {
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// DuplicateWorkspace creates a new workspace on the same target with the projects, env vars and build configs of the source workspace
+func (s *WorkspaceService) DuplicateWorkspace(ctx context.Context, workspaceId string, req dto.DuplicateWorkspaceDTO) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	for projectName := range req.Commits {
+		_, err := w.GetProject(projectName)
+		if err != nil {
+			return nil, ErrProjectNotFound
+		}
+	}
+
+	createWorkspaceReq := dto.CreateWorkspaceDTO{
+		Id:       req.Id,
+		Name:     req.Name,
+		Target:   w.Target,
+		Projects: []dto.CreateProjectDTO{},
+	}
+
+	for _, p := range w.Projects {
+		repository := *p.Repository
+		if commit, ok := req.Commits[p.Name]; ok {
+			repository.Sha = commit
+			repository.Target = gitprovider.CloneTargetCommit
+			// The duplicated project stays on its branch so that the carried over changes can be committed to it
+			repository.KeepBranch = true
+		}
+
+		createProjectReq := dto.CreateProjectDTO{
+			Name:  p.Name,
+			Image: &p.Image,
+			User:  &p.User,
+			Source: dto.CreateProjectSourceDTO{
+				Repository: &repository,
+			},
+			EnvVars:             getUserEnvVars(p),
+			GitProviderConfigId: p.GitProviderConfigId,
+			IdleTimeout:         p.IdleTimeout,
+		}
+
+		if p.BuildConfig != nil {
+			buildConfig := *p.BuildConfig
+			createProjectReq.BuildConfig = &buildConfig
+		}
+
+		createWorkspaceReq.Projects = append(createWorkspaceReq.Projects, createProjectReq)
+	}
+
+	return s.CreateWorkspace(ctx, createWorkspaceReq)
+}
@@ -8,12 +8,23 @@ import (
 )
 
 var (
-	ErrWorkspaceAlreadyExists = errors.New("workspace already exists")
-	ErrInvalidWorkspaceName   = errors.New("name is not a valid alphanumeric string")
-	ErrWorkspaceNotFound      = errors.New("workspace not found")
-	ErrProjectNotFound        = errors.New("project not found")
-	ErrInvalidProjectName     = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
-	ErrInvalidProjectConfig   = errors.New("project config is invalid")
+	ErrWorkspaceAlreadyExists   = errors.New("workspace already exists")
+	ErrInvalidWorkspaceName     = errors.New("name is not a valid alphanumeric string")
+	ErrWorkspaceNotFound        = errors.New("workspace not found")
+	ErrProjectNotFound          = errors.New("project not found")
+	ErrProjectAlreadyExists     = errors.New("project already exists")
+	ErrLastProject              = errors.New("the last project of a workspace can not be removed. Remove the workspace instead")
+	ErrInvalidProjectName       = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrInvalidProjectConfig     = errors.New("project config is invalid")
+	ErrInvalidWorkspaceExpiry   = errors.New("workspace expiry is invalid. Set either a positive TTL or a future expiry timestamp")
+	ErrWorkspaceDoesNotExpire   = errors.New("workspace does not have an expiry set")
+	ErrInvalidStatusTransition  = errors.New("operation is not allowed in the current status")
+	ErrOperationInterrupted     = errors.New("operation was interrupted by a server restart")
+	ErrSnapshotsNotConfigured   = errors.New("no container registry is configured for project snapshots")
+	ErrWorkspaceAlreadyOnTarget = errors.New("workspace is already on the target")
+	ErrMultiProjectNotSupported = errors.New("the target provider does not support workspaces with multiple projects")
+	ErrStopStartNotSupported    = errors.New("the target provider does not support stopping workspaces and projects")
+	ErrSnapshotsNotSupported    = errors.New("the target provider does not support project snapshots")
 )
 
 func IsWorkspaceAlreadyExists(err error) bool {
@@ -28,6 +39,41 @@ func IsProjectNotFound(err error) bool {
 	return err.Error() == ErrProjectNotFound.Error()
 }
 
+func IsProjectAlreadyExists(err error) bool {
+	return err.Error() == ErrProjectAlreadyExists.Error()
+}
+
+func IsLastProject(err error) bool {
+	return err.Error() == ErrLastProject.Error()
+}
+
 func IsInvalidWorkspaceName(err error) bool {
 	return err.Error() == ErrInvalidWorkspaceName.Error()
 }
+
+func IsInvalidWorkspaceExpiry(err error) bool {
+	return err.Error() == ErrInvalidWorkspaceExpiry.Error()
+}
+
+func IsWorkspaceDoesNotExpire(err error) bool {
+	return err.Error() == ErrWorkspaceDoesNotExpire.Error()
+}
+
+func IsInvalidStatusTransition(err error) bool {
+	return err.Error() == ErrInvalidStatusTransition.Error()
+}
+
+func IsSnapshotsNotConfigured(err error) bool {
+	return err.Error() == ErrSnapshotsNotConfigured.Error()
+}
+
+func IsWorkspaceAlreadyOnTarget(err error) bool {
+	return err.Error() == ErrWorkspaceAlreadyOnTarget.Error()
+}
+
+// IsCapabilityNotSupported returns true if the request was rejected because the target provider does not support it
+func IsCapabilityNotSupported(err error) bool {
+	return err.Error() == ErrMultiProjectNotSupported.Error() ||
+		err.Error() == ErrStopStartNotSupported.Error() ||
+		err.Error() == ErrSnapshotsNotSupported.Error()
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (s *WorkspaceService) publishWorkspaceEvent(eventType events.EventType, w *workspace.Workspace) {
+	event := events.NewEvent(eventType)
+	event.WorkspaceId = w.Id
+	event.Target = w.Target
+
+	s.eventBus.Publish(event)
+}
+
+func (s *WorkspaceService) publishWorkspaceError(w *workspace.Workspace, err error) {
+	event := events.NewEvent(events.EventTypeWorkspaceError)
+	event.WorkspaceId = w.Id
+	event.Target = w.Target
+	event.State = string(w.Status)
+	event.Error = err.Error()
+
+	s.eventBus.Publish(event)
+}
+
+func (s *WorkspaceService) publishProjectStatus(w *workspace.Workspace, p *project.Project) {
+	event := events.NewEvent(events.EventTypeProjectStatusChanged)
+	event.WorkspaceId = w.Id
+	event.ProjectName = p.Name
+	event.State = string(p.Status)
+
+	s.eventBus.Publish(event)
+}
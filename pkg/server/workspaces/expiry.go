@@ -0,0 +1,130 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Workspaces get a warning in their log once they are this close to expiring
+const expiryWarningPeriod = 15 * time.Minute
+
+func (s *WorkspaceService) ExtendWorkspace(ctx context.Context, workspaceId string, duration time.Duration) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if w.ExpiresAt == nil {
+		return nil, ErrWorkspaceDoesNotExpire
+	}
+
+	if duration <= 0 {
+		return nil, ErrInvalidWorkspaceExpiry
+	}
+
+	expiresAt := *w.ExpiresAt
+	if expiresAt.Before(time.Now()) {
+		expiresAt = time.Now()
+	}
+	expiresAt = expiresAt.Add(duration)
+	w.ExpiresAt = &expiresAt
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	s.expiryWarnings.Delete(w.Id)
+
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogger.Write([]byte(fmt.Sprintf("Workspace expiry extended to %s\n", expiresAt.Format(time.RFC1123))))
+
+	return w, nil
+}
+
+// RemoveExpiredWorkspaces removes all workspaces whose expiry has passed.
+// Workspaces that are about to expire get a warning in their log.
+func (s *WorkspaceService) RemoveExpiredWorkspaces(ctx context.Context) error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		if w.ExpiresAt == nil {
+			continue
+		}
+
+		remaining := time.Until(*w.ExpiresAt)
+		if remaining > 0 {
+			if remaining <= expiryWarningPeriod {
+				s.warnWorkspaceExpiry(w)
+			}
+			continue
+		}
+
+		log.Infof("Workspace %s expired at %s. Removing...", w.Name, w.ExpiresAt.Format(time.RFC1123))
+
+		wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+		wsLogger.Write([]byte(fmt.Sprintf("Workspace expired at %s. Removing...\n", w.ExpiresAt.Format(time.RFC1123))))
+		wsLogger.Close()
+
+		err := s.RemoveWorkspace(ctx, w.Id)
+		if err != nil {
+			log.Errorf("failed to remove expired workspace %s: %s", w.Name, err)
+			continue
+		}
+
+		s.expiryWarnings.Delete(w.Id)
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) warnWorkspaceExpiry(w *workspace.Workspace) {
+	warnedExpiry, ok := s.expiryWarnings.Load(w.Id)
+	if ok && warnedExpiry.(time.Time).Equal(*w.ExpiresAt) {
+		return
+	}
+
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogger.Write([]byte(fmt.Sprintf("Workspace %s will be removed at %s. Run 'daytona workspace extend %s' to keep it longer\n", w.Name, w.ExpiresAt.Format(time.RFC1123), w.Name)))
+
+	s.expiryWarnings.Store(w.Id, *w.ExpiresAt)
+}
+
+func getWorkspaceExpiry(req dto.CreateWorkspaceDTO) (*time.Time, error) {
+	if req.TTL != nil && req.ExpiresAt != nil {
+		return nil, ErrInvalidWorkspaceExpiry
+	}
+
+	if req.TTL != nil {
+		ttl, err := time.ParseDuration(*req.TTL)
+		if err != nil || ttl <= 0 {
+			return nil, ErrInvalidWorkspaceExpiry
+		}
+
+		expiresAt := time.Now().Add(ttl)
+		return &expiresAt, nil
+	}
+
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		return nil, ErrInvalidWorkspaceExpiry
+	}
+
+	return req.ExpiresAt, nil
+}
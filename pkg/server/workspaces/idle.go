@@ -0,0 +1,111 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// StopIdleProjects stops all running projects whose last reported activity is older than their idle timeout.
+// The idle timeout is taken from the project, its target or the server config, in that order.
+func (s *WorkspaceService) StopIdleProjects(ctx context.Context) error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	targets := map[string]*provider.ProviderTarget{}
+
+	for _, w := range workspaces {
+		target, ok := targets[w.Target]
+		if !ok {
+			target = s.getIdleStopTarget(ctx, w.Target)
+			targets[w.Target] = target
+		}
+
+		if target == nil {
+			continue
+		}
+
+		for _, p := range w.Projects {
+			idleTimeout := s.getProjectIdleTimeout(p, target)
+			if idleTimeout <= 0 || !isProjectIdle(p, time.Duration(idleTimeout)*time.Minute) {
+				continue
+			}
+
+			projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+			projectLogger.Write([]byte(fmt.Sprintf("Project %s has been idle for more than %d minutes. Stopping...\n", p.Name, idleTimeout)))
+
+			err := s.StopProject(ctx, w.Id, p.Name)
+			if err != nil {
+				projectLogger.Write([]byte(fmt.Sprintf("Failed to stop idle project %s: %s\n", p.Name, err)))
+				projectLogger.Close()
+				log.Errorf("failed to stop idle project %s/%s: %s", w.Name, p.Name, err)
+				continue
+			}
+
+			projectLogger.Write([]byte(fmt.Sprintf("Project %s stopped\n", p.Name)))
+			projectLogger.Close()
+		}
+	}
+
+	return nil
+}
+
+// getIdleStopTarget returns the target with the given name or nil if the idle projects on it can not be stopped.
+// Targets whose provider does not support stopping projects are skipped instead of failing on every run
+func (s *WorkspaceService) getIdleStopTarget(ctx context.Context, targetName string) *provider.ProviderTarget {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &targetName})
+	if err != nil {
+		log.Errorf("failed to find target %s: %s", targetName, err)
+		return nil
+	}
+
+	capabilities, err := s.provisioner.GetCapabilities(ctx, target)
+	if err != nil {
+		log.Errorf("failed to get the capabilities of target %s: %s", targetName, err)
+		return nil
+	}
+
+	if !capabilities.StopStart {
+		return nil
+	}
+
+	return target
+}
+
+func (s *WorkspaceService) getProjectIdleTimeout(p *project.Project, target *provider.ProviderTarget) int {
+	if p.IdleTimeout != nil {
+		return *p.IdleTimeout
+	}
+
+	if target.IdleTimeout != nil {
+		return *target.IdleTimeout
+	}
+
+	return s.projectIdleTimeout
+}
+
+func isProjectIdle(p *project.Project, idleTimeout time.Duration) bool {
+	// Projects that are not running or whose agent does not report activity are never considered idle.
+	// The status is checked as well because the agent may report its state once more while the project is stopping
+	if p.Status != project.ProjectStatusRunning || p.State == nil || p.State.Uptime == 0 || p.State.LastActivity == "" {
+		return false
+	}
+
+	lastActivity, err := time.Parse(time.RFC3339, p.State.LastActivity)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(lastActivity) > idleTimeout
+}
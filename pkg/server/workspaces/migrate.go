@@ -0,0 +1,203 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/operation"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MigrateWorkspace stops the workspace, recreates its projects on the new target and removes them from the old one.
+// Uncommitted work is carried over either by snapshotting the projects or by checking out the given commits.
+// If the workspace can not be created on the new target, it is restored on the old target
+func (s *WorkspaceService) MigrateWorkspace(ctx context.Context, workspaceId string, req dto.MigrateWorkspaceDTO) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if w.Target == req.Target {
+		return nil, ErrWorkspaceAlreadyOnTarget
+	}
+
+	if req.UseSnapshots && (s.snapshotImageRegistry == nil || s.dockerClient == nil) {
+		return nil, ErrSnapshotsNotConfigured
+	}
+
+	for projectName := range req.Commits {
+		_, err := w.GetProject(projectName)
+		if err != nil {
+			return nil, ErrProjectNotFound
+		}
+	}
+
+	oldTarget, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	newTarget, err := s.targetStore.Find(&provider.TargetFilter{Name: &req.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	if newTarget.IsTemplate {
+		return nil, provider.ErrTargetIsTemplate
+	}
+
+	if len(w.Projects) > 1 {
+		err = s.requireCapability(ctx, newTarget, supportsMultiProject, ErrMultiProjectNotSupported)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.UseSnapshots {
+		err = s.requireCapability(ctx, oldTarget, supportsSnapshots, ErrSnapshotsNotSupported)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogWriter := io.MultiWriter(&util.InfoLogWriter{}, wsLogger)
+
+	wsLogWriter.Write([]byte(fmt.Sprintf("Migrating workspace %s from target %s to %s\n", w.Name, oldTarget.Name, newTarget.Name)))
+	operation.ReportProgress(ctx, fmt.Sprintf("Migrating workspace %s to %s", w.Name, newTarget.Name))
+
+	wasRunning := w.Status == workspace.WorkspaceStatusRunning
+	if wasRunning {
+		err = s.stopWorkspace(ctx, w, oldTarget)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	migrated := *w
+	migrated.Target = newTarget.Name
+	migrated.Status = workspace.WorkspaceStatusCreating
+	migrated.LastError = nil
+	migrated.Projects = []*project.Project{}
+
+	for _, p := range w.Projects {
+		migratedProject, err := s.newMigratedProject(w, p, newTarget, req)
+		if err != nil {
+			return nil, s.restoreWorkspace(ctx, w, oldTarget, wasRunning, wsLogWriter, err)
+		}
+
+		migrated.Projects = append(migrated.Projects, migratedProject)
+	}
+
+	_, err = s.createWorkspace(ctx, &migrated, newTarget)
+	if err != nil {
+		s.destroyWorkspaceResources(ctx, &migrated, newTarget)
+		return nil, s.restoreWorkspace(ctx, w, oldTarget, wasRunning, wsLogWriter, err)
+	}
+
+	s.destroyWorkspaceResources(ctx, w, oldTarget)
+
+	// The migration is complete at this point so a failure to stop the workspace does not fail it
+	if !wasRunning {
+		err = s.stopWorkspace(ctx, &migrated, newTarget)
+		if err != nil {
+			log.Error(err)
+			wsLogWriter.Write([]byte(fmt.Sprintf("Failed to stop workspace %s on target %s: %s\n", migrated.Name, newTarget.Name, err)))
+		}
+	}
+
+	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s migrated to target %s\n", migrated.Name, newTarget.Name)))
+
+	return &migrated, nil
+}
+
+// newMigratedProject returns a copy of the project that is ready to be created on the new target
+func (s *WorkspaceService) newMigratedProject(w *workspace.Workspace, p *project.Project, target *provider.ProviderTarget, req dto.MigrateWorkspaceDTO) (*project.Project, error) {
+	migratedProject := *p
+	migratedProject.Target = target.Name
+	migratedProject.Status = project.ProjectStatusCreating
+	migratedProject.State = nil
+	migratedProject.LastError = nil
+	migratedProject.SnapshotId = nil
+	migratedProject.EnvVars = getUserEnvVars(p)
+
+	repository := *p.Repository
+	migratedProject.Repository = &repository
+
+	if commit, ok := req.Commits[p.Name]; ok {
+		migratedProject.Repository.Sha = commit
+		migratedProject.Repository.Target = gitprovider.CloneTargetCommit
+	}
+
+	if req.UseSnapshots {
+		snap, err := s.snapshotProject(w, p, true)
+		if err != nil {
+			return nil, err
+		}
+
+		// The snapshot image is used as is instead of building the project
+		migratedProject.Image = snap.Image
+		migratedProject.SnapshotId = &snap.Id
+		migratedProject.BuildConfig = &buildconfig.BuildConfig{
+			CachedBuild: &buildconfig.CachedBuild{
+				User:  snap.User,
+				Image: snap.Image,
+			},
+		}
+	}
+
+	return &migratedProject, nil
+}
+
+// restoreWorkspace saves the workspace as it was before the migration, starts it again if it was running and returns the migration error
+func (s *WorkspaceService) restoreWorkspace(ctx context.Context, w *workspace.Workspace, target *provider.ProviderTarget, start bool, wsLogWriter io.Writer, migrationErr error) error {
+	wsLogWriter.Write([]byte(fmt.Sprintf("Failed to migrate workspace %s: %s. Restoring it on target %s\n", w.Name, migrationErr, target.Name)))
+
+	// The workspace record was replaced by the migrated workspace. The statuses of the workspace are the ones
+	// it had before the migration, or stopped if it was stopped for the migration
+	err := s.workspaceStore.Save(w)
+	if err != nil {
+		log.Error(err)
+		return migrationErr
+	}
+
+	if start {
+		err = s.startWorkspace(ctx, w, target, wsLogWriter)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	return migrationErr
+}
+
+// destroyWorkspaceResources removes the workspace and its projects from the target without removing the workspace record.
+// Failures are logged since the workspace is no longer in use on the target
+func (s *WorkspaceService) destroyWorkspaceResources(ctx context.Context, w *workspace.Workspace, target *provider.ProviderTarget) {
+	for _, p := range w.Projects {
+		err := s.provisioner.DestroyProject(ctx, p, target)
+		if err != nil {
+			log.Errorf("failed to destroy project %s on target %s: %s", p.Name, target.Name, err)
+		}
+	}
+
+	err := s.provisioner.DestroyWorkspace(ctx, w, target)
+	if err != nil {
+		log.Errorf("failed to destroy workspace %s on target %s: %s", w.Name, target.Name, err)
+	}
+}
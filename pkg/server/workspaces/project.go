@@ -0,0 +1,167 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *WorkspaceService) AddProject(ctx context.Context, workspaceId string, req dto.CreateProjectDTO) (*project.Project, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	_, err = w.GetProject(req.Name)
+	if err == nil {
+		return nil, ErrProjectAlreadyExists
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.requireCapability(ctx, target, supportsMultiProject, ErrMultiProjectNotSupported)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.newProject(w, req)
+	if err != nil {
+		return nil, err
+	}
+
+	p = s.withProjectEnvVars(ctx, p)
+
+	err = s.addProject(ctx, w, p, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// addProject saves the project to the workspace, then creates and starts it.
+// The project is removed again if it fails so that adding it can be retried
+func (s *WorkspaceService) addProject(ctx context.Context, w *workspace.Workspace, p *project.Project, target *provider.ProviderTarget) error {
+	w.Projects = append(w.Projects, p)
+	err := s.workspaceStore.Save(w)
+	if err != nil {
+		s.rollbackProject(ctx, w, p, target, false)
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogWriter := io.MultiWriter(&util.InfoLogWriter{}, projectLogger)
+
+	err = s.createProject(ctx, w, p, target, projectLogWriter)
+	if err == nil {
+		err = s.startProject(ctx, w, p, target, projectLogWriter)
+	}
+	if err != nil {
+		s.rollbackProject(ctx, w, p, target, true)
+		return err
+	}
+
+	return nil
+}
+
+// rollbackProject destroys a project that failed to be added, revokes its API key and removes it from the workspace
+func (s *WorkspaceService) rollbackProject(ctx context.Context, w *workspace.Workspace, p *project.Project, target *provider.ProviderTarget, destroy bool) {
+	if destroy {
+		// The project might have been partially created by the provider
+		err := s.provisioner.DestroyProject(ctx, p, target)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", w.Id, p.Name))
+	if err != nil {
+		log.Error(err)
+	}
+
+	projects := []*project.Project{}
+	for _, project := range w.Projects {
+		if project.Name != p.Name {
+			projects = append(projects, project)
+		}
+	}
+	w.Projects = projects
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func (s *WorkspaceService) RemoveProject(ctx context.Context, workspaceId, projectName string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if len(w.Projects) == 1 {
+		return ErrLastProject
+	}
+
+	log.Infof("Destroying project %s in workspace %s", p.Name, w.Id)
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return err
+	}
+
+	err = s.setProjectStatus(w, p, project.ProjectStatusDeleting)
+	if err != nil {
+		return err
+	}
+
+	err = s.provisioner.DestroyProject(ctx, p, target)
+	if err != nil {
+		return s.setProjectError(w, p, err)
+	}
+
+	err = s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", w.Id, p.Name))
+	if err != nil {
+		// Should not fail the whole operation if the API key cannot be revoked
+		log.Error(err)
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	err = projectLogger.Cleanup()
+	if err != nil {
+		// Should not fail the whole operation if the project logger cannot be cleaned up
+		log.Error(err)
+	}
+
+	projects := []*project.Project{}
+	for _, project := range w.Projects {
+		if project.Name != p.Name {
+			projects = append(projects, project)
+		}
+	}
+	w.Projects = projects
+
+	return s.workspaceStore.Save(w)
+}
@@ -10,48 +10,60 @@ import (
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 )
 
 func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	w, err := s.workspaceStore.Find(workspaceId)
 	if err != nil {
 		return ErrWorkspaceNotFound
 	}
 
-	log.Infof("Destroying workspace %s", workspace.Id)
+	log.Infof("Destroying workspace %s", w.Id)
 
-	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
 	if err != nil {
 		return err
 	}
 
-	for _, project := range workspace.Projects {
+	err = s.setWorkspaceStatus(w, workspace.WorkspaceStatusDeleting)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range w.Projects {
 		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+		err := s.setProjectStatus(w, p, project.ProjectStatusDeleting)
+		if err != nil {
+			return s.setWorkspaceError(w, err)
+		}
+
+		err = s.provisioner.DestroyProject(ctx, p, target)
 		if err != nil {
-			return err
+			return s.setWorkspaceError(w, s.setProjectError(w, p, err))
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
+	err = s.provisioner.DestroyWorkspace(ctx, w, target)
 	if err != nil {
-		return err
+		return s.setWorkspaceError(w, err)
 	}
 
 	// Should not fail the whole operation if the API key cannot be revoked
-	err = s.apiKeyService.Revoke(workspace.Id)
+	err = s.apiKeyService.Revoke(w.Id)
 	if err != nil {
 		log.Error(err)
 	}
 
-	for _, project := range workspace.Projects {
-		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
+	for _, project := range w.Projects {
+		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", w.Id, project.Name))
 		if err != nil {
 			// Should not fail the whole operation if the API key cannot be revoked
 			log.Error(err)
 		}
-		projectLogger := s.loggerFactory.CreateProjectLogger(workspace.Id, project.Name, logs.LogSourceServer)
+		projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 		err = projectLogger.Cleanup()
 		if err != nil {
 			// Should not fail the whole operation if the project logger cannot be cleaned up
@@ -59,14 +71,14 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 		}
 	}
 
-	logger := s.loggerFactory.CreateWorkspaceLogger(workspace.Id, logs.LogSourceServer)
+	logger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
 	err = logger.Cleanup()
 	if err != nil {
 		// Should not fail the whole operation if the workspace logger cannot be cleaned up
 		log.Error(err)
 	}
 
-	err = s.workspaceStore.Delete(workspace)
+	err = s.workspaceStore.Delete(w)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -74,7 +86,7 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 
 	clientId := telemetry.ClientId(ctx)
 
-	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, workspace, target)
+	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, w, target)
 	event := telemetry.ServerEventWorkspaceDestroyed
 	if err != nil {
 		telemetryProps["error"] = err.Error()
@@ -90,47 +102,57 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 
 // ForceRemoveWorkspace ignores provider errors and makes sure the workspace is removed from storage.
 func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	w, err := s.workspaceStore.Find(workspaceId)
 	if err != nil {
 		return ErrWorkspaceNotFound
 	}
 
-	log.Infof("Destroying workspace %s", workspace.Id)
+	log.Infof("Destroying workspace %s", w.Id)
+
+	target, _ := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+
+	w.Status = workspace.WorkspaceStatusDeleting
+	for _, p := range w.Projects {
+		p.Status = project.ProjectStatusDeleting
+	}
 
-	target, _ := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		log.Error(err)
+	}
 
-	for _, project := range workspace.Projects {
+	for _, p := range w.Projects {
 		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+		err := s.provisioner.DestroyProject(ctx, p, target)
 		if err != nil {
 			log.Error(err)
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
+	err = s.provisioner.DestroyWorkspace(ctx, w, target)
 	if err != nil {
 		log.Error(err)
 	}
 
-	err = s.apiKeyService.Revoke(workspace.Id)
+	err = s.apiKeyService.Revoke(w.Id)
 	if err != nil {
 		log.Error(err)
 	}
 
-	for _, project := range workspace.Projects {
-		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
+	for _, project := range w.Projects {
+		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", w.Id, project.Name))
 		if err != nil {
 			log.Error(err)
 		}
 
-		projectLogger := s.loggerFactory.CreateProjectLogger(workspace.Id, project.Name, logs.LogSourceServer)
+		projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 		err = projectLogger.Cleanup()
 		if err != nil {
 			log.Error(err)
 		}
 	}
 
-	err = s.workspaceStore.Delete(workspace)
+	err = s.workspaceStore.Delete(w)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -138,7 +160,7 @@ func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId
 
 	clientId := telemetry.ClientId(ctx)
 
-	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, workspace, target)
+	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, w, target)
 	event := telemetry.ServerEventWorkspaceDestroyed
 	if err != nil {
 		telemetryProps["error"] = err.Error()
@@ -7,10 +7,16 @@ import (
 	"context"
 	"errors"
 	"io"
+	"sync"
+	"time"
 
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/scheduler"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
@@ -20,10 +26,15 @@ import (
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+
+	log "github.com/sirupsen/logrus"
 )
 
 type IWorkspaceService interface {
 	CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error)
+	DuplicateWorkspace(ctx context.Context, workspaceId string, req dto.DuplicateWorkspaceDTO) (*workspace.Workspace, error)
+	MigrateWorkspace(ctx context.Context, workspaceId string, req dto.MigrateWorkspaceDTO) (*workspace.Workspace, error)
 	GetWorkspace(ctx context.Context, workspaceId string, verbose bool) (*dto.WorkspaceDTO, error)
 	GetWorkspaceLogReader(workspaceId string) (io.Reader, error)
 	GetProjectLogReader(workspaceId, projectName string) (io.Reader, error)
@@ -34,7 +45,16 @@ type IWorkspaceService interface {
 	StartProject(ctx context.Context, workspaceId string, projectName string) error
 	StartWorkspace(ctx context.Context, workspaceId string) error
 	StopProject(ctx context.Context, workspaceId string, projectName string) error
+	AddProject(ctx context.Context, workspaceId string, req dto.CreateProjectDTO) (*project.Project, error)
+	RemoveProject(ctx context.Context, workspaceId string, projectName string) error
 	StopWorkspace(ctx context.Context, workspaceId string) error
+	StopIdleProjects(ctx context.Context) error
+	ExtendWorkspace(ctx context.Context, workspaceId string, duration time.Duration) (*workspace.Workspace, error)
+	SnapshotProject(ctx context.Context, workspaceId string, projectName string, req dto.CreateSnapshotDTO) (*snapshot.Snapshot, error)
+	ListSnapshots(workspaceId string) ([]*snapshot.Snapshot, error)
+	RestoreSnapshot(ctx context.Context, workspaceId string, req dto.RestoreSnapshotDTO) (*project.Project, error)
+	RemoveExpiredWorkspaces(ctx context.Context) error
+	Start() error
 }
 
 type targetStore interface {
@@ -58,6 +78,14 @@ type WorkspaceServiceConfig struct {
 	LoggerFactory            logs.LoggerFactory
 	GitProviderService       gitproviders.IGitProviderService
 	TelemetryService         telemetry.TelemetryService
+	Scheduler                scheduler.IScheduler
+	ProjectIdleTimeout       int
+	EventBus                 *events.EventBus
+	SnapshotStore            snapshot.Store
+	DockerClient             docker.IDockerClient
+	// Container registry and namespace to which project snapshots are pushed
+	SnapshotImageRegistry  *containerregistry.ContainerRegistry
+	SnapshotImageNamespace string
 }
 
 func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
@@ -78,6 +106,13 @@ func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 		gitProviderService:       config.GitProviderService,
 		telemetryService:         config.TelemetryService,
 		builderImage:             config.BuilderImage,
+		scheduler:                config.Scheduler,
+		projectIdleTimeout:       config.ProjectIdleTimeout,
+		eventBus:                 config.EventBus,
+		snapshotStore:            config.SnapshotStore,
+		dockerClient:             config.DockerClient,
+		snapshotImageRegistry:    config.SnapshotImageRegistry,
+		snapshotImageNamespace:   config.SnapshotImageNamespace,
 	}
 }
 
@@ -98,6 +133,48 @@ type WorkspaceService struct {
 	loggerFactory            logs.LoggerFactory
 	gitProviderService       gitproviders.IGitProviderService
 	telemetryService         telemetry.TelemetryService
+	scheduler                scheduler.IScheduler
+	projectIdleTimeout       int
+	eventBus                 *events.EventBus
+	snapshotStore            snapshot.Store
+	dockerClient             docker.IDockerClient
+	snapshotImageRegistry    *containerregistry.ContainerRegistry
+	snapshotImageNamespace   string
+	// Workspace ID -> expiry for which a warning was already written to the workspace log
+	expiryWarnings sync.Map
+}
+
+// Runs at the start of every minute
+const schedulerInterval = "0 * * * * *"
+
+func (s *WorkspaceService) Start() error {
+	err := s.failInterruptedOperations()
+	if err != nil {
+		return err
+	}
+
+	err = s.scheduler.AddFunc(schedulerInterval, func() {
+		err := s.StopIdleProjects(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.scheduler.AddFunc(schedulerInterval, func() {
+		err := s.RemoveExpiredWorkspaces(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	s.scheduler.Start()
+	return nil
 }
 
 func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, state *project.ProjectState) (*workspace.Workspace, error) {
@@ -5,7 +5,10 @@ package workspaces_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
@@ -24,6 +28,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +47,30 @@ var target = provider.ProviderTarget{
 	},
 	Options: "test-options",
 }
+var migrationTarget = provider.ProviderTarget{
+	Name: "test-migration-target",
+	ProviderInfo: provider.ProviderInfo{
+		Name:    "test-provider",
+		Version: "test",
+	},
+	Options: "test-options",
+}
+var failingTarget = provider.ProviderTarget{
+	Name: "test-failing-target",
+	ProviderInfo: provider.ProviderInfo{
+		Name:    "test-provider",
+		Version: "test",
+	},
+	Options: "test-options",
+}
+var limitedTarget = provider.ProviderTarget{
+	Name: "test-limited-target",
+	ProviderInfo: provider.ProviderInfo{
+		Name:    "test-limited-provider",
+		Version: "test",
+	},
+	Options: "test-options",
+}
 var gitProviderConfigId = "github"
 
 var baseApiUrl = "https://api.github.com"
@@ -105,10 +134,23 @@ func TestWorkspaceService(t *testing.T) {
 	targetStore := t_targets.NewInMemoryTargetStore()
 	err := targetStore.Save(&target)
 	require.Nil(t, err)
+	err = targetStore.Save(&migrationTarget)
+	require.Nil(t, err)
+	err = targetStore.Save(&failingTarget)
+	require.Nil(t, err)
+	err = targetStore.Save(&limitedTarget)
+	require.Nil(t, err)
 
 	apiKeyService := mocks.NewMockApiKeyService()
 	gitProviderService := mocks.NewMockGitProviderService()
 	mockProvisioner := mocks.NewMockProvisioner()
+	mockScheduler := &mocks.MockScheduler{}
+	mockDockerClient := mocks.NewMockDockerClient()
+
+	mockProvisioner.On("GetCapabilities", mock.Anything, &limitedTarget).Return(&provider.ProviderCapabilities{}, nil)
+	mockProvisioner.On("GetCapabilities", mock.Anything, mock.Anything).Return(&provider.DefaultProviderCapabilities, nil)
+	snapshotStore := t_workspaces.NewInMemorySnapshotStore()
+	eventBus := events.NewEventBus()
 
 	wsLogsDir := t.TempDir()
 	buildLogsDir := t.TempDir()
@@ -128,6 +170,12 @@ func TestWorkspaceService(t *testing.T) {
 		Provisioner:              mockProvisioner,
 		LoggerFactory:            logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
 		GitProviderService:       gitProviderService,
+		Scheduler:                mockScheduler,
+		EventBus:                 eventBus,
+		SnapshotStore:            snapshotStore,
+		DockerClient:             mockDockerClient,
+		SnapshotImageRegistry:    &containerregistry.ContainerRegistry{Server: "registry.test"},
+		SnapshotImageNamespace:   "/daytona",
 	})
 
 	t.Run("CreateWorkspace", func(t *testing.T) {
@@ -135,8 +183,8 @@ func TestWorkspaceService(t *testing.T) {
 
 		containerRegistryService.On("FindByImageName", defaultProjectImage).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
 
-		mockProvisioner.On("CreateWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("CreateWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StartWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
 
 		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, createWorkspaceDto.Id).Return(createWorkspaceDto.Id, nil)
 		gitProviderService.On("GetLastCommitSha", createWorkspaceDto.Projects[0].Source.Repository).Return("123", nil)
@@ -155,6 +203,7 @@ func TestWorkspaceService(t *testing.T) {
 			GitProviderConfigId: createWorkspaceDto.Projects[0].GitProviderConfigId,
 			WorkspaceId:         createWorkspaceDto.Id,
 			Target:              createWorkspaceDto.Target,
+			Status:              project.ProjectStatusCreating,
 		}
 
 		proj.EnvVars = project.GetProjectEnvVars(proj, project.ProjectEnvVarParams{
@@ -164,7 +213,10 @@ func TestWorkspaceService(t *testing.T) {
 			ClientId:      "test",
 		}, false)
 
-		mockProvisioner.On("CreateProject", provisioner.ProjectParams{
+		projToStart := *proj
+		projToStart.Status = project.ProjectStatusStarting
+
+		mockProvisioner.On("CreateProject", mock.Anything, provisioner.ProjectParams{
 			Project:                       proj,
 			Target:                        &target,
 			ContainerRegistry:             containerRegistry,
@@ -172,8 +224,8 @@ func TestWorkspaceService(t *testing.T) {
 			BuilderImage:                  defaultProjectImage,
 			BuilderImageContainerRegistry: containerRegistry,
 		}).Return(nil)
-		mockProvisioner.On("StartProject", provisioner.ProjectParams{
-			Project:                       proj,
+		mockProvisioner.On("StartProject", mock.Anything, provisioner.ProjectParams{
+			Project:                       &projToStart,
 			Target:                        &target,
 			ContainerRegistry:             containerRegistry,
 			GitProviderConfig:             &gitProviderConfig,
@@ -183,12 +235,15 @@ func TestWorkspaceService(t *testing.T) {
 
 		gitProviderService.On("GetConfig", "github").Return(&gitProviderConfig, nil)
 
-		workspace, err := service.CreateWorkspace(ctx, createWorkspaceDto)
+		w, err := service.CreateWorkspace(ctx, createWorkspaceDto)
 
 		require.Nil(t, err)
-		require.NotNil(t, workspace)
+		require.NotNil(t, w)
+
+		workspaceEquals(t, createWorkspaceDto, w, defaultProjectImage)
 
-		workspaceEquals(t, createWorkspaceDto, workspace, defaultProjectImage)
+		require.Equal(t, workspace.WorkspaceStatusRunning, w.Status)
+		require.Equal(t, project.ProjectStatusRunning, w.Projects[0].Status)
 	})
 
 	t.Run("CreateWorkspace fails when workspace already exists", func(t *testing.T) {
@@ -206,6 +261,44 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, workspaces.ErrInvalidWorkspaceName, err)
 	})
 
+	t.Run("CreateWorkspace fails expiry validation", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDto
+		invalidWorkspaceRequest.Id = "invalid-expiry"
+		invalidWorkspaceRequest.Name = "invalid-expiry"
+		ttl := "-1h"
+		invalidWorkspaceRequest.TTL = &ttl
+
+		_, err := service.CreateWorkspace(ctx, invalidWorkspaceRequest)
+		require.NotNil(t, err)
+		require.Equal(t, workspaces.ErrInvalidWorkspaceExpiry, err)
+	})
+
+	t.Run("CreateWorkspace fails when the provider does not support multiple projects", func(t *testing.T) {
+		multiProjectRequest := createWorkspaceDto
+		multiProjectRequest.Id = "multi-project"
+		multiProjectRequest.Name = "multi-project"
+		multiProjectRequest.Target = limitedTarget.Name
+
+		secondProject := createWorkspaceDto.Projects[0]
+		secondProject.Name = "project2"
+		multiProjectRequest.Projects = append([]dto.CreateProjectDTO{}, createWorkspaceDto.Projects[0], secondProject)
+
+		_, err := service.CreateWorkspace(ctx, multiProjectRequest)
+		require.Equal(t, workspaces.ErrMultiProjectNotSupported, err)
+	})
+
+	t.Run("StopWorkspace fails when the provider does not support stopping", func(t *testing.T) {
+		limitedWorkspace := &workspace.Workspace{Id: "limited", Name: "limited", Target: limitedTarget.Name}
+		err := workspaceStore.Save(limitedWorkspace)
+		require.Nil(t, err)
+
+		err = service.StopWorkspace(ctx, limitedWorkspace.Id)
+		require.Equal(t, workspaces.ErrStopStartNotSupported, err)
+
+		err = workspaceStore.Delete(limitedWorkspace)
+		require.Nil(t, err)
+	})
+
 	t.Run("GetWorkspace", func(t *testing.T) {
 		mockProvisioner.On("GetWorkspaceInfo", mock.Anything, mock.Anything, &target).Return(&workspaceInfo, nil)
 
@@ -252,17 +345,23 @@ func TestWorkspaceService(t *testing.T) {
 	})
 
 	t.Run("StartWorkspace", func(t *testing.T) {
-		mockProvisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("StartProject", mock.Anything).Return(nil)
+		mockProvisioner.On("StartWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StartProject", mock.Anything, mock.Anything).Return(nil)
+
+		eventChan, unsubscribe := eventBus.Subscribe(events.EventTypeWorkspaceStarted)
+		defer unsubscribe()
 
 		err := service.StartWorkspace(ctx, createWorkspaceDto.Id)
 
 		require.Nil(t, err)
+
+		event := <-eventChan
+		require.Equal(t, createWorkspaceDto.Id, event.WorkspaceId)
 	})
 
 	t.Run("StartProject", func(t *testing.T) {
-		mockProvisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("StartProject", mock.Anything).Return(nil)
+		mockProvisioner.On("StartWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StartProject", mock.Anything, mock.Anything).Return(nil)
 
 		err := service.StartProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
 
@@ -270,8 +369,8 @@ func TestWorkspaceService(t *testing.T) {
 	})
 
 	t.Run("StopWorkspace", func(t *testing.T) {
-		mockProvisioner.On("StopWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("StopProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StopWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StopProject", mock.Anything, mock.Anything, &target).Return(nil)
 
 		err := service.StopWorkspace(ctx, createWorkspaceDto.Id)
 
@@ -279,17 +378,353 @@ func TestWorkspaceService(t *testing.T) {
 	})
 
 	t.Run("StopProject", func(t *testing.T) {
-		mockProvisioner.On("StopWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("StopProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StopWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StopProject", mock.Anything, mock.Anything, &target).Return(nil)
 
 		err := service.StopProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
 
 		require.Nil(t, err)
+
+		w, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Equal(t, project.ProjectStatusStopped, w.Projects[0].Status)
+	})
+
+	t.Run("StartProject fails while project is being deleted", func(t *testing.T) {
+		w, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		w.Projects[0].Status = project.ProjectStatusDeleting
+
+		err = service.StartProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
+		require.Equal(t, workspaces.ErrInvalidStatusTransition, err)
+
+		w.Projects[0].Status = project.ProjectStatusStopped
+	})
+
+	t.Run("StartWorkspace clears the last error", func(t *testing.T) {
+		w, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		workspaceStatus := w.Status
+		w.Status = workspace.WorkspaceStatusError
+		w.LastError = util.Pointer("failed to start workspace")
+		w.Projects[0].Status = project.ProjectStatusError
+		w.Projects[0].LastError = util.Pointer("failed to start project")
+
+		err = service.StartWorkspace(ctx, createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		w, err = workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Equal(t, workspace.WorkspaceStatusRunning, w.Status)
+		require.Nil(t, w.LastError)
+		require.Equal(t, project.ProjectStatusRunning, w.Projects[0].Status)
+		require.Nil(t, w.Projects[0].LastError)
+
+		w.Status = workspaceStatus
+		w.Projects[0].Status = project.ProjectStatusStopped
+	})
+
+	t.Run("AddProject removes the project when it fails to be created", func(t *testing.T) {
+		projectDto := createWorkspaceDto.Projects[0]
+		projectDto.Name = "failing-project"
+		isFailingProject := func(params provisioner.ProjectParams) bool {
+			return params.Project.Name == projectDto.Name
+		}
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", createWorkspaceDto.Id, projectDto.Name)).Return(projectDto.Name, nil)
+		apiKeyService.On("Revoke", fmt.Sprintf("%s/%s", createWorkspaceDto.Id, projectDto.Name)).Return(nil).Once()
+		mockProvisioner.On("CreateProject", mock.Anything, mock.MatchedBy(isFailingProject)).Return(errors.New("failed to create project")).Once()
+		mockProvisioner.On("DestroyProject", mock.Anything, mock.MatchedBy(func(p *project.Project) bool {
+			return p.Name == projectDto.Name
+		}), &target).Return(nil).Once()
+
+		_, err := service.AddProject(ctx, createWorkspaceDto.Id, projectDto)
+		require.NotNil(t, err)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, ws.Projects, 1)
+
+		apiKeyService.AssertCalled(t, "Revoke", fmt.Sprintf("%s/%s", createWorkspaceDto.Id, projectDto.Name))
+		mockProvisioner.AssertCalled(t, "DestroyProject", mock.Anything, mock.Anything, &target)
+	})
+
+	t.Run("AddProject", func(t *testing.T) {
+		projectDto := createWorkspaceDto.Projects[0]
+		projectDto.Name = "project2"
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", createWorkspaceDto.Id, projectDto.Name)).Return(projectDto.Name, nil)
+		mockProvisioner.On("CreateProject", mock.Anything, mock.Anything).Return(nil)
+		mockProvisioner.On("StartProject", mock.Anything, mock.Anything).Return(nil)
+
+		p, err := service.AddProject(ctx, createWorkspaceDto.Id, projectDto)
+
+		require.Nil(t, err)
+		require.Equal(t, projectDto.Name, p.Name)
+		require.Equal(t, projectDto.Name, p.ApiKey)
+		require.Equal(t, createWorkspaceDto.Id, p.WorkspaceId)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, ws.Projects, 2)
+	})
+
+	t.Run("AddProject fails when project already exists", func(t *testing.T) {
+		_, err := service.AddProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0])
+		require.Equal(t, workspaces.ErrProjectAlreadyExists, err)
+	})
+
+	t.Run("RemoveProject", func(t *testing.T) {
+		mockProvisioner.On("DestroyProject", mock.Anything, mock.Anything, &target).Return(nil)
+		apiKeyService.On("Revoke", fmt.Sprintf("%s/%s", createWorkspaceDto.Id, "project2")).Return(nil)
+
+		err := service.RemoveProject(ctx, createWorkspaceDto.Id, "project2")
+
+		require.Nil(t, err)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, ws.Projects, 1)
+	})
+
+	t.Run("RemoveProject fails for the last project", func(t *testing.T) {
+		err := service.RemoveProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
+		require.Equal(t, workspaces.ErrLastProject, err)
+	})
+
+	var snap *snapshot.Snapshot
+
+	t.Run("SnapshotProject", func(t *testing.T) {
+		mockDockerClient.On("SnapshotProject", mock.Anything).Return(nil)
+
+		var err error
+		snap, err = service.SnapshotProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, dto.CreateSnapshotDTO{
+			IncludeVolume: true,
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, createWorkspaceDto.Id, snap.WorkspaceId)
+		require.Equal(t, createWorkspaceDto.Projects[0].Name, snap.ProjectName)
+		require.True(t, snap.IncludesVolume)
+		require.True(t, strings.HasPrefix(snap.Image, "registry.test/daytona/"))
+		require.True(t, strings.HasSuffix(snap.Image, ":"+snap.Id))
+	})
+
+	t.Run("SnapshotProject fails when project not found", func(t *testing.T) {
+		_, err := service.SnapshotProject(ctx, createWorkspaceDto.Id, "nonexistent", dto.CreateSnapshotDTO{})
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		snapshots, err := service.ListSnapshots(createWorkspaceDto.Id)
+
+		require.Nil(t, err)
+		require.Len(t, snapshots, 1)
+		require.Equal(t, snap.Id, snapshots[0].Id)
+	})
+
+	t.Run("RestoreSnapshot", func(t *testing.T) {
+		var containerRegistry *containerregistry.ContainerRegistry
+
+		containerRegistryService.On("FindByImageName", snap.Image).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", createWorkspaceDto.Id, "restored")).Return("restored", nil)
+
+		p, err := service.RestoreSnapshot(ctx, createWorkspaceDto.Id, dto.RestoreSnapshotDTO{
+			SnapshotId:  snap.Id,
+			ProjectName: "restored",
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, "restored", p.Name)
+		require.Equal(t, snap.Image, p.Image)
+		require.Equal(t, snap.Image, p.BuildConfig.CachedBuild.Image)
+		require.Equal(t, snap.User, p.BuildConfig.CachedBuild.User)
+		require.NotNil(t, p.SnapshotId)
+		require.Equal(t, snap.Id, *p.SnapshotId)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, ws.Projects, 2)
+	})
+
+	t.Run("RestoreSnapshot fails when project already exists", func(t *testing.T) {
+		_, err := service.RestoreSnapshot(ctx, createWorkspaceDto.Id, dto.RestoreSnapshotDTO{
+			SnapshotId:  snap.Id,
+			ProjectName: "restored",
+		})
+		require.Equal(t, workspaces.ErrProjectAlreadyExists, err)
+	})
+
+	t.Run("DuplicateWorkspace", func(t *testing.T) {
+		duplicateId := "test-copy"
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, duplicateId).Return(duplicateId, nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, mock.MatchedBy(func(name string) bool {
+			return strings.HasPrefix(name, duplicateId+"/")
+		})).Return(duplicateId, nil)
+
+		w, err := service.DuplicateWorkspace(ctx, createWorkspaceDto.Id, dto.DuplicateWorkspaceDTO{
+			Id:   duplicateId,
+			Name: duplicateId,
+			Commits: map[string]string{
+				projectName: "sha2",
+			},
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, duplicateId, w.Id)
+		require.Equal(t, target.Name, w.Target)
+
+		source, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, w.Projects, len(source.Projects))
+
+		p, err := w.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, "sha2", p.Repository.Sha)
+		require.Equal(t, gitprovider.CloneTargetCommit, p.Repository.Target)
+		require.Equal(t, createWorkspaceDto.Projects[0].GitProviderConfigId, p.GitProviderConfigId)
+
+		sourceProject, err := source.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, "sha1", sourceProject.Repository.Sha)
+	})
+
+	t.Run("DuplicateWorkspace fails when project not found", func(t *testing.T) {
+		_, err := service.DuplicateWorkspace(ctx, createWorkspaceDto.Id, dto.DuplicateWorkspaceDTO{
+			Id:   "test-copy-2",
+			Name: "test-copy-2",
+			Commits: map[string]string{
+				"nonexistent": "sha2",
+			},
+		})
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Run("MigrateWorkspace", func(t *testing.T) {
+		duplicateId := "test-copy"
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("CreateWorkspace", mock.Anything, mock.Anything, &migrationTarget).Return(nil)
+		mockProvisioner.On("StartWorkspace", mock.Anything, mock.Anything, &migrationTarget).Return(nil)
+
+		w, err := service.MigrateWorkspace(ctx, duplicateId, dto.MigrateWorkspaceDTO{
+			Target: migrationTarget.Name,
+			Commits: map[string]string{
+				projectName: "sha3",
+			},
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, migrationTarget.Name, w.Target)
+		require.Equal(t, workspace.WorkspaceStatusRunning, w.Status)
+
+		ws, err := workspaceStore.Find(duplicateId)
+		require.Nil(t, err)
+		require.Equal(t, migrationTarget.Name, ws.Target)
+
+		for _, p := range ws.Projects {
+			require.Equal(t, migrationTarget.Name, p.Target)
+			require.Equal(t, project.ProjectStatusRunning, p.Status)
+		}
+
+		p, err := ws.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, "sha3", p.Repository.Sha)
+		require.Equal(t, gitprovider.CloneTargetCommit, p.Repository.Target)
+	})
+
+	t.Run("MigrateWorkspace fails when workspace is already on the target", func(t *testing.T) {
+		_, err := service.MigrateWorkspace(ctx, "test-copy", dto.MigrateWorkspaceDTO{
+			Target: migrationTarget.Name,
+		})
+		require.Equal(t, workspaces.ErrWorkspaceAlreadyOnTarget, err)
+	})
+
+	t.Run("MigrateWorkspace restores the workspace when creation fails", func(t *testing.T) {
+		duplicateId := "test-copy"
+
+		mockProvisioner.On("StopWorkspace", mock.Anything, mock.Anything, &migrationTarget).Return(nil)
+		mockProvisioner.On("StopProject", mock.Anything, mock.Anything, &migrationTarget).Return(nil)
+		mockProvisioner.On("CreateWorkspace", mock.Anything, mock.Anything, &failingTarget).Return(errors.New("failed to create workspace"))
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, mock.Anything, &failingTarget).Return(nil)
+		mockProvisioner.On("DestroyProject", mock.Anything, mock.Anything, &failingTarget).Return(nil)
+
+		_, err := service.MigrateWorkspace(ctx, duplicateId, dto.MigrateWorkspaceDTO{
+			Target: failingTarget.Name,
+		})
+		require.NotNil(t, err)
+
+		ws, err := workspaceStore.Find(duplicateId)
+		require.Nil(t, err)
+		require.Equal(t, migrationTarget.Name, ws.Target)
+		require.Equal(t, workspace.WorkspaceStatusRunning, ws.Status)
+		require.Nil(t, ws.LastError)
+	})
+
+	t.Run("MigrateWorkspace does not start a workspace that was not running", func(t *testing.T) {
+		duplicateId := "test-copy"
+
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, mock.Anything, &migrationTarget).Return(nil)
+		mockProvisioner.On("DestroyProject", mock.Anything, mock.Anything, &migrationTarget).Return(nil)
+
+		countStopCalls := func() int {
+			count := 0
+			for _, call := range mockProvisioner.Calls {
+				if call.Method == "StopWorkspace" && call.Arguments.Get(2) == &migrationTarget {
+					count++
+				}
+			}
+			return count
+		}
+
+		ws, err := workspaceStore.Find(duplicateId)
+		require.Nil(t, err)
+
+		ws.Status = workspace.WorkspaceStatusError
+		for _, p := range ws.Projects {
+			p.Status = project.ProjectStatusError
+		}
+
+		stopCalls := countStopCalls()
+
+		w, err := service.MigrateWorkspace(ctx, duplicateId, dto.MigrateWorkspaceDTO{
+			Target: target.Name,
+		})
+		require.Nil(t, err)
+		require.Equal(t, target.Name, w.Target)
+		require.Equal(t, workspace.WorkspaceStatusStopped, w.Status)
+
+		// The workspace is not stopped on the old target before it is destroyed
+		require.Equal(t, stopCalls, countStopCalls())
+	})
+
+	t.Run("ExtendWorkspace fails when workspace does not expire", func(t *testing.T) {
+		_, err := service.ExtendWorkspace(ctx, createWorkspaceDto.Id, time.Hour)
+		require.Equal(t, workspaces.ErrWorkspaceDoesNotExpire, err)
+	})
+
+	t.Run("ExtendWorkspace", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		expiresAt := time.Now().Add(time.Hour)
+		ws.ExpiresAt = &expiresAt
+		err = workspaceStore.Save(ws)
+		require.Nil(t, err)
+
+		ws, err = service.ExtendWorkspace(ctx, createWorkspaceDto.Id, 2*time.Hour)
+		require.Nil(t, err)
+		require.Equal(t, expiresAt.Add(2*time.Hour), *ws.ExpiresAt)
 	})
 
 	t.Run("RemoveWorkspace", func(t *testing.T) {
-		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DestroyProject", mock.Anything, mock.Anything, &target).Return(nil)
 		apiKeyService.On("Revoke", mock.Anything).Return(nil)
 
 		err := service.RemoveWorkspace(ctx, createWorkspaceDto.Id)
@@ -304,8 +739,8 @@ func TestWorkspaceService(t *testing.T) {
 		err := workspaceStore.Save(&workspace.Workspace{Id: createWorkspaceDto.Id, Target: target.Name})
 		require.Nil(t, err)
 
-		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DestroyProject", mock.Anything, mock.Anything, &target).Return(nil)
 		apiKeyService.On("Revoke", mock.Anything).Return(nil)
 
 		err = service.ForceRemoveWorkspace(ctx, createWorkspaceDto.Id)
@@ -336,9 +771,164 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, "main", project.State.GitStatus.CurrentBranch)
 	})
 
+	t.Run("StopIdleProjects", func(t *testing.T) {
+		mockProvisioner.On("StopProject", mock.Anything, mock.Anything, &target).Return(nil)
+
+		countStopProjectCalls := func(projectName string) int {
+			count := 0
+			for _, call := range mockProvisioner.Calls {
+				if call.Method == "StopProject" && call.Arguments.Get(1).(*project.Project).Name == projectName {
+					count++
+				}
+			}
+			return count
+		}
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		idleTimeout := 10
+		idleProject := ws.Projects[0]
+		require.Equal(t, project.ProjectStatusRunning, idleProject.Status)
+		idleProject.IdleTimeout = &idleTimeout
+		idleProject.State = &project.ProjectState{
+			UpdatedAt:    time.Now().Format(time.RFC1123),
+			Uptime:       3600,
+			LastActivity: time.Now().Add(-time.Hour).Format(time.RFC3339),
+		}
+
+		activeProject := &project.Project{
+			Name:        "active-project",
+			WorkspaceId: ws.Id,
+			Target:      ws.Target,
+			Status:      project.ProjectStatusRunning,
+			IdleTimeout: &idleTimeout,
+			State: &project.ProjectState{
+				UpdatedAt:    time.Now().Format(time.RFC1123),
+				Uptime:       3600,
+				LastActivity: time.Now().Add(-time.Minute).Format(time.RFC3339),
+			},
+		}
+		ws.Projects = append(ws.Projects, activeProject)
+
+		err = workspaceStore.Save(ws)
+		require.Nil(t, err)
+
+		idleStopCalls := countStopProjectCalls(idleProject.Name)
+
+		err = service.StopIdleProjects(ctx)
+		require.Nil(t, err)
+
+		require.Equal(t, idleStopCalls+1, countStopProjectCalls(idleProject.Name))
+		require.Zero(t, countStopProjectCalls(activeProject.Name))
+
+		ws, err = workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		require.Equal(t, project.ProjectStatusStopped, ws.Projects[0].Status)
+		require.Zero(t, ws.Projects[0].State.Uptime)
+		require.Equal(t, project.ProjectStatusRunning, ws.Projects[1].Status)
+
+		// Stopped projects are not stopped again, even if their agent reports its state once more
+		_, err = service.SetProjectState(ws.Id, idleProject.Name, &project.ProjectState{
+			UpdatedAt:    time.Now().Format(time.RFC1123),
+			Uptime:       3600,
+			LastActivity: time.Now().Add(-time.Hour).Format(time.RFC3339),
+		})
+		require.Nil(t, err)
+
+		err = service.StopIdleProjects(ctx)
+		require.Nil(t, err)
+
+		require.Equal(t, idleStopCalls+1, countStopProjectCalls(idleProject.Name))
+
+		ws.Projects = ws.Projects[:1]
+		err = workspaceStore.Save(ws)
+		require.Nil(t, err)
+	})
+
+	t.Run("StopIdleProjects skips targets that do not support stopping", func(t *testing.T) {
+		idleTimeout := 10
+		limitedWorkspace := &workspace.Workspace{
+			Id:     "limited-idle",
+			Name:   "limited-idle",
+			Target: limitedTarget.Name,
+			Projects: []*project.Project{
+				{
+					Name:        "project1",
+					WorkspaceId: "limited-idle",
+					Target:      limitedTarget.Name,
+					IdleTimeout: &idleTimeout,
+					State: &project.ProjectState{
+						UpdatedAt:    time.Now().Format(time.RFC1123),
+						Uptime:       3600,
+						LastActivity: time.Now().Add(-time.Hour).Format(time.RFC3339),
+					},
+				},
+			},
+		}
+		err := workspaceStore.Save(limitedWorkspace)
+		require.Nil(t, err)
+
+		err = service.StopIdleProjects(ctx)
+		require.Nil(t, err)
+
+		// The project is skipped before anything is written to its logs
+		require.NoFileExists(t, filepath.Join(wsLogsDir, limitedWorkspace.Id, "project1", "log"))
+		mockProvisioner.AssertNotCalled(t, "StopProject", mock.Anything, mock.Anything, &limitedTarget)
+
+		err = workspaceStore.Delete(limitedWorkspace)
+		require.Nil(t, err)
+	})
+
+	t.Run("RemoveExpiredWorkspaces", func(t *testing.T) {
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DestroyProject", mock.Anything, mock.Anything, &target).Return(nil)
+		apiKeyService.On("Revoke", mock.Anything).Return(nil)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		expiresAt := time.Now().Add(-time.Minute)
+		ws.ExpiresAt = &expiresAt
+		err = workspaceStore.Save(ws)
+		require.Nil(t, err)
+
+		err = service.RemoveExpiredWorkspaces(ctx)
+		require.Nil(t, err)
+
+		_, err = service.GetWorkspace(ctx, createWorkspaceDto.Id, false)
+		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
+	})
+
+	t.Run("Start", func(t *testing.T) {
+		interrupted := &workspace.Workspace{
+			Id:     "interrupted",
+			Name:   "interrupted",
+			Target: target.Name,
+			Status: workspace.WorkspaceStatusStarting,
+			Projects: []*project.Project{
+				{Name: "project1", Status: project.ProjectStatusStarting},
+			},
+		}
+		err := workspaceStore.Save(interrupted)
+		require.Nil(t, err)
+
+		mockScheduler.On("AddFunc", mock.Anything, mock.Anything).Return(nil)
+		mockScheduler.On("Start").Return()
+
+		err = service.Start()
+
+		require.Nil(t, err)
+		require.Equal(t, workspace.WorkspaceStatusError, interrupted.Status)
+		require.Equal(t, project.ProjectStatusError, interrupted.Projects[0].Status)
+	})
+
 	t.Cleanup(func() {
 		apiKeyService.AssertExpectations(t)
 		mockProvisioner.AssertExpectations(t)
+		mockScheduler.AssertExpectations(t)
+		mockDockerClient.AssertExpectations(t)
 	})
 }
 
@@ -0,0 +1,191 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+// SnapshotProject commits the project container, and optionally the project directory, to an image
+// in the snapshot container registry. Only projects running on the server's Docker daemon can be snapshotted
+func (s *WorkspaceService) SnapshotProject(ctx context.Context, workspaceId, projectName string, req dto.CreateSnapshotDTO) (*snapshot.Snapshot, error) {
+	if s.snapshotImageRegistry == nil || s.dockerClient == nil {
+		return nil, ErrSnapshotsNotConfigured
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.requireCapability(ctx, target, supportsSnapshots, ErrSnapshotsNotSupported)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.snapshotProject(w, p, req.IncludeVolume)
+}
+
+func (s *WorkspaceService) snapshotProject(w *workspace.Workspace, p *project.Project, includeVolume bool) (*snapshot.Snapshot, error) {
+	id := stringid.GenerateRandomID()
+	id = stringid.TruncateID(id)
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogWriter := io.MultiWriter(&util.InfoLogWriter{}, projectLogger)
+
+	snap := &snapshot.Snapshot{
+		Id:                  id,
+		WorkspaceId:         w.Id,
+		ProjectName:         p.Name,
+		Image:               s.getSnapshotImageName(p, id),
+		User:                p.User,
+		IncludesVolume:      includeVolume,
+		Repository:          p.Repository,
+		GitProviderConfigId: p.GitProviderConfigId,
+		EnvVars:             getUserEnvVars(p),
+		CreatedAt:           time.Now(),
+	}
+
+	projectLogWriter.Write([]byte(fmt.Sprintf("Creating snapshot %s of project %s\n", snap.Id, p.Name)))
+
+	err := s.dockerClient.SnapshotProject(docker.SnapshotProjectOptions{
+		Project:           p,
+		ImageName:         snap.Image,
+		IncludeVolume:     includeVolume,
+		ContainerRegistry: s.snapshotImageRegistry,
+		LogWriter:         projectLogWriter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.snapshotStore.Save(snap)
+	if err != nil {
+		return nil, err
+	}
+
+	projectLogWriter.Write([]byte(fmt.Sprintf("Snapshot %s created\n", snap.Id)))
+
+	return snap, nil
+}
+
+func (s *WorkspaceService) ListSnapshots(workspaceId string) ([]*snapshot.Snapshot, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	return s.snapshotStore.List(&snapshot.SnapshotFilter{
+		WorkspaceId: &w.Id,
+	})
+}
+
+// RestoreSnapshot adds a new project to the workspace that runs the snapshot image
+func (s *WorkspaceService) RestoreSnapshot(ctx context.Context, workspaceId string, req dto.RestoreSnapshotDTO) (*project.Project, error) {
+	snap, err := s.snapshotStore.Find(req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	_, err = w.GetProject(req.ProjectName)
+	if err == nil {
+		return nil, ErrProjectAlreadyExists
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.requireCapability(ctx, target, supportsMultiProject, ErrMultiProjectNotSupported)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.newProject(w, dto.CreateProjectDTO{
+		Name:  req.ProjectName,
+		Image: &snap.Image,
+		User:  &snap.User,
+		Source: dto.CreateProjectSourceDTO{
+			Repository: snap.Repository,
+		},
+		GitProviderConfigId: snap.GitProviderConfigId,
+		EnvVars:             snap.EnvVars,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The snapshot image is used as is instead of building the project
+	p.SnapshotId = &snap.Id
+	p.BuildConfig = &buildconfig.BuildConfig{
+		CachedBuild: &buildconfig.CachedBuild{
+			User:  snap.User,
+			Image: snap.Image,
+		},
+	}
+
+	p = s.withProjectEnvVars(ctx, p)
+
+	err = s.addProject(ctx, w, p, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (s *WorkspaceService) getSnapshotImageName(p *project.Project, snapshotId string) string {
+	nameBytes := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", p.WorkspaceId, p.Name)))
+	name := hex.EncodeToString(nameBytes[:])[:16]
+
+	return fmt.Sprintf("%s%s/s-%s:%s", s.snapshotImageRegistry.Server, s.snapshotImageNamespace, name, snapshotId)
+}
+
+// getUserEnvVars returns the project env vars without the ones set by the server
+func getUserEnvVars(p *project.Project) map[string]string {
+	serverEnvVars := project.GetProjectEnvVars(p, project.ProjectEnvVarParams{}, true)
+
+	envVars := map[string]string{}
+	for k, v := range p.EnvVars {
+		if _, ok := serverEnvVars[k]; !ok {
+			envVars[k] = v
+		}
+	}
+
+	return envVars
+}
@@ -9,8 +9,10 @@ import (
 	"io"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/operation"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/telemetry"
@@ -78,11 +80,21 @@ func (s *WorkspaceService) StartProject(ctx context.Context, workspaceId, projec
 	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 	defer projectLogger.Close()
 
-	return s.startProject(ctx, project, target, projectLogger)
+	return s.startProject(ctx, w, project, target, projectLogger)
 }
 
 func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
+	if !canTransitionProjects(ws, project.ProjectStatusStarting) {
+		return ErrInvalidStatusTransition
+	}
+
+	err := s.setWorkspaceStatus(ws, workspace.WorkspaceStatusStarting)
+	if err != nil {
+		return err
+	}
+
 	wsLogWriter.Write([]byte("Starting workspace\n"))
+	operation.ReportProgress(ctx, fmt.Sprintf("Starting workspace %s", ws.Name))
 
 	ws.EnvVars = workspace.GetWorkspaceEnvVars(ws, workspace.WorkspaceEnvVarParams{
 		ApiUrl:        s.serverApiUrl,
@@ -91,28 +103,41 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 		ClientId:      telemetry.ClientId(ctx),
 	}, telemetry.TelemetryEnabled(ctx))
 
-	err := s.provisioner.StartWorkspace(ws, target)
+	err = s.provisioner.StartWorkspace(provisioner.WithLogWriter(ctx, wsLogWriter), ws, target)
 	if err != nil {
-		return err
+		return s.setWorkspaceError(ws, err)
 	}
 
 	for _, project := range ws.Projects {
 		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, project.Name, logs.LogSourceServer)
 		defer projectLogger.Close()
 
-		err = s.startProject(ctx, project, target, projectLogger)
+		err = s.startProject(ctx, ws, project, target, projectLogger)
 		if err != nil {
-			return err
+			return s.setWorkspaceError(ws, err)
 		}
 	}
 
 	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s started\n", ws.Name)))
 
+	err = s.setWorkspaceStatus(ws, workspace.WorkspaceStatusRunning)
+	if err != nil {
+		return err
+	}
+
+	s.publishWorkspaceEvent(events.EventTypeWorkspaceStarted, ws)
+
 	return nil
 }
 
-func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+func (s *WorkspaceService) startProject(ctx context.Context, w *workspace.Workspace, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+	err := s.setProjectStatus(w, p, project.ProjectStatusStarting)
+	if err != nil {
+		return err
+	}
+
 	logWriter.Write([]byte(fmt.Sprintf("Starting project %s\n", p.Name)))
+	operation.ReportProgress(ctx, fmt.Sprintf("Starting project %s", p.Name))
 
 	projectToStart := *p
 	projectToStart.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
@@ -124,12 +149,12 @@ func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project,
 
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return s.setProjectError(w, p, err)
 	}
 
 	builderCr, err := s.containerRegistryService.FindByImageName(s.builderImage)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return s.setProjectError(w, p, err)
 	}
 
 	var gc *gitprovider.GitProviderConfig
@@ -137,11 +162,11 @@ func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project,
 	if p.GitProviderConfigId != nil {
 		gc, err = s.gitProviderService.GetConfig(*p.GitProviderConfigId)
 		if err != nil && !gitprovider.IsGitProviderNotFound(err) {
-			return err
+			return s.setProjectError(w, p, err)
 		}
 	}
 
-	err = s.provisioner.StartProject(provisioner.ProjectParams{
+	err = s.provisioner.StartProject(provisioner.WithLogWriter(ctx, logWriter), provisioner.ProjectParams{
 		Project:                       &projectToStart,
 		Target:                        target,
 		ContainerRegistry:             cr,
@@ -150,10 +175,10 @@ func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project,
 		BuilderImageContainerRegistry: builderCr,
 	})
 	if err != nil {
-		return err
+		return s.setProjectError(w, p, err)
 	}
 
 	logWriter.Write([]byte(fmt.Sprintf("Project %s started\n", p.Name)))
 
-	return nil
+	return s.setProjectStatus(w, p, project.ProjectStatusRunning)
 }
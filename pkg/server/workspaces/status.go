@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *WorkspaceService) setWorkspaceStatus(w *workspace.Workspace, status workspace.WorkspaceStatus) error {
+	if !w.Status.CanTransitionTo(status) {
+		log.Debugf("workspace %s can not move from %s to %s", w.Name, w.Status, status)
+		return ErrInvalidStatusTransition
+	}
+
+	w.Status = status
+	// The error of the last failed operation is cleared once another operation moves the workspace out of the error status
+	if status != workspace.WorkspaceStatusError {
+		w.LastError = nil
+	}
+
+	return s.workspaceStore.Save(w)
+}
+
+// setWorkspaceError moves the workspace to the error status, records the error message and returns the error
+func (s *WorkspaceService) setWorkspaceError(w *workspace.Workspace, err error) error {
+	errMessage := err.Error()
+	w.Status = workspace.WorkspaceStatusError
+	w.LastError = &errMessage
+
+	saveErr := s.workspaceStore.Save(w)
+	if saveErr != nil {
+		log.Error(saveErr)
+	}
+
+	s.publishWorkspaceError(w, err)
+
+	return err
+}
+
+func (s *WorkspaceService) setProjectStatus(w *workspace.Workspace, p *project.Project, status project.ProjectStatus) error {
+	if !p.Status.CanTransitionTo(status) {
+		log.Debugf("project %s/%s can not move from %s to %s", w.Name, p.Name, p.Status, status)
+		return ErrInvalidStatusTransition
+	}
+
+	p.Status = status
+	if status != project.ProjectStatusError {
+		p.LastError = nil
+	}
+
+	err := s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	s.publishProjectStatus(w, p)
+
+	return nil
+}
+
+// setProjectError moves the project to the error status, records the error message and returns the error
+func (s *WorkspaceService) setProjectError(w *workspace.Workspace, p *project.Project, err error) error {
+	errMessage := err.Error()
+	p.Status = project.ProjectStatusError
+	p.LastError = &errMessage
+
+	saveErr := s.workspaceStore.Save(w)
+	if saveErr != nil {
+		log.Error(saveErr)
+	}
+
+	s.publishProjectStatus(w, p)
+
+	return err
+}
+
+// canTransitionProjects reports whether all projects of the workspace can move to the given status
+func canTransitionProjects(w *workspace.Workspace, status project.ProjectStatus) bool {
+	for _, p := range w.Projects {
+		if !p.Status.CanTransitionTo(status) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// failInterruptedOperations moves workspaces and projects that were left in a transitional status,
+// e.g. because the server was stopped mid-operation, to the error status so they can be acted on again
+func (s *WorkspaceService) failInterruptedOperations() error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		if w.Status.IsTransitional() {
+			_ = s.setWorkspaceError(w, ErrOperationInterrupted)
+		}
+
+		for _, p := range w.Projects {
+			if p.Status.IsTransitional() {
+				_ = s.setProjectError(w, p, ErrOperationInterrupted)
+			}
+		}
+	}
+
+	return nil
+}
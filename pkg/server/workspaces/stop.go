@@ -5,40 +5,35 @@ package workspaces
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/operation"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 )
 
 func (s *WorkspaceService) StopWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	w, err := s.workspaceStore.Find(workspaceId)
 	if err != nil {
 		return ErrWorkspaceNotFound
 	}
 
-	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
 	if err != nil {
 		return err
 	}
 
-	for _, project := range workspace.Projects {
-		//	todo: go routines
-		err := s.provisioner.StopProject(project, target)
-		if err != nil {
-			return err
-		}
-		if project.State != nil {
-			project.State.Uptime = 0
-			project.State.UpdatedAt = time.Now().Format(time.RFC1123)
-		}
+	err = s.requireCapability(ctx, target, supportsStopStart, ErrStopStartNotSupported)
+	if err != nil {
+		return err
 	}
 
-	err = s.provisioner.StopWorkspace(workspace, target)
-	if err == nil {
-		err = s.workspaceStore.Save(workspace)
-	}
+	err = s.stopWorkspace(ctx, w, target)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -46,7 +41,7 @@ func (s *WorkspaceService) StopWorkspace(ctx context.Context, workspaceId string
 
 	clientId := telemetry.ClientId(ctx)
 
-	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, workspace, target)
+	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, w, target)
 	event := telemetry.ServerEventWorkspaceStopped
 	if err != nil {
 		telemetryProps["error"] = err.Error()
@@ -76,15 +71,66 @@ func (s *WorkspaceService) StopProject(ctx context.Context, workspaceId, project
 		return err
 	}
 
-	err = s.provisioner.StopProject(project, target)
+	err = s.requireCapability(ctx, target, supportsStopStart, ErrStopStartNotSupported)
 	if err != nil {
 		return err
 	}
 
-	if project.State != nil {
-		project.State.Uptime = 0
-		project.State.UpdatedAt = time.Now().Format(time.RFC1123)
+	return s.stopProject(ctx, w, project, target)
+}
+
+func (s *WorkspaceService) stopWorkspace(ctx context.Context, w *workspace.Workspace, target *provider.ProviderTarget) error {
+	if !canTransitionProjects(w, project.ProjectStatusStopping) {
+		return ErrInvalidStatusTransition
+	}
+
+	err := s.setWorkspaceStatus(w, workspace.WorkspaceStatusStopping)
+	if err != nil {
+		return err
+	}
+
+	for _, project := range w.Projects {
+		//	todo: go routines
+		err := s.stopProject(ctx, w, project, target)
+		if err != nil {
+			return s.setWorkspaceError(w, err)
+		}
+	}
+
+	operation.ReportProgress(ctx, fmt.Sprintf("Stopping workspace %s", w.Name))
+
+	err = s.provisioner.StopWorkspace(ctx, w, target)
+	if err != nil {
+		return s.setWorkspaceError(w, err)
+	}
+
+	err = s.setWorkspaceStatus(w, workspace.WorkspaceStatusStopped)
+	if err != nil {
+		return err
+	}
+
+	s.publishWorkspaceEvent(events.EventTypeWorkspaceStopped, w)
+
+	return nil
+}
+
+func (s *WorkspaceService) stopProject(ctx context.Context, w *workspace.Workspace, p *project.Project, target *provider.ProviderTarget) error {
+	err := s.setProjectStatus(w, p, project.ProjectStatusStopping)
+	if err != nil {
+		return err
+	}
+
+	operation.ReportProgress(ctx, fmt.Sprintf("Stopping project %s", p.Name))
+
+	err = s.provisioner.StopProject(ctx, p, target)
+	if err != nil {
+		return s.setProjectError(w, p, err)
+	}
+
+	if p.State != nil {
+		p.State.Uptime = 0
+		p.State.UpdatedAt = time.Now().Format(time.RFC1123)
 	}
 
-	return s.workspaceStore.Save(w)
+	return s.setProjectStatus(w, p, project.ProjectStatusStopped)
 }
@@ -6,6 +6,8 @@ package info
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/internal/util"
@@ -35,6 +37,12 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 
 	output += getInfoLine("State", string(b.State)) + "\n"
 
+	if b.Queued != nil {
+		output += getInfoLine("Queue Position", strconv.Itoa(int(*b.Queued))) + "\n"
+	}
+
+	output += getInfoLine("Priority", string(b.Priority)) + "\n"
+
 	output += getInfoLine("Repository", b.Repository.Url) + "\n"
 
 	if b.Image != nil {
@@ -61,12 +69,28 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 		output += getInfoLine("Devcontainer path", b.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if b.BuildConfig != nil && b.BuildConfig.Dockerfile != nil {
+		output += getInfoLine("Dockerfile path", b.BuildConfig.Dockerfile.FilePath) + "\n"
+	}
+
+	if b.BuildConfig != nil && b.BuildConfig.Language != nil {
+		output += getInfoLine("Languages", strings.Join(b.BuildConfig.Language.Languages, ", ")) + "\n"
+	}
+
 	output += getInfoLine("Prebuild ID", b.PrebuildId) + "\n"
 
 	output += getInfoLine("Created", util.FormatTimestamp(b.CreatedAt)) + "\n"
 
 	output += getInfoLine("Updated", util.FormatTimestamp(b.UpdatedAt)) + "\n"
 
+	if b.RetryAt != nil {
+		output += getInfoLine("Next Retry", util.FormatTimestamp(*b.RetryAt)) + "\n"
+	}
+
+	if len(b.Attempts) > 0 {
+		output += getAttemptsInfo(b.Attempts)
+	}
+
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		fmt.Println(output)
@@ -94,6 +118,25 @@ func renderTUIView(output string, width int) {
 	fmt.Println(content)
 }
 
+func getAttemptsInfo(attempts []apiclient.BuildAttempt) string {
+	output := "\n" + views.GetStyledMainTitle("Attempts") + "\n\n"
+
+	for _, attempt := range attempts {
+		value := fmt.Sprintf("Started %s", util.FormatTimestamp(attempt.StartedAt))
+		if attempt.Error != nil {
+			if attempt.ErrorCategory != nil {
+				value += fmt.Sprintf(", failed at %s: %s", *attempt.ErrorCategory, *attempt.Error)
+			} else {
+				value += fmt.Sprintf(", failed: %s", *attempt.Error)
+			}
+		}
+
+		output += getInfoLine(fmt.Sprintf("#%d", attempt.Number), value)
+	}
+
+	return output
+}
+
 func getInfoLine(key, value string) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
 }
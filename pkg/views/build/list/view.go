@@ -68,6 +68,9 @@ func getRowFromRowData(build apiclient.Build) []string {
 
 	data.Id = build.Id + views_util.AdditionalPropertyPadding
 	data.State = string(build.State)
+	if build.Queued != nil {
+		data.State += fmt.Sprintf(" (#%d in queue)", *build.Queued)
+	}
 	data.PrebuildId = build.PrebuildId
 	if data.PrebuildId == "" {
 		data.PrebuildId = "/"
@@ -73,6 +73,14 @@ func Render(projectConfig *apiclient.ProjectConfig, apiServerConfig *apiclient.S
 		output += getInfoLine("Devcontainer path", projectConfig.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if projectConfig.BuildConfig != nil && projectConfig.BuildConfig.Dockerfile != nil {
+		output += getInfoLine("Dockerfile path", projectConfig.BuildConfig.Dockerfile.FilePath) + "\n"
+	}
+
+	if projectConfig.BuildConfig != nil && projectConfig.BuildConfig.Language != nil {
+		output += getInfoLine("Languages", strings.Join(projectConfig.BuildConfig.Language.Languages, ", ")) + "\n"
+	}
+
 	prebuildCount := len(projectConfig.Prebuilds)
 
 	if prebuildCount > 0 {
@@ -146,5 +154,13 @@ func GetLabelFromBuild(build *apiclient.BuildConfig) string {
 		return fmt.Sprintf("Devcontainer (%s)", build.Devcontainer.FilePath)
 	}
 
+	if build.Dockerfile != nil {
+		return fmt.Sprintf("Dockerfile (%s)", build.Dockerfile.FilePath)
+	}
+
+	if build.Language != nil {
+		return fmt.Sprintf("Languages (%s)", strings.Join(build.Language.Languages, ", "))
+	}
+
 	return ""
 }
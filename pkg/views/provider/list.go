@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -13,9 +14,11 @@ import (
 )
 
 type rowData struct {
-	Label   string
-	Name    string
-	Version string
+	Label        string
+	Name         string
+	Version      string
+	Capabilities string
+	Health       string
 }
 
 func List(providerList []apiclient.Provider) {
@@ -31,7 +34,7 @@ func List(providerList []apiclient.Provider) {
 	}
 
 	table := util.GetTableView(data, []string{
-		"Provider", "Name", "Version",
+		"Provider", "Name", "Version", "Capabilities", "Health",
 	}, nil, func() {
 		renderUnstyledList(providerList)
 	})
@@ -49,11 +52,15 @@ func getRowFromData(provider *apiclient.Provider) []string {
 	}
 	data.Name = provider.Name
 	data.Version = provider.Version
+	data.Capabilities = getCapabilitiesString(provider.Capabilities)
+	data.Health = getHealthString(provider.Health)
 
 	return []string{
 		views.NameStyle.Render(data.Label),
 		views.DefaultRowDataStyle.Render(data.Name),
 		views.DefaultRowDataStyle.Render(data.Version),
+		views.DefaultRowDataStyle.Render(data.Capabilities),
+		views.DefaultRowDataStyle.Render(data.Health),
 	}
 }
 
@@ -65,7 +72,13 @@ func renderUnstyledList(providerList []apiclient.Provider) {
 			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider: "), *provider.Label) + "\n\n"
 		}
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), provider.Name) + "\n\n"
-		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Version: "), provider.Version) + "\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Version: "), provider.Version) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Capabilities: "), getCapabilitiesString(provider.Capabilities)) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Health: "), getHealthString(provider.Health)) + "\n"
+
+		if provider.Health != nil && provider.Health.LastError != nil {
+			output += "\n" + fmt.Sprintf("%s %s", views.GetPropertyKey("Last Error: "), *provider.Health.LastError) + "\n"
+		}
 
 		if provider.Name != providerList[len(providerList)-1].Name {
 			output += views.SeparatorString + "\n\n"
@@ -74,3 +87,45 @@ func renderUnstyledList(providerList []apiclient.Provider) {
 
 	fmt.Println(output)
 }
+
+func getCapabilitiesString(capabilities apiclient.ProviderCapabilities) string {
+	supported := []string{}
+
+	if capabilities.MultiProject {
+		supported = append(supported, "multi-project")
+	}
+	if capabilities.StopStart {
+		supported = append(supported, "stop/start")
+	}
+	if capabilities.Snapshots {
+		supported = append(supported, "snapshots")
+	}
+	if capabilities.PortExposure {
+		supported = append(supported, "port exposure")
+	}
+
+	if len(supported) == 0 {
+		return "/"
+	}
+
+	return strings.Join(supported, ", ")
+}
+
+func getHealthString(health *apiclient.ProviderHealth) string {
+	if health == nil {
+		return "/"
+	}
+
+	status := "Healthy"
+	if !health.Healthy {
+		status = "Down"
+	} else if len(health.UnmetRequirements) > 0 {
+		status = "Requirements not met"
+	}
+
+	if health.CrashCount > 0 {
+		status += fmt.Sprintf(" (%d crashes)", health.CrashCount)
+	}
+
+	return status
+}
@@ -27,6 +27,16 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Default Project User: "), config.DefaultProjectUser) + "\n\n"
 
+	output += fmt.Sprintf("%s %d", views.GetPropertyKey("Project Idle Timeout: "), config.ProjectIdleTimeout) + "\n\n"
+
+	if config.ProviderPublicKey != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Public Key: "), config.ProviderPublicKey) + "\n\n"
+	}
+
+	if config.MasterKeyFile != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Master Key File: "), config.MasterKeyFile) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("FRPS Domain: "), config.Frps.Domain) + "\n\n"
 
 	output += fmt.Sprintf("%s %d", views.GetPropertyKey("FRPS Port: "), config.Frps.Port) + "\n\n"
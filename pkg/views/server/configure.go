@@ -57,6 +57,8 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 	logFileMaxSize := strconv.Itoa(int(m.config.LogFile.MaxSize))
 	logFileMaxBackups := strconv.Itoa(int(m.config.LogFile.MaxBackups))
 	logFileMaxAge := strconv.Itoa(int(m.config.LogFile.MaxAge))
+	projectIdleTimeout := strconv.Itoa(int(m.config.GetProjectIdleTimeout()))
+	providerPublicKey := m.config.GetProviderPublicKey()
 
 	return huh.NewForm(
 		huh.NewGroup(
@@ -67,6 +69,14 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 			huh.NewInput().
 				Title("Registry URL").
 				Value(&m.config.RegistryUrl),
+			huh.NewInput().
+				Title("Provider Public Key").
+				Description("Base64 encoded ed25519 key used to verify provider signatures. Leave empty to only verify checksums").
+				Value(&providerPublicKey).
+				Validate(func(s string) error {
+					m.config.ProviderPublicKey = &s
+					return nil
+				}),
 			huh.NewInput().
 				Title("Server Download URL").
 				Value(&m.config.ServerDownloadUrl),
@@ -82,6 +92,24 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 			huh.NewInput().
 				Title("Default Project User").
 				Value(&m.config.DefaultProjectUser),
+			huh.NewInput().
+				Title("Project Idle Timeout").
+				Description("In minutes. Set to 0 to disable stopping idle projects").
+				Value(&projectIdleTimeout).
+				Validate(func(string) error {
+					idleTimeout, err := strconv.Atoi(projectIdleTimeout)
+					if err != nil {
+						return errors.New("failed to parse int")
+					}
+
+					if idleTimeout < 0 {
+						return errors.New("int out of range")
+					}
+
+					m.config.SetProjectIdleTimeout(int32(idleTimeout))
+
+					return nil
+				}),
 		),
 		huh.NewGroup(
 			huh.NewInput().
@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"log"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func ConfirmKeyRotationPrompt(confirmCheck *bool) {
+	views.RenderInfoMessageBold("This command generates a new master key and re-encrypts all secrets stored by the Daytona Server.\nKeep a backup of the current master key until the server is restarted successfully.")
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(" Do you want to continue?").
+				Value(confirmCheck),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
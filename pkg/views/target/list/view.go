@@ -48,6 +48,9 @@ func getRowFromRowData(target *apiclient.ProviderTarget) []string {
 	var data rowData
 
 	data.Target = target.Name
+	if target.GetIsTemplate() {
+		data.Target += " (template)"
+	}
 	data.Provider = target.ProviderInfo.Name
 	data.Options = target.Options
 
@@ -87,6 +90,10 @@ func renderUnstyledList(targetList []apiclient.ProviderTarget) {
 			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Default: "), "Yes") + "\n\n"
 		}
 
+		if target.GetIsTemplate() {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Template: "), "Yes") + "\n\n"
+		}
+
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target Options: "), target.Options) + "\n\n"
 
 		if target.Name != targetList[len(targetList)-1].Name {
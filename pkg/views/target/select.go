@@ -21,6 +21,7 @@ type TargetView struct {
 	Name         string
 	Options      string
 	IsDefault    bool
+	IsTemplate   bool
 	ProviderInfo ProviderInfo
 }
 
@@ -94,9 +95,10 @@ func GetTargetFromPrompt(targets []apiclient.ProviderTarget, activeProfileName s
 
 func GetTargetViewFromTarget(target apiclient.ProviderTarget) TargetView {
 	return TargetView{
-		Name:      target.Name,
-		Options:   target.Options,
-		IsDefault: target.IsDefault,
+		Name:       target.Name,
+		Options:    target.Options,
+		IsDefault:  target.IsDefault,
+		IsTemplate: target.GetIsTemplate(),
 		ProviderInfo: ProviderInfo{
 			Name:    target.ProviderInfo.Name,
 			Version: target.ProviderInfo.Version,
@@ -77,7 +77,12 @@ func SetTargetForm(target *TargetView, targetManifest map[string]apiclient.Provi
 				initialValue = &v
 			}
 
-			input, value := getInput(name, property, initialValue)
+			// Template values can reference variables
+			if v, ok := options[name].(string); ok && target.IsTemplate {
+				initialValue = &v
+			}
+
+			input, value := getInput(name, property, initialValue, target.IsTemplate)
 			fields = append(fields, input)
 			options[name] = value
 		case apiclient.ProviderTargetPropertyTypeString:
@@ -87,7 +92,7 @@ func SetTargetForm(target *TargetView, targetManifest map[string]apiclient.Provi
 				initialValue = &v
 			}
 
-			input, value := getInput(name, property, initialValue)
+			input, value := getInput(name, property, initialValue, target.IsTemplate)
 			fields = append(fields, input)
 			options[name] = value
 		case apiclient.ProviderTargetPropertyTypeBoolean:
@@ -134,6 +139,11 @@ func SetTargetForm(target *TargetView, targetManifest map[string]apiclient.Provi
 				continue
 			}
 		}
+		// Template values that reference variables are kept as strings
+		if v, ok := options[name].(*string); ok && target.IsTemplate && templateVariableRegex.MatchString(*v) {
+			continue
+		}
+
 		switch *property.Type {
 		case apiclient.ProviderTargetPropertyTypeInt:
 			options[name], err = strconv.Atoi(*options[name].(*string))
@@ -162,7 +172,10 @@ func SetTargetForm(target *TargetView, targetManifest map[string]apiclient.Provi
 	return nil
 }
 
-func getInput(name string, property apiclient.ProviderProviderTargetProperty, initialValue *string) (*huh.Input, *string) {
+// Matches template variable references, e.g. ${region}
+var templateVariableRegex = regexp.MustCompile(`\$\{[a-zA-Z_][a-zA-Z0-9_]*\}`)
+
+func getInput(name string, property apiclient.ProviderProviderTargetProperty, initialValue *string, isTemplate bool) (*huh.Input, *string) {
 	value := property.DefaultValue
 	if initialValue != nil {
 		value = initialValue
@@ -173,6 +186,14 @@ func getInput(name string, property apiclient.ProviderProviderTargetProperty, in
 		Description(*property.Description).
 		Value(value).
 		Validate(func(s string) error {
+			if s == "" && property.Required != nil && *property.Required {
+				return errors.New("value is required")
+			}
+
+			if isTemplate && templateVariableRegex.MatchString(s) {
+				return nil
+			}
+
 			switch *property.Type {
 			case apiclient.ProviderTargetPropertyTypeInt:
 				_, err := strconv.Atoi(s)
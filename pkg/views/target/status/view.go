@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func Render(statusList []apiclient.TargetQueueStatus) {
+	if len(statusList) == 0 {
+		views_util.NotifyEmptyTargetList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, status := range statusList {
+		data = append(data, []string{
+			views.NameStyle.Render(status.Target),
+			views.DefaultRowDataStyle.Render(getLimitString(status)),
+			views.DefaultRowDataStyle.Render(getOperationsString(status.Running, false)),
+			views.DefaultRowDataStyle.Render(getOperationsString(status.Queued, true)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Target", "Limit", "Running", "Queued",
+	}, nil, func() {
+		renderUnstyledList(statusList)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledList(statusList []apiclient.TargetQueueStatus) {
+	output := "\n"
+
+	for i, status := range statusList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target: "), status.Target) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Limit: "), getLimitString(status)) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Running: "), getOperationsString(status.Running, false)) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Queued: "), getOperationsString(status.Queued, true)) + "\n"
+
+		if i < len(statusList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getLimitString(status apiclient.TargetQueueStatus) string {
+	if status.MaxConcurrentOperations == 0 {
+		return "/"
+	}
+
+	return strconv.Itoa(int(status.MaxConcurrentOperations))
+}
+
+func getOperationsString(operations []apiclient.TargetOperation, queued bool) string {
+	if len(operations) == 0 {
+		return "/"
+	}
+
+	lines := []string{}
+	for i, operation := range operations {
+		line := fmt.Sprintf("%s %s", operation.Name, operation.WorkspaceId)
+		if operation.ProjectName != nil {
+			line += "/" + *operation.ProjectName
+		}
+
+		since := operation.QueuedAt
+		if !queued && operation.StartedAt != nil {
+			since = *operation.StartedAt
+		}
+		line += fmt.Sprintf(" (%s)", getElapsedString(since))
+
+		if queued {
+			line = fmt.Sprintf("%d. %s", i+1, line)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func getElapsedString(timestamp string) string {
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return timestamp
+	}
+
+	return time.Since(t).Round(time.Second).String()
+}
@@ -23,6 +23,10 @@ func (i item) Title() string {
 		title += " (default)"
 	}
 
+	if i.target.IsTemplate {
+		title += " (template)"
+	}
+
 	return title
 }
 func (i item) Description() string {
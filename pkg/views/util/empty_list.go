@@ -88,3 +88,21 @@ func NotifyEmptyServerLogList(tip bool) {
 		views.RenderTip("Use 'daytona serve' in order to create server log files")
 	}
 }
+
+func NotifyEmptyWebhookList(tip bool) {
+	views.RenderInfoMessageBold("No webhooks found")
+	if tip {
+		views.RenderTip("Use 'daytona webhook add' to add a webhook")
+	}
+}
+
+func NotifyEmptyWebhookDeliveryList() {
+	views.RenderInfoMessageBold("No webhook deliveries found")
+}
+
+func NotifyEmptySnapshotList(tip bool) {
+	views.RenderInfoMessageBold("No snapshots found")
+	if tip {
+		views.RenderTip("Use 'daytona workspace snapshot' to snapshot a project")
+	}
+}
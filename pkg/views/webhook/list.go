@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListWebhooks(webhookList []apiclient.Webhook) {
+	if len(webhookList) == 0 {
+		views_util.NotifyEmptyWebhookList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, w := range webhookList {
+		data = append(data, []string{
+			views.NameStyle.Render(w.Id + views_util.AdditionalPropertyPadding),
+			views.DefaultRowDataStyle.Render(w.Url),
+			views.DefaultRowDataStyle.Render(getEvents(w)),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(w.CreatedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "URL", "Events", "Created",
+	}, nil, func() {
+		renderUnstyledWebhookList(webhookList)
+	})
+
+	fmt.Println(table)
+}
+
+func ListDeliveries(deliveryList []apiclient.WebhookDelivery) {
+	if len(deliveryList) == 0 {
+		views_util.NotifyEmptyWebhookDeliveryList()
+		return
+	}
+
+	data := [][]string{}
+
+	for _, d := range deliveryList {
+		data = append(data, []string{
+			views.NameStyle.Render(d.Id + views_util.AdditionalPropertyPadding),
+			views.DefaultRowDataStyle.Render(string(d.EventType)),
+			views.DefaultRowDataStyle.Render(string(d.Status)),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(d.Attempts)),
+			views.DefaultRowDataStyle.Render(getResponse(d)),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(d.UpdatedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Event", "Status", "Attempts", "Response", "Updated",
+	}, nil, func() {
+		renderUnstyledDeliveryList(deliveryList)
+	})
+
+	fmt.Println(table)
+}
+
+func getEvents(w apiclient.Webhook) string {
+	if len(w.Events) == 0 {
+		return "all"
+	}
+
+	eventTypes := []string{}
+	for _, eventType := range w.Events {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return strings.Join(eventTypes, ", ")
+}
+
+func getResponse(d apiclient.WebhookDelivery) string {
+	if d.Error != nil {
+		return *d.Error
+	}
+
+	if d.StatusCode != nil {
+		return fmt.Sprint(*d.StatusCode)
+	}
+
+	return "/"
+}
+
+func renderUnstyledWebhookList(webhookList []apiclient.Webhook) {
+	output := "\n"
+
+	for _, w := range webhookList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), w.Id) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("URL: "), w.Url) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Events: "), getEvents(w)) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), util.FormatTimestamp(w.CreatedAt)) + "\n\n"
+
+		if w.Id != webhookList[len(webhookList)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func renderUnstyledDeliveryList(deliveryList []apiclient.WebhookDelivery) {
+	output := "\n"
+
+	for _, d := range deliveryList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), d.Id) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Event: "), d.EventType) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Status: "), d.Status) + "\n\n"
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Attempts: "), d.Attempts) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Response: "), getResponse(d)) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Updated: "), util.FormatTimestamp(d.UpdatedAt)) + "\n\n"
+
+		if d.Id != deliveryList[len(deliveryList)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func RenderSecret(secret string) {
+	var output string
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Generated webhook secret: "), secret) + "\n\n"
+
+	output += "Use it to verify the X-Daytona-Signature header of deliveries. Make sure to copy it as you will not be able to see it again."
+
+	views.RenderContainerLayout(views.GetInfoMessage(output))
+}
@@ -21,6 +21,7 @@ import (
 
 const (
 	DEVCONTAINER_FILEPATH = ".devcontainer/devcontainer.json"
+	DOCKERFILE_FILEPATH   = "Dockerfile"
 )
 
 var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("enter: next  f10: advanced configuration")
@@ -28,6 +29,7 @@ var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("e
 type ProjectConfigurationData struct {
 	BuildChoice          string
 	DevcontainerFilePath string
+	DockerfileFilePath   string
 	Image                string
 	User                 string
 	EnvVars              map[string]string
@@ -37,6 +39,7 @@ func NewConfigurationData(buildChoice views_util.BuildChoice, devContainerFilePa
 	projectConfigurationData := &ProjectConfigurationData{
 		BuildChoice:          string(buildChoice),
 		DevcontainerFilePath: defaults.DevcontainerFilePath,
+		DockerfileFilePath:   DOCKERFILE_FILEPATH,
 		Image:                *defaults.Image,
 		User:                 *defaults.ImageUser,
 		EnvVars:              map[string]string{},
@@ -54,6 +57,10 @@ func NewConfigurationData(buildChoice views_util.BuildChoice, devContainerFilePa
 		projectConfigurationData.EnvVars = currentProject.EnvVars
 	}
 
+	if currentProject.BuildConfig != nil && currentProject.BuildConfig.Dockerfile != nil {
+		projectConfigurationData.DockerfileFilePath = currentProject.BuildConfig.Dockerfile.FilePath
+	}
+
 	return projectConfigurationData
 }
 
@@ -80,6 +87,8 @@ func RunProjectConfiguration(projectList *[]apiclient.CreateProjectDTO, defaults
 		if currentProject.BuildConfig.Devcontainer != nil {
 			builderChoice = views_util.DEVCONTAINER
 			devContainerFilePath = currentProject.BuildConfig.Devcontainer.FilePath
+		} else if currentProject.BuildConfig.Dockerfile != nil {
+			builderChoice = views_util.DOCKERFILE
 		}
 	} else {
 		if currentProject.Image == nil && currentProject.User == nil ||
@@ -130,6 +139,20 @@ func RunProjectConfiguration(projectList *[]apiclient.CreateProjectDTO, defaults
 				(*projectList)[i].User = nil
 			}
 
+			if projectConfigurationData.BuildChoice == string(views_util.DOCKERFILE) {
+				dockerfileConfig := apiclient.DockerfileConfig{}
+				if currentProject.BuildConfig != nil && currentProject.BuildConfig.Dockerfile != nil {
+					dockerfileConfig = *currentProject.BuildConfig.Dockerfile
+				}
+				dockerfileConfig.FilePath = projectConfigurationData.DockerfileFilePath
+
+				(*projectList)[i].BuildConfig = &apiclient.BuildConfig{
+					Dockerfile: &dockerfileConfig,
+				}
+				(*projectList)[i].Image = nil
+				(*projectList)[i].User = nil
+			}
+
 			(*projectList)[i].EnvVars = projectConfigurationData.EnvVars
 		}
 	}
@@ -153,6 +176,7 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 	buildOptions := []huh.Option[string]{
 		{Key: "Automatic", Value: string(views_util.AUTOMATIC)},
 		{Key: "Devcontainer", Value: string(views_util.DEVCONTAINER)},
+		{Key: "Dockerfile", Value: string(views_util.DOCKERFILE)},
 		{Key: "Custom image", Value: string(views_util.CUSTOMIMAGE)},
 		{Key: "None", Value: string(views_util.NONE)},
 	}
@@ -183,6 +207,13 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 		).WithHeight(5).WithHideFunc(func() bool {
 			return projectConfiguration.BuildChoice != string(views_util.DEVCONTAINER)
 		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Dockerfile path").
+				Value(&projectConfiguration.DockerfileFilePath),
+		).WithHeight(5).WithHideFunc(func() bool {
+			return projectConfiguration.BuildChoice != string(views_util.DOCKERFILE)
+		}),
 		huh.NewGroup(
 			views.GetEnvVarsInput(&projectConfiguration.EnvVars),
 		).WithHeight(12),
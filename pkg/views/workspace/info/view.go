@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -41,10 +42,18 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 
 	output += getInfoLine("ID", workspace.Id) + "\n"
 
+	if expiresAt, err := time.Parse(time.RFC3339, workspace.GetExpiresAt()); err == nil {
+		output += getInfoLine("Expires", expiresAt.Local().Format(time.RFC1123)) + "\n"
+	}
+
 	if isCreationView {
 		output += getInfoLine("Editor", ide) + "\n"
 	}
 
+	if workspace.Status == apiclient.WorkspaceStatusError && workspace.LastError != nil && !hasProjectError(workspace.Projects) {
+		output += getInfoLine("Error", *workspace.LastError) + "\n"
+	}
+
 	if len(workspace.Projects) == 1 {
 		output += getSingleProjectOutput(&workspace.Projects[0], isCreationView)
 	} else {
@@ -94,8 +103,13 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	repositoryUrl = strings.TrimPrefix(repositoryUrl, "https://")
 	repositoryUrl = strings.TrimPrefix(repositoryUrl, "http://")
 
+	output += getInfoLineState("State", project) + "\n"
+
+	if project.Status == apiclient.ProjectStatusError && project.LastError != nil {
+		output += getInfoLine("Error", *project.LastError) + "\n"
+	}
+
 	if project.State != nil {
-		output += getInfoLineState("State", project.State) + "\n"
 		output += getInfoLineGitStatus("Branch", project.State.GitStatus) + "\n"
 	}
 
@@ -118,7 +132,10 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 	var output string
 	for i, project := range projects {
 		output += getInfoLine(fmt.Sprintf("Project #%d", i+1), project.Name)
-		output += getInfoLineState("State", project.State)
+		output += getInfoLineState("State", &project)
+		if project.Status == apiclient.ProjectStatusError && project.LastError != nil {
+			output += getInfoLine("Error", *project.LastError)
+		}
 		if project.State != nil {
 			output += getInfoLineGitStatus("Branch", project.State.GitStatus)
 		}
@@ -139,25 +156,43 @@ func getInfoLine(key, value string) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
 }
 
-func getInfoLineState(key string, state *apiclient.ProjectState) string {
-	var uptime int
+func getInfoLineState(key string, project *apiclient.Project) string {
 	var stateProperty string
 
-	if state == nil {
-		uptime = 0
-	} else {
-		uptime = int(state.Uptime)
+	status := project.Status
+	if status == apiclient.ProjectStatusUnknown {
+		// Projects created before statuses were introduced only have the reported uptime
+		status = apiclient.ProjectStatusStopped
+		if project.State != nil && project.State.Uptime > 0 {
+			status = apiclient.ProjectStatusRunning
+		}
 	}
 
-	if uptime == 0 {
-		stateProperty = propertyValueStyle.Foreground(views.Gray).Render("STOPPED")
-	} else {
+	switch status {
+	case apiclient.ProjectStatusRunning:
 		stateProperty = propertyValueStyle.Foreground(views.Green).Render("RUNNING")
+	case apiclient.ProjectStatusError:
+		stateProperty = propertyValueStyle.Foreground(views.Red).Render("ERROR")
+	case apiclient.ProjectStatusStopped:
+		stateProperty = propertyValueStyle.Foreground(views.Gray).Render("STOPPED")
+	default:
+		stateProperty = propertyValueStyle.Foreground(views.Yellow).Render(strings.ToUpper(string(status)))
 	}
 
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + stateProperty + propertyValueStyle.Foreground(views.Light).Render("\n")
 }
 
+// hasProjectError reports whether any of the projects holds the error that failed the workspace
+func hasProjectError(projects []apiclient.Project) bool {
+	for _, project := range projects {
+		if project.Status == apiclient.ProjectStatusError {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getInfoLineGitStatus(key string, status *apiclient.GitStatus) string {
 	if status == nil {
 		return ""
@@ -6,6 +6,7 @@ package list
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/internal/util"
@@ -19,7 +20,8 @@ type RowData struct {
 	Name       string
 	Repository string
 	Target     string
-	Status     string
+	Status     apiclient.ProjectStatus
+	Uptime     string
 	Created    string
 	Branch     string
 }
@@ -93,10 +95,15 @@ func renderUnstyledList(workspaceList []apiclient.WorkspaceDTO) {
 
 func getRowFromRowData(rowData RowData, isMultiProjectAccordion bool) []string {
 	var state string
-	if rowData.Status == "" {
-		state = views.InactiveStyle.Render("STOPPED")
-	} else {
+	switch rowData.Status {
+	case apiclient.ProjectStatusRunning:
 		state = views.ActiveStyle.Render("RUNNING")
+	case apiclient.ProjectStatusError:
+		state = lipgloss.NewStyle().Foreground(views.Red).Render("ERROR")
+	case apiclient.ProjectStatusCreating, apiclient.ProjectStatusStarting, apiclient.ProjectStatusStopping, apiclient.ProjectStatusDeleting:
+		state = lipgloss.NewStyle().Foreground(views.Yellow).Render(strings.ToUpper(string(rowData.Status)))
+	default:
+		state = views.InactiveStyle.Render("STOPPED")
 	}
 
 	if isMultiProjectAccordion {
@@ -112,8 +119,8 @@ func getRowFromRowData(rowData RowData, isMultiProjectAccordion bool) []string {
 		views.DefaultRowDataStyle.Render(views.GetBranchNameLabel(rowData.Branch)),
 	}
 
-	if rowData.Status != "" {
-		row[3] = fmt.Sprintf("%s %s", state, views.DefaultRowDataStyle.Render(fmt.Sprintf("(%s)", rowData.Status)))
+	if rowData.Status == apiclient.ProjectStatusRunning && rowData.Uptime != "" {
+		row[3] = fmt.Sprintf("%s %s", state, views.DefaultRowDataStyle.Render(fmt.Sprintf("(%s)", rowData.Uptime)))
 	}
 
 	return row
@@ -147,7 +154,7 @@ func SortWorkspaces(workspaceList *[]apiclient.WorkspaceDTO, verbose bool) {
 }
 
 func getWorkspaceTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProviders bool) *RowData {
-	rowData := RowData{"", "", "", "", "", ""}
+	rowData := RowData{}
 	rowData.Name = workspace.Name + views_util.AdditionalPropertyPadding
 	if len(workspace.Projects) > 0 {
 		rowData.Repository = util.GetRepositorySlugFromUrl(workspace.Projects[0].Repository.Url, specifyGitProviders)
@@ -159,14 +166,17 @@ func getWorkspaceTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProvid
 	if workspace.Info != nil && workspace.Info.Projects != nil && len(workspace.Info.Projects) > 0 {
 		rowData.Created = util.FormatTimestamp(workspace.Info.Projects[0].Created)
 	}
-	if len(workspace.Projects) > 0 && workspace.Projects[0].State != nil && workspace.Projects[0].State.Uptime > 0 {
-		rowData.Status = util.FormatUptime(workspace.Projects[0].State.Uptime)
+	if len(workspace.Projects) > 0 {
+		rowData.Status = getProjectStatus(workspace.Projects[0])
+		if workspace.Projects[0].State != nil && workspace.Projects[0].State.Uptime > 0 {
+			rowData.Uptime = util.FormatUptime(workspace.Projects[0].State.Uptime)
+		}
 	}
 	return &rowData
 }
 
 func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclient.Project, specifyGitProviders bool) *RowData {
-	rowData := RowData{"", "", "", "", "", ""}
+	rowData := RowData{}
 	rowData.Name = " └ " + project.Name
 
 	rowData.Repository = util.GetRepositorySlugFromUrl(project.Repository.Url, specifyGitProviders)
@@ -174,8 +184,9 @@ func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclie
 
 	rowData.Target = project.Target + views_util.AdditionalPropertyPadding
 
+	rowData.Status = getProjectStatus(project)
 	if project.State != nil && project.State.Uptime > 0 {
-		rowData.Status = util.FormatUptime(project.State.Uptime)
+		rowData.Uptime = util.FormatUptime(project.State.Uptime)
 	}
 
 	if workspaceDTO.Info == nil || workspaceDTO.Info.Projects == nil {
@@ -191,3 +202,16 @@ func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclie
 
 	return &rowData
 }
+
+// getProjectStatus falls back to the reported uptime for projects created before statuses were introduced
+func getProjectStatus(project apiclient.Project) apiclient.ProjectStatus {
+	if project.Status != apiclient.ProjectStatusUnknown {
+		return project.Status
+	}
+
+	if project.State != nil && project.State.Uptime > 0 {
+		return apiclient.ProjectStatusRunning
+	}
+
+	return apiclient.ProjectStatusStopped
+}
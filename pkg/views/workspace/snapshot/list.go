@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListSnapshots(snapshotList []apiclient.ProjectSnapshot) {
+	if len(snapshotList) == 0 {
+		views_util.NotifyEmptySnapshotList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range snapshotList {
+		data = append(data, []string{
+			views.NameStyle.Render(s.Id + views_util.AdditionalPropertyPadding),
+			views.DefaultRowDataStyle.Render(s.ProjectName),
+			views.DefaultRowDataStyle.Render(getIncludesVolume(s)),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(s.CreatedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Project", "Volume", "Created",
+	}, nil, func() {
+		renderUnstyledList(snapshotList)
+	})
+
+	fmt.Println(table)
+}
+
+func getIncludesVolume(s apiclient.ProjectSnapshot) string {
+	if s.IncludesVolume {
+		return "included"
+	}
+
+	return "/"
+}
+
+func renderUnstyledList(snapshotList []apiclient.ProjectSnapshot) {
+	output := "\n"
+
+	for _, s := range snapshotList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), s.Id) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), s.ProjectName) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Image: "), s.Image) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Volume: "), getIncludesVolume(s)) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), util.FormatTimestamp(s.CreatedAt)) + "\n\n"
+
+		if s.Id != snapshotList[len(snapshotList)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
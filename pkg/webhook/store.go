@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import "errors"
+
+type Store interface {
+	List() ([]*Webhook, error)
+	Find(id string) (*Webhook, error)
+	Save(webhook *Webhook) error
+	Delete(webhook *Webhook) error
+	// ListDeliveries returns the deliveries of the webhook, most recent first
+	ListDeliveries(webhookId string) ([]*Delivery, error)
+	// ListPendingDeliveries returns the deliveries of all webhooks that are still being attempted, oldest first
+	ListPendingDeliveries() ([]*Delivery, error)
+	SaveDelivery(delivery *Delivery) error
+	DeleteDeliveries(webhookId string) error
+	// PruneDeliveries deletes the finished deliveries of the webhook that are older than its most recent deliveries
+	PruneDeliveries(webhookId string, keep int) error
+}
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+func IsWebhookNotFound(err error) bool {
+	return err.Error() == ErrWebhookNotFound.Error()
+}
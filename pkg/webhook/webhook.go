@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+)
+
+type Webhook struct {
+	Id  string `json:"id" validate:"required"`
+	Url string `json:"url" validate:"required"`
+	// Used to sign delivery payloads, never returned by the API
+	Secret string `json:"-"`
+	// Event types delivered to the webhook, all events are delivered if empty
+	Events    []events.EventType `json:"events" validate:"required"`
+	CreatedAt time.Time          `json:"createdAt" validate:"required"`
+} // @name Webhook
+
+// Matches reports whether the event should be delivered to the webhook
+func (w *Webhook) Matches(event events.Event) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+
+	for _, eventType := range w.Events {
+		if eventType == event.Type {
+			return true
+		}
+	}
+
+	return false
+}
+
+type DeliveryStatus string // @name WebhookDeliveryStatus
+
+const (
+	DeliveryStatusPending DeliveryStatus = "pending"
+	DeliveryStatusSuccess DeliveryStatus = "success"
+	DeliveryStatusFailed  DeliveryStatus = "failed"
+)
+
+type Delivery struct {
+	Id        string           `json:"id" validate:"required"`
+	WebhookId string           `json:"webhookId" validate:"required"`
+	EventId   string           `json:"eventId" validate:"required"`
+	EventType events.EventType `json:"eventType" validate:"required"`
+	Status    DeliveryStatus   `json:"status" validate:"required"`
+	Attempts  int              `json:"attempts" validate:"required"`
+	// HTTP status code of the last attempt
+	StatusCode *int `json:"statusCode,omitempty" validate:"optional"`
+	// Error of the last attempt
+	Error *string `json:"error,omitempty" validate:"optional"`
+	// Time of the next attempt of a pending delivery
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty" validate:"optional"`
+	// Body sent to the webhook, kept so that pending deliveries can be resumed after a restart
+	Payload   string    `json:"-"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+} // @name WebhookDelivery
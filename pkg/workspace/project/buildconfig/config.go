@@ -5,6 +5,8 @@ package buildconfig
 
 type BuildConfig struct {
 	Devcontainer *DevcontainerConfig `json:"devcontainer,omitempty" validate:"optional"`
+	Dockerfile   *DockerfileConfig   `json:"dockerfile,omitempty" validate:"optional"`
+	Language     *LanguageConfig     `json:"language,omitempty" validate:"optional"`
 	CachedBuild  *CachedBuild        `json:"cachedBuild,omitempty" validate:"optional"`
 } // @name BuildConfig
 
@@ -12,6 +14,20 @@ type DevcontainerConfig struct {
 	FilePath string `json:"filePath" validate:"required"`
 } // @name DevcontainerConfig
 
+type DockerfileConfig struct {
+	FilePath string `json:"filePath" validate:"required"`
+	// Build context relative to the project directory. Defaults to the project directory
+	Context   string            `json:"context,omitempty" validate:"optional"`
+	BuildArgs map[string]string `json:"buildArgs,omitempty" validate:"optional"`
+	// Stage of a multi-stage Dockerfile to build
+	Target string `json:"target,omitempty" validate:"optional"`
+} // @name DockerfileConfig
+
+type LanguageConfig struct {
+	// Languages of the project, e.g. go, node, python, rust or java
+	Languages []string `json:"languages" validate:"required"`
+} // @name LanguageConfig
+
 type CachedBuild struct {
 	User  string `json:"user" validate:"required"`
 	Image string `json:"image" validate:"required"`
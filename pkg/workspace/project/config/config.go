@@ -19,6 +19,7 @@ type ProjectConfig struct {
 	IsDefault           bool                     `json:"default" validate:"required"`
 	Prebuilds           []*PrebuildConfig        `json:"prebuilds" validate:"optional"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	IdleTimeout         *int                     `json:"idleTimeout,omitempty" validate:"optional"`
 } // @name ProjectConfig
 
 func (pc *ProjectConfig) SetPrebuild(p *PrebuildConfig) error {
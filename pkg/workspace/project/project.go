@@ -23,6 +23,11 @@ type Project struct {
 	Target              string                     `json:"target" validate:"required"`
 	State               *ProjectState              `json:"state,omitempty" validate:"optional"`
 	GitProviderConfigId *string                    `json:"gitProviderConfigId,omitempty" validate:"optional"`
+	IdleTimeout         *int                       `json:"idleTimeout,omitempty" validate:"optional"`
+	Status              ProjectStatus              `json:"status" validate:"required"`
+	LastError           *string                    `json:"lastError,omitempty" validate:"optional"`
+	// Id of the snapshot the project was restored from. The project runs the snapshot image instead of being built
+	SnapshotId *string `json:"snapshotId,omitempty" validate:"optional"`
 } // @name Project
 
 type ProjectInfo struct {
@@ -34,9 +39,10 @@ type ProjectInfo struct {
 } // @name ProjectInfo
 
 type ProjectState struct {
-	UpdatedAt string     `json:"updatedAt" validate:"required"`
-	Uptime    uint64     `json:"uptime" validate:"required"`
-	GitStatus *GitStatus `json:"gitStatus" validate:"optional"`
+	UpdatedAt    string     `json:"updatedAt" validate:"required"`
+	Uptime       uint64     `json:"uptime" validate:"required"`
+	GitStatus    *GitStatus `json:"gitStatus" validate:"optional"`
+	LastActivity string     `json:"lastActivity,omitempty" validate:"optional"`
 } // @name ProjectState
 
 type GitStatus struct {
@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+)
+
+type Snapshot struct {
+	Id          string `json:"id" validate:"required"`
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	ProjectName string `json:"projectName" validate:"required"`
+	// Image in the container registry holding the project container filesystem
+	Image string `json:"image" validate:"required"`
+	User  string `json:"user" validate:"required"`
+	// Whether the project directory is part of the image
+	IncludesVolume      bool                       `json:"includesVolume" validate:"required"`
+	Repository          *gitprovider.GitRepository `json:"repository" validate:"required"`
+	GitProviderConfigId *string                    `json:"gitProviderConfigId,omitempty" validate:"optional"`
+	EnvVars             map[string]string          `json:"envVars" validate:"required"`
+	CreatedAt           time.Time                  `json:"createdAt" validate:"required"`
+} // @name ProjectSnapshot
@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import "errors"
+
+type SnapshotFilter struct {
+	WorkspaceId *string
+	ProjectName *string
+}
+
+type Store interface {
+	List(filter *SnapshotFilter) ([]*Snapshot, error)
+	Find(id string) (*Snapshot, error)
+	Save(snapshot *Snapshot) error
+}
+
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+)
+
+func IsSnapshotNotFound(err error) bool {
+	return err.Error() == ErrSnapshotNotFound.Error()
+}
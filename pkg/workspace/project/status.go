@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import "slices"
+
+type ProjectStatus string // @name ProjectStatus
+
+const (
+	ProjectStatusCreating ProjectStatus = "creating"
+	ProjectStatusStarting ProjectStatus = "starting"
+	ProjectStatusRunning  ProjectStatus = "running"
+	ProjectStatusStopping ProjectStatus = "stopping"
+	ProjectStatusStopped  ProjectStatus = "stopped"
+	ProjectStatusDeleting ProjectStatus = "deleting"
+	ProjectStatusError    ProjectStatus = "error"
+	// Status of projects persisted before statuses were introduced
+	ProjectStatusUnknown ProjectStatus = "unknown"
+)
+
+var projectStatusTransitions = map[ProjectStatus][]ProjectStatus{
+	ProjectStatusCreating: {ProjectStatusStarting, ProjectStatusError, ProjectStatusDeleting},
+	ProjectStatusStarting: {ProjectStatusRunning, ProjectStatusError, ProjectStatusDeleting},
+	ProjectStatusRunning:  {ProjectStatusStarting, ProjectStatusStopping, ProjectStatusError, ProjectStatusDeleting},
+	ProjectStatusStopping: {ProjectStatusStopped, ProjectStatusError, ProjectStatusDeleting},
+	ProjectStatusStopped:  {ProjectStatusStarting, ProjectStatusStopping, ProjectStatusError, ProjectStatusDeleting},
+	ProjectStatusDeleting: {ProjectStatusError},
+	ProjectStatusError:    {ProjectStatusStarting, ProjectStatusStopping, ProjectStatusError, ProjectStatusDeleting},
+}
+
+// CanTransitionTo reports whether a project in this status can move to the next status
+func (s ProjectStatus) CanTransitionTo(next ProjectStatus) bool {
+	if s == "" || s == ProjectStatusUnknown {
+		return true
+	}
+
+	return slices.Contains(projectStatusTransitions[s], next)
+}
+
+// IsTransitional reports whether the status belongs to an operation that is still in progress
+func (s ProjectStatus) IsTransitional() bool {
+	return s == ProjectStatusCreating || s == ProjectStatusStarting || s == ProjectStatusStopping || s == ProjectStatusDeleting
+}
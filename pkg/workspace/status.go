@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import "slices"
+
+type WorkspaceStatus string // @name WorkspaceStatus
+
+const (
+	WorkspaceStatusCreating WorkspaceStatus = "creating"
+	WorkspaceStatusStarting WorkspaceStatus = "starting"
+	WorkspaceStatusRunning  WorkspaceStatus = "running"
+	WorkspaceStatusStopping WorkspaceStatus = "stopping"
+	WorkspaceStatusStopped  WorkspaceStatus = "stopped"
+	WorkspaceStatusDeleting WorkspaceStatus = "deleting"
+	WorkspaceStatusError    WorkspaceStatus = "error"
+	// Status of workspaces persisted before statuses were introduced
+	WorkspaceStatusUnknown WorkspaceStatus = "unknown"
+)
+
+var workspaceStatusTransitions = map[WorkspaceStatus][]WorkspaceStatus{
+	WorkspaceStatusCreating: {WorkspaceStatusStarting, WorkspaceStatusError, WorkspaceStatusDeleting},
+	WorkspaceStatusStarting: {WorkspaceStatusRunning, WorkspaceStatusError, WorkspaceStatusDeleting},
+	WorkspaceStatusRunning:  {WorkspaceStatusStarting, WorkspaceStatusStopping, WorkspaceStatusError, WorkspaceStatusDeleting},
+	WorkspaceStatusStopping: {WorkspaceStatusStopped, WorkspaceStatusError, WorkspaceStatusDeleting},
+	WorkspaceStatusStopped:  {WorkspaceStatusStarting, WorkspaceStatusStopping, WorkspaceStatusError, WorkspaceStatusDeleting},
+	WorkspaceStatusDeleting: {WorkspaceStatusError},
+	WorkspaceStatusError:    {WorkspaceStatusStarting, WorkspaceStatusStopping, WorkspaceStatusError, WorkspaceStatusDeleting},
+}
+
+// CanTransitionTo reports whether a workspace in this status can move to the next status
+func (s WorkspaceStatus) CanTransitionTo(next WorkspaceStatus) bool {
+	if s == "" || s == WorkspaceStatusUnknown {
+		return true
+	}
+
+	return slices.Contains(workspaceStatusTransitions[s], next)
+}
+
+// IsTransitional reports whether the status belongs to an operation that is still in progress
+func (s WorkspaceStatus) IsTransitional() bool {
+	return s == WorkspaceStatusCreating || s == WorkspaceStatusStarting || s == WorkspaceStatusStopping || s == WorkspaceStatusDeleting
+}
@@ -5,17 +5,21 @@ package workspace
 
 import (
 	"errors"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type Workspace struct {
-	Id       string             `json:"id" validate:"required"`
-	Name     string             `json:"name" validate:"required"`
-	Projects []*project.Project `json:"projects" validate:"required"`
-	Target   string             `json:"target" validate:"required"`
-	ApiKey   string             `json:"-"`
-	EnvVars  map[string]string  `json:"-"`
+	Id        string             `json:"id" validate:"required"`
+	Name      string             `json:"name" validate:"required"`
+	Projects  []*project.Project `json:"projects" validate:"required"`
+	Target    string             `json:"target" validate:"required"`
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty" validate:"optional"`
+	Status    WorkspaceStatus    `json:"status" validate:"required"`
+	LastError *string            `json:"lastError,omitempty" validate:"optional"`
+	ApiKey    string             `json:"-"`
+	EnvVars   map[string]string  `json:"-"`
 } // @name Workspace
 
 type WorkspaceInfo struct {